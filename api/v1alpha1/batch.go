@@ -0,0 +1,29 @@
+package v1alpha1
+
+// BatchMessage is a message within a batch sent to the main container, see HTTP.MaxBatchSize.
+type BatchMessage struct {
+	Meta Meta `json:"meta" protobuf:"bytes,1,opt,name=meta"`
+	// Data is base64 encoded in JSON.
+	Data []byte `json:"data" protobuf:"bytes,2,opt,name=data"`
+}
+
+// BatchResult is the result of processing a BatchMessage, results are returned in the same order as the messages.
+type BatchResult struct {
+	// Data is the message to send to the sinks, or nil if there is none.
+	Data []byte `json:"data,omitempty" protobuf:"bytes,1,opt,name=data"`
+	// Error is set if the message could not be processed. The message is retried, or sent to the DLQ, just like a
+	// message that failed on its own.
+	Error string `json:"error,omitempty" protobuf:"bytes,2,opt,name=error"`
+	// Outputs are the messages to send to the sinks, if there is more than one, rather than Data.
+	Outputs []BatchOutput `json:"outputs,omitempty" protobuf:"bytes,3,rep,name=outputs"`
+	// Headers are the user's headers to add to every output message.
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,4,rep,name=headers"`
+}
+
+// BatchOutput is one of many output messages of a BatchResult.
+type BatchOutput struct {
+	// Data is base64 encoded in JSON.
+	Data []byte `json:"data" protobuf:"bytes,1,opt,name=data"`
+	// Headers are the user's headers to add to this output message.
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,2,rep,name=headers"`
+}
@@ -0,0 +1,40 @@
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CircuitBreaker stops messages being sent to a failing sink. After `failureThreshold` consecutive failures it opens,
+// and sources are paused for `openDuration`. It then half-opens, and allows `halfOpenProbes` messages to be sent. If
+// they all succeed it closes, otherwise it opens again.
+type CircuitBreaker struct {
+	// +kubebuilder:default=5
+	FailureThreshold uint32 `json:"failureThreshold,omitempty" protobuf:"varint,1,opt,name=failureThreshold"`
+	// +kubebuilder:default="30s"
+	OpenDuration *metav1.Duration `json:"openDuration,omitempty" protobuf:"bytes,2,opt,name=openDuration"`
+	// +kubebuilder:default=1
+	HalfOpenProbes uint32 `json:"halfOpenProbes,omitempty" protobuf:"varint,3,opt,name=halfOpenProbes"`
+}
+
+func (in CircuitBreaker) GetFailureThreshold() uint32 {
+	if in.FailureThreshold > 0 {
+		return in.FailureThreshold
+	}
+	return 5
+}
+
+func (in CircuitBreaker) GetOpenDuration() time.Duration {
+	if in.OpenDuration != nil {
+		return in.OpenDuration.Duration
+	}
+	return 30 * time.Second
+}
+
+func (in CircuitBreaker) GetHalfOpenProbes() uint32 {
+	if in.HalfOpenProbes > 0 {
+		return in.HalfOpenProbes
+	}
+	return 1
+}
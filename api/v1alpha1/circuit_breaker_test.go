@@ -0,0 +1,15 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	x := CircuitBreaker{}
+	assert.Equal(t, uint32(5), x.GetFailureThreshold())
+	assert.Equal(t, 30*time.Second, x.GetOpenDuration())
+	assert.Equal(t, uint32(1), x.GetHalfOpenProbes())
+}
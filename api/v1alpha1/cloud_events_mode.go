@@ -0,0 +1,11 @@
+package v1alpha1
+
+// CloudEventsMode is how a message is sent as a CloudEvent over HTTP, see
+// https://github.com/cloudevents/spec/blob/v1.0.1/http-protocol-binding.md
+// +kubebuilder:validation:Enum=Binary;Structured
+type CloudEventsMode string
+
+const (
+	CloudEventsModeBinary     CloudEventsMode = "Binary"     // attributes as `ce-*` headers, and the data as the body
+	CloudEventsModeStructured CloudEventsMode = "Structured" // the event as `application/cloudevents+json`
+)
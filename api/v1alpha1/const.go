@@ -11,6 +11,7 @@ const (
 	// conditions.
 	ConditionCompleted   = "Completed"   // the pipeline completed
 	ConditionRunning     = "Running"     // added if any step is currently running
+	ConditionSuspended   = "Suspended"   // added if the pipeline is suspended
 	ConditionTerminating = "Terminating" // added if any terminator step terminated
 	// container names.
 	CtrInit    = "init"
@@ -28,6 +29,7 @@ const (
 	EnvStep             = "ARGO_DATAFLOW_STEP"
 	EnvPeekDelay        = "ARGO_DATAFLOW_PEEK_DELAY"         // how long between peeking (default 4m)
 	EnvPullPolicy       = "ARGO_DATAFLOW_PULL_POLICY"        // default ""
+	EnvRedisPassword    = "ARGO_DATAFLOW_REDIS_PASSWORD"     // the password for a Redis dedupe store
 	EnvScalingDelay     = "ARGO_DATAFLOW_SCALING_DELAY"      // how long to wait between any scaling events (including peeking) default "4m"
 	EnvUpdateInterval   = "ARGO_DATAFLOW_UPDATE_INTERVAL"    // default "15s"
 	EnvImagePullSecrets = "ARGO_DATAFLOW_IMAGE_PULL_SECRETS" // allows providing a list of imagePullSecrets as a comma delimited string (eg. "secret1,secret2")
@@ -40,15 +42,22 @@ const (
 	KeyReplica          = "dataflow.argoproj.io/replica"
 	KeyStepName         = "dataflow.argoproj.io/step-name" // the step name without pipeline name prefix
 	KeyHash             = "dataflow.argoproj.io/hash"      // hash of the object
+	KeySuspend          = "dataflow.argoproj.io/suspend"   // "true" if the pod's step is suspended
+	KeySuspended        = "dataflow.argoproj.io/suspended" // "true" if the step's pipeline is suspended, so it must not be auto-scaled
 	// paths.
 	PathAuthorization = "/var/run/argo-dataflow/authorization" // the authorization header which must be used by the main container to speak to the sidecar
 	PathCheckout      = "/var/run/argo-dataflow/checkout"
+	PathDedupe        = "/var/run/argo-dataflow/dedupe"
 	PathFIFOIn        = "/var/run/argo-dataflow/in"
 	PathFIFOOut       = "/var/run/argo-dataflow/out"
 	PathGroups        = "/var/run/argo-dataflow/groups"
 	PathHandlerFile   = "/var/run/argo-dataflow/handler"
+	PathJoins         = "/var/run/argo-dataflow/joins"
 	PathKill          = "/var/run/argo-dataflow/kill"
+	PathMainSocket    = "/var/run/argo-dataflow/main.sock" // the Unix socket the main container may listen on
+	PathPodInfo       = "/var/run/argo-dataflow/podinfo"   // the pod's annotations, which the sidecar watches for changes
 	PathPreStop       = "/var/run/argo-dataflow/prestop"
+	PathWindows       = "/var/run/argo-dataflow/windows"
 	PathWorkingDir    = "/var/run/argo-dataflow/wd"
 	PathVarRun        = "/var/run/argo-dataflow"
 	// other const.
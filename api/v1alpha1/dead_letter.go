@@ -0,0 +1,48 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DeadLetterHeaderPrefix is the prefix of the headers of a message sent to a DLQ in the Raw format.
+	DeadLetterHeaderPrefix = "dlq-"
+)
+
+// DeadLetter is a message that could not be processed, and why. It is sent to DLQ sinks, as headers on the message in
+// the Raw format, or as JSON in the Envelope format.
+type DeadLetter struct {
+	// Error is the error from the last attempt.
+	Error      string `json:"error" protobuf:"bytes,1,opt,name=error"`
+	StepName   string `json:"stepName" protobuf:"bytes,2,opt,name=stepName"`
+	SourceName string `json:"sourceName" protobuf:"bytes,3,opt,name=sourceName"`
+	// Attempts is the number of times the message was processed, including retries.
+	Attempts         uint64      `json:"attempts" protobuf:"varint,4,opt,name=attempts"`
+	FirstFailureTime metav1.Time `json:"firstFailureTime" protobuf:"bytes,5,opt,name=firstFailureTime"`
+	LastFailureTime  metav1.Time `json:"lastFailureTime" protobuf:"bytes,6,opt,name=lastFailureTime"`
+	// Meta is the message's original meta-data.
+	Meta Meta `json:"meta" protobuf:"bytes,7,opt,name=meta"`
+	// Data is base64 encoded in JSON.
+	Data []byte `json:"data" protobuf:"bytes,8,opt,name=data"`
+}
+
+// Headers returns the message's original headers, and the failure, as headers prefixed with "dlq-".
+func (in DeadLetter) Headers() map[string]string {
+	headers := map[string]string{}
+	for k, v := range in.Meta.Headers {
+		headers[k] = v
+	}
+	meta, _ := json.Marshal(in.Meta)
+	headers[DeadLetterHeaderPrefix+"error"] = in.Error
+	headers[DeadLetterHeaderPrefix+"step-name"] = in.StepName
+	headers[DeadLetterHeaderPrefix+"source-name"] = in.SourceName
+	headers[DeadLetterHeaderPrefix+"attempts"] = strconv.FormatUint(in.Attempts, 10)
+	headers[DeadLetterHeaderPrefix+"first-failure-time"] = in.FirstFailureTime.UTC().Format(time.RFC3339)
+	headers[DeadLetterHeaderPrefix+"last-failure-time"] = in.LastFailureTime.UTC().Format(time.RFC3339)
+	headers[DeadLetterHeaderPrefix+"meta"] = string(meta)
+	return headers
+}
@@ -0,0 +1,9 @@
+package v1alpha1
+
+// +kubebuilder:validation:Enum=Raw;Envelope
+type DeadLetterQueueFormat string
+
+const (
+	DeadLetterQueueFormatRaw      DeadLetterQueueFormat = "Raw"      // the message as it was, with the failure in its headers
+	DeadLetterQueueFormatEnvelope DeadLetterQueueFormat = "Envelope" // a JSON DeadLetter, with the message as its data
+)
@@ -0,0 +1,31 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeadLetter_Headers(t *testing.T) {
+	d := DeadLetter{
+		Error:            "my-error",
+		StepName:         "my-step",
+		SourceName:       "my-source-name",
+		Attempts:         3,
+		FirstFailureTime: metav1.NewTime(time.Unix(1, 0)),
+		LastFailureTime:  metav1.NewTime(time.Unix(2, 0)),
+		Meta:             Meta{Source: "my-source", ID: "my-id", Time: 1, Headers: map[string]string{"tenant-id": "my-tenant"}},
+	}
+	assert.Equal(t, map[string]string{
+		"tenant-id":              "my-tenant",
+		"dlq-error":              "my-error",
+		"dlq-step-name":          "my-step",
+		"dlq-source-name":        "my-source-name",
+		"dlq-attempts":           "3",
+		"dlq-first-failure-time": "1970-01-01T00:00:01Z",
+		"dlq-last-failure-time":  "1970-01-01T00:00:02Z",
+		"dlq-meta":               `{"source":"my-source","id":"my-id","time":1,"headers":{"tenant-id":"my-tenant"}}`,
+	}, d.Headers())
+}
@@ -1,12 +1,28 @@
 package v1alpha1
 
 import (
+	"strconv"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // https://segment.com/blog/exactly-once-delivery/
 
+type RedisStore struct {
+	// Address is the "host:port" of the Redis server.
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// PasswordSecret is an optional reference to a secret key containing the password.
+	PasswordSecret *corev1.SecretKeySelector `json:"passwordSecret,omitempty" protobuf:"bytes,2,opt,name=passwordSecret"`
+	// DB is the Redis database number.
+	DB int32 `json:"db,omitempty" protobuf:"varint,3,opt,name=db"`
+	// KeyPrefix is prepended to each UID. If omitted, it is unique to the step, so that replicas share UIDs, but other
+	// steps do not.
+	KeyPrefix string `json:"keyPrefix,omitempty" protobuf:"bytes,4,opt,name=keyPrefix"`
+}
+
 type Dedupe struct {
 	AbstractStep `json:",inline" protobuf:"bytes,1,opt,name=abstractStep"`
 
@@ -16,12 +32,46 @@ type Dedupe struct {
 	// Larger number mean bigger windows of time for dedupe, but greater memory usage.
 	// +kubebuilder:default="1M"
 	MaxSize resource.Quantity `json:"maxSize,omitempty" protobuf:"bytes,3,opt,name=maxSize"`
+	// TTL is how long UIDs are kept for when using storage or Redis.
+	// +kubebuilder:default="1h"
+	TTL *metav1.Duration `json:"ttl,omitempty" protobuf:"bytes,4,opt,name=ttl"`
+	// Storage is an optional volume to store UIDs on, rather than in-memory, so they survive restarts.
+	Storage *Storage `json:"storage,omitempty" protobuf:"bytes,5,opt,name=storage"`
+	// Redis is an optional Redis server to store UIDs in, rather than in-memory, so they survive restarts and are shared
+	// between replicas. This takes precedence over storage.
+	Redis *RedisStore `json:"redis,omitempty" protobuf:"bytes,6,opt,name=redis"`
+}
+
+func (d Dedupe) GetTTL() time.Duration {
+	if d.TTL == nil {
+		return time.Hour
+	}
+	return d.TTL.Duration
 }
 
 func (d Dedupe) getContainer(req getContainerReq) corev1.Container {
-	return containerBuilder{}.
-		init(req).
-		args("dedupe", d.UID, d.MaxSize.String()).
+	args := []string{"dedupe", d.UID, d.MaxSize.String(), d.GetTTL().String()}
+	builder := containerBuilder{}.init(req)
+	if x := d.Redis; x != nil {
+		args = append(args, "redis", x.Address, strconv.Itoa(int(x.DB)), x.KeyPrefix)
+		if x.PasswordSecret != nil {
+			builder = builder.appendEnv(corev1.EnvVar{
+				Name:      EnvRedisPassword,
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: x.PasswordSecret},
+			})
+		}
+	} else if x := d.Storage; x != nil {
+		args = append(args, "disk")
+		builder = builder.appendVolumeMounts(corev1.VolumeMount{
+			Name:      x.Name,
+			MountPath: PathDedupe,
+			SubPath:   x.SubPath,
+		})
+	} else {
+		args = append(args, "memory")
+	}
+	return builder.
+		args(args...).
 		enablePrometheus().
 		resources(d.Resources).
 		build()
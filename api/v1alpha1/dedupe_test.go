@@ -0,0 +1,30 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestDedupe_getContainer(t *testing.T) {
+	t.Run("Memory", func(t *testing.T) {
+		x := Dedupe{UID: "my-uid", MaxSize: resource.MustParse("1M")}
+		c := x.getContainer(getContainerReq{})
+		assert.Equal(t, []string{"dedupe", "my-uid", "1M", "1h0m0s", "memory"}, c.Args)
+	})
+	t.Run("Storage", func(t *testing.T) {
+		x := Dedupe{UID: "my-uid", MaxSize: resource.MustParse("1M"), Storage: &Storage{Name: "my-storage", SubPath: "my-sub-path"}}
+		c := x.getContainer(getContainerReq{})
+		assert.Equal(t, []string{"dedupe", "my-uid", "1M", "1h0m0s", "disk"}, c.Args)
+		assert.Contains(t, c.VolumeMounts, corev1.VolumeMount{Name: "my-storage", MountPath: "/var/run/argo-dataflow/dedupe", SubPath: "my-sub-path"})
+	})
+	t.Run("Redis", func(t *testing.T) {
+		secret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}, Key: "my-key"}
+		x := Dedupe{UID: "my-uid", MaxSize: resource.MustParse("1M"), Redis: &RedisStore{Address: "redis:6379", PasswordSecret: secret, DB: 1}}
+		c := x.getContainer(getContainerReq{})
+		assert.Equal(t, []string{"dedupe", "my-uid", "1M", "1h0m0s", "redis", "redis:6379", "1", ""}, c.Args)
+		assert.Contains(t, c.Env, corev1.EnvVar{Name: EnvRedisPassword, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secret}})
+	})
+}
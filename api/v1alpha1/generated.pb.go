@@ -215,10 +215,109 @@ func (m *Backoff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Backoff proto.InternalMessageInfo
 
+func (m *BatchMessage) Reset()      { *m = BatchMessage{} }
+func (*BatchMessage) ProtoMessage() {}
+func (*BatchMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{5}
+}
+
+func (m *BatchMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BatchMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *BatchMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchMessage.Merge(m, src)
+}
+
+func (m *BatchMessage) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BatchMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchMessage proto.InternalMessageInfo
+
+func (m *BatchOutput) Reset()      { *m = BatchOutput{} }
+func (*BatchOutput) ProtoMessage() {}
+func (*BatchOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{6}
+}
+
+func (m *BatchOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BatchOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *BatchOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOutput.Merge(m, src)
+}
+
+func (m *BatchOutput) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BatchOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOutput proto.InternalMessageInfo
+
+func (m *BatchResult) Reset()      { *m = BatchResult{} }
+func (*BatchResult) ProtoMessage() {}
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{7}
+}
+
+func (m *BatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *BatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResult.Merge(m, src)
+}
+
+func (m *BatchResult) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResult proto.InternalMessageInfo
+
 func (m *Cat) Reset()      { *m = Cat{} }
 func (*Cat) ProtoMessage() {}
 func (*Cat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{5}
+	return fileDescriptor_7a4218a80d7ff35f, []int{8}
 }
 
 func (m *Cat) XXX_Unmarshal(b []byte) error {
@@ -248,10 +347,43 @@ func (m *Cat) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cat proto.InternalMessageInfo
 
+func (m *CircuitBreaker) Reset()      { *m = CircuitBreaker{} }
+func (*CircuitBreaker) ProtoMessage() {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{9}
+}
+
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
 func (m *Code) Reset()      { *m = Code{} }
 func (*Code) ProtoMessage() {}
 func (*Code) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{6}
+	return fileDescriptor_7a4218a80d7ff35f, []int{10}
 }
 
 func (m *Code) XXX_Unmarshal(b []byte) error {
@@ -284,7 +416,7 @@ var xxx_messageInfo_Code proto.InternalMessageInfo
 func (m *Container) Reset()      { *m = Container{} }
 func (*Container) ProtoMessage() {}
 func (*Container) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{7}
+	return fileDescriptor_7a4218a80d7ff35f, []int{11}
 }
 
 func (m *Container) XXX_Unmarshal(b []byte) error {
@@ -317,7 +449,7 @@ var xxx_messageInfo_Container proto.InternalMessageInfo
 func (m *Cron) Reset()      { *m = Cron{} }
 func (*Cron) ProtoMessage() {}
 func (*Cron) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{8}
+	return fileDescriptor_7a4218a80d7ff35f, []int{12}
 }
 
 func (m *Cron) XXX_Unmarshal(b []byte) error {
@@ -350,7 +482,7 @@ var xxx_messageInfo_Cron proto.InternalMessageInfo
 func (m *DBDataSource) Reset()      { *m = DBDataSource{} }
 func (*DBDataSource) ProtoMessage() {}
 func (*DBDataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{9}
+	return fileDescriptor_7a4218a80d7ff35f, []int{13}
 }
 
 func (m *DBDataSource) XXX_Unmarshal(b []byte) error {
@@ -383,7 +515,7 @@ var xxx_messageInfo_DBDataSource proto.InternalMessageInfo
 func (m *DBDataSourceFrom) Reset()      { *m = DBDataSourceFrom{} }
 func (*DBDataSourceFrom) ProtoMessage() {}
 func (*DBDataSourceFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{10}
+	return fileDescriptor_7a4218a80d7ff35f, []int{14}
 }
 
 func (m *DBDataSourceFrom) XXX_Unmarshal(b []byte) error {
@@ -416,7 +548,7 @@ var xxx_messageInfo_DBDataSourceFrom proto.InternalMessageInfo
 func (m *DBSink) Reset()      { *m = DBSink{} }
 func (*DBSink) ProtoMessage() {}
 func (*DBSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{11}
+	return fileDescriptor_7a4218a80d7ff35f, []int{15}
 }
 
 func (m *DBSink) XXX_Unmarshal(b []byte) error {
@@ -449,7 +581,7 @@ var xxx_messageInfo_DBSink proto.InternalMessageInfo
 func (m *DBSource) Reset()      { *m = DBSource{} }
 func (*DBSource) ProtoMessage() {}
 func (*DBSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{12}
+	return fileDescriptor_7a4218a80d7ff35f, []int{16}
 }
 
 func (m *DBSource) XXX_Unmarshal(b []byte) error {
@@ -482,7 +614,7 @@ var xxx_messageInfo_DBSource proto.InternalMessageInfo
 func (m *Database) Reset()      { *m = Database{} }
 func (*Database) ProtoMessage() {}
 func (*Database) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{13}
+	return fileDescriptor_7a4218a80d7ff35f, []int{17}
 }
 
 func (m *Database) XXX_Unmarshal(b []byte) error {
@@ -512,10 +644,43 @@ func (m *Database) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Database proto.InternalMessageInfo
 
+func (m *DeadLetter) Reset()      { *m = DeadLetter{} }
+func (*DeadLetter) ProtoMessage() {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{18}
+}
+
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+
+func (m *DeadLetter) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
 func (m *Dedupe) Reset()      { *m = Dedupe{} }
 func (*Dedupe) ProtoMessage() {}
 func (*Dedupe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{14}
+	return fileDescriptor_7a4218a80d7ff35f, []int{19}
 }
 
 func (m *Dedupe) XXX_Unmarshal(b []byte) error {
@@ -548,7 +713,7 @@ var xxx_messageInfo_Dedupe proto.InternalMessageInfo
 func (m *Expand) Reset()      { *m = Expand{} }
 func (*Expand) ProtoMessage() {}
 func (*Expand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{15}
+	return fileDescriptor_7a4218a80d7ff35f, []int{20}
 }
 
 func (m *Expand) XXX_Unmarshal(b []byte) error {
@@ -581,7 +746,7 @@ var xxx_messageInfo_Expand proto.InternalMessageInfo
 func (m *Filter) Reset()      { *m = Filter{} }
 func (*Filter) ProtoMessage() {}
 func (*Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{16}
+	return fileDescriptor_7a4218a80d7ff35f, []int{21}
 }
 
 func (m *Filter) XXX_Unmarshal(b []byte) error {
@@ -614,7 +779,7 @@ var xxx_messageInfo_Filter proto.InternalMessageInfo
 func (m *Flatten) Reset()      { *m = Flatten{} }
 func (*Flatten) ProtoMessage() {}
 func (*Flatten) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{17}
+	return fileDescriptor_7a4218a80d7ff35f, []int{22}
 }
 
 func (m *Flatten) XXX_Unmarshal(b []byte) error {
@@ -644,10 +809,43 @@ func (m *Flatten) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Flatten proto.InternalMessageInfo
 
+func (m *GRPC) Reset()      { *m = GRPC{} }
+func (*GRPC) ProtoMessage() {}
+func (*GRPC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{23}
+}
+
+func (m *GRPC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GRPC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *GRPC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPC.Merge(m, src)
+}
+
+func (m *GRPC) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *GRPC) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPC proto.InternalMessageInfo
+
 func (m *GetPodSpecReq) Reset()      { *m = GetPodSpecReq{} }
 func (*GetPodSpecReq) ProtoMessage() {}
 func (*GetPodSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{18}
+	return fileDescriptor_7a4218a80d7ff35f, []int{24}
 }
 
 func (m *GetPodSpecReq) XXX_Unmarshal(b []byte) error {
@@ -680,7 +878,7 @@ var xxx_messageInfo_GetPodSpecReq proto.InternalMessageInfo
 func (m *Git) Reset()      { *m = Git{} }
 func (*Git) ProtoMessage() {}
 func (*Git) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{19}
+	return fileDescriptor_7a4218a80d7ff35f, []int{25}
 }
 
 func (m *Git) XXX_Unmarshal(b []byte) error {
@@ -713,7 +911,7 @@ var xxx_messageInfo_Git proto.InternalMessageInfo
 func (m *Group) Reset()      { *m = Group{} }
 func (*Group) ProtoMessage() {}
 func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{20}
+	return fileDescriptor_7a4218a80d7ff35f, []int{26}
 }
 
 func (m *Group) XXX_Unmarshal(b []byte) error {
@@ -746,7 +944,7 @@ var xxx_messageInfo_Group proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{21}
+	return fileDescriptor_7a4218a80d7ff35f, []int{27}
 }
 
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
@@ -779,7 +977,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{22}
+	return fileDescriptor_7a4218a80d7ff35f, []int{28}
 }
 
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
@@ -812,7 +1010,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{23}
+	return fileDescriptor_7a4218a80d7ff35f, []int{29}
 }
 
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
@@ -845,7 +1043,7 @@ var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 func (m *HTTPSink) Reset()      { *m = HTTPSink{} }
 func (*HTTPSink) ProtoMessage() {}
 func (*HTTPSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{24}
+	return fileDescriptor_7a4218a80d7ff35f, []int{30}
 }
 
 func (m *HTTPSink) XXX_Unmarshal(b []byte) error {
@@ -878,7 +1076,7 @@ var xxx_messageInfo_HTTPSink proto.InternalMessageInfo
 func (m *HTTPSource) Reset()      { *m = HTTPSource{} }
 func (*HTTPSource) ProtoMessage() {}
 func (*HTTPSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{25}
+	return fileDescriptor_7a4218a80d7ff35f, []int{31}
 }
 
 func (m *HTTPSource) XXX_Unmarshal(b []byte) error {
@@ -911,7 +1109,7 @@ var xxx_messageInfo_HTTPSource proto.InternalMessageInfo
 func (m *Interface) Reset()      { *m = Interface{} }
 func (*Interface) ProtoMessage() {}
 func (*Interface) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{26}
+	return fileDescriptor_7a4218a80d7ff35f, []int{32}
 }
 
 func (m *Interface) XXX_Unmarshal(b []byte) error {
@@ -944,7 +1142,7 @@ var xxx_messageInfo_Interface proto.InternalMessageInfo
 func (m *JetStream) Reset()      { *m = JetStream{} }
 func (*JetStream) ProtoMessage() {}
 func (*JetStream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{27}
+	return fileDescriptor_7a4218a80d7ff35f, []int{33}
 }
 
 func (m *JetStream) XXX_Unmarshal(b []byte) error {
@@ -977,7 +1175,7 @@ var xxx_messageInfo_JetStream proto.InternalMessageInfo
 func (m *JetStreamSink) Reset()      { *m = JetStreamSink{} }
 func (*JetStreamSink) ProtoMessage() {}
 func (*JetStreamSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{28}
+	return fileDescriptor_7a4218a80d7ff35f, []int{34}
 }
 
 func (m *JetStreamSink) XXX_Unmarshal(b []byte) error {
@@ -1010,7 +1208,7 @@ var xxx_messageInfo_JetStreamSink proto.InternalMessageInfo
 func (m *JetStreamSource) Reset()      { *m = JetStreamSource{} }
 func (*JetStreamSource) ProtoMessage() {}
 func (*JetStreamSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{29}
+	return fileDescriptor_7a4218a80d7ff35f, []int{35}
 }
 
 func (m *JetStreamSource) XXX_Unmarshal(b []byte) error {
@@ -1040,10 +1238,76 @@ func (m *JetStreamSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JetStreamSource proto.InternalMessageInfo
 
+func (m *Join) Reset()      { *m = Join{} }
+func (*Join) ProtoMessage() {}
+func (*Join) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{36}
+}
+
+func (m *Join) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Join) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *Join) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Join.Merge(m, src)
+}
+
+func (m *Join) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Join) XXX_DiscardUnknown() {
+	xxx_messageInfo_Join.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Join proto.InternalMessageInfo
+
+func (m *JoinSide) Reset()      { *m = JoinSide{} }
+func (*JoinSide) ProtoMessage() {}
+func (*JoinSide) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{37}
+}
+
+func (m *JoinSide) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *JoinSide) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *JoinSide) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinSide.Merge(m, src)
+}
+
+func (m *JoinSide) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *JoinSide) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinSide.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinSide proto.InternalMessageInfo
+
 func (m *Kafka) Reset()      { *m = Kafka{} }
 func (*Kafka) ProtoMessage() {}
 func (*Kafka) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{30}
+	return fileDescriptor_7a4218a80d7ff35f, []int{38}
 }
 
 func (m *Kafka) XXX_Unmarshal(b []byte) error {
@@ -1076,7 +1340,7 @@ var xxx_messageInfo_Kafka proto.InternalMessageInfo
 func (m *KafkaConfig) Reset()      { *m = KafkaConfig{} }
 func (*KafkaConfig) ProtoMessage() {}
 func (*KafkaConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{31}
+	return fileDescriptor_7a4218a80d7ff35f, []int{39}
 }
 
 func (m *KafkaConfig) XXX_Unmarshal(b []byte) error {
@@ -1109,7 +1373,7 @@ var xxx_messageInfo_KafkaConfig proto.InternalMessageInfo
 func (m *KafkaNET) Reset()      { *m = KafkaNET{} }
 func (*KafkaNET) ProtoMessage() {}
 func (*KafkaNET) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{32}
+	return fileDescriptor_7a4218a80d7ff35f, []int{40}
 }
 
 func (m *KafkaNET) XXX_Unmarshal(b []byte) error {
@@ -1142,7 +1406,7 @@ var xxx_messageInfo_KafkaNET proto.InternalMessageInfo
 func (m *KafkaSink) Reset()      { *m = KafkaSink{} }
 func (*KafkaSink) ProtoMessage() {}
 func (*KafkaSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{33}
+	return fileDescriptor_7a4218a80d7ff35f, []int{41}
 }
 
 func (m *KafkaSink) XXX_Unmarshal(b []byte) error {
@@ -1175,7 +1439,7 @@ var xxx_messageInfo_KafkaSink proto.InternalMessageInfo
 func (m *KafkaSource) Reset()      { *m = KafkaSource{} }
 func (*KafkaSource) ProtoMessage() {}
 func (*KafkaSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{34}
+	return fileDescriptor_7a4218a80d7ff35f, []int{42}
 }
 
 func (m *KafkaSource) XXX_Unmarshal(b []byte) error {
@@ -1208,7 +1472,7 @@ var xxx_messageInfo_KafkaSource proto.InternalMessageInfo
 func (m *Log) Reset()      { *m = Log{} }
 func (*Log) ProtoMessage() {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{35}
+	return fileDescriptor_7a4218a80d7ff35f, []int{43}
 }
 
 func (m *Log) XXX_Unmarshal(b []byte) error {
@@ -1241,7 +1505,7 @@ var xxx_messageInfo_Log proto.InternalMessageInfo
 func (m *Map) Reset()      { *m = Map{} }
 func (*Map) ProtoMessage() {}
 func (*Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{36}
+	return fileDescriptor_7a4218a80d7ff35f, []int{44}
 }
 
 func (m *Map) XXX_Unmarshal(b []byte) error {
@@ -1274,7 +1538,7 @@ var xxx_messageInfo_Map proto.InternalMessageInfo
 func (m *Meta) Reset()      { *m = Meta{} }
 func (*Meta) ProtoMessage() {}
 func (*Meta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{37}
+	return fileDescriptor_7a4218a80d7ff35f, []int{45}
 }
 
 func (m *Meta) XXX_Unmarshal(b []byte) error {
@@ -1307,7 +1571,7 @@ var xxx_messageInfo_Meta proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{38}
+	return fileDescriptor_7a4218a80d7ff35f, []int{46}
 }
 
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
@@ -1340,7 +1604,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *NATSAuth) Reset()      { *m = NATSAuth{} }
 func (*NATSAuth) ProtoMessage() {}
 func (*NATSAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{39}
+	return fileDescriptor_7a4218a80d7ff35f, []int{47}
 }
 
 func (m *NATSAuth) XXX_Unmarshal(b []byte) error {
@@ -1373,7 +1637,7 @@ var xxx_messageInfo_NATSAuth proto.InternalMessageInfo
 func (m *Pipeline) Reset()      { *m = Pipeline{} }
 func (*Pipeline) ProtoMessage() {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{40}
+	return fileDescriptor_7a4218a80d7ff35f, []int{48}
 }
 
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
@@ -1406,7 +1670,7 @@ var xxx_messageInfo_Pipeline proto.InternalMessageInfo
 func (m *PipelineList) Reset()      { *m = PipelineList{} }
 func (*PipelineList) ProtoMessage() {}
 func (*PipelineList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{41}
+	return fileDescriptor_7a4218a80d7ff35f, []int{49}
 }
 
 func (m *PipelineList) XXX_Unmarshal(b []byte) error {
@@ -1439,7 +1703,7 @@ var xxx_messageInfo_PipelineList proto.InternalMessageInfo
 func (m *PipelineSpec) Reset()      { *m = PipelineSpec{} }
 func (*PipelineSpec) ProtoMessage() {}
 func (*PipelineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{42}
+	return fileDescriptor_7a4218a80d7ff35f, []int{50}
 }
 
 func (m *PipelineSpec) XXX_Unmarshal(b []byte) error {
@@ -1472,7 +1736,7 @@ var xxx_messageInfo_PipelineSpec proto.InternalMessageInfo
 func (m *PipelineStatus) Reset()      { *m = PipelineStatus{} }
 func (*PipelineStatus) ProtoMessage() {}
 func (*PipelineStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{43}
+	return fileDescriptor_7a4218a80d7ff35f, []int{51}
 }
 
 func (m *PipelineStatus) XXX_Unmarshal(b []byte) error {
@@ -1502,10 +1766,142 @@ func (m *PipelineStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PipelineStatus proto.InternalMessageInfo
 
-func (m *S3) Reset()      { *m = S3{} }
-func (*S3) ProtoMessage() {}
+func (m *RateLimit) Reset()      { *m = RateLimit{} }
+func (*RateLimit) ProtoMessage() {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{52}
+}
+
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RedisStore) Reset()      { *m = RedisStore{} }
+func (*RedisStore) ProtoMessage() {}
+func (*RedisStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{53}
+}
+
+func (m *RedisStore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RedisStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *RedisStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedisStore.Merge(m, src)
+}
+
+func (m *RedisStore) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RedisStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedisStore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedisStore proto.InternalMessageInfo
+
+func (m *Replay) Reset()      { *m = Replay{} }
+func (*Replay) ProtoMessage() {}
+func (*Replay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{54}
+}
+
+func (m *Replay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Replay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *Replay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay.Merge(m, src)
+}
+
+func (m *Replay) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Replay) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Replay proto.InternalMessageInfo
+
+func (m *RollingFile) Reset()      { *m = RollingFile{} }
+func (*RollingFile) ProtoMessage() {}
+func (*RollingFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{55}
+}
+
+func (m *RollingFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RollingFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *RollingFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollingFile.Merge(m, src)
+}
+
+func (m *RollingFile) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RollingFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollingFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollingFile proto.InternalMessageInfo
+
+func (m *S3) Reset()      { *m = S3{} }
+func (*S3) ProtoMessage() {}
 func (*S3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{44}
+	return fileDescriptor_7a4218a80d7ff35f, []int{56}
 }
 
 func (m *S3) XXX_Unmarshal(b []byte) error {
@@ -1538,7 +1934,7 @@ var xxx_messageInfo_S3 proto.InternalMessageInfo
 func (m *S3Sink) Reset()      { *m = S3Sink{} }
 func (*S3Sink) ProtoMessage() {}
 func (*S3Sink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{45}
+	return fileDescriptor_7a4218a80d7ff35f, []int{57}
 }
 
 func (m *S3Sink) XXX_Unmarshal(b []byte) error {
@@ -1571,7 +1967,7 @@ var xxx_messageInfo_S3Sink proto.InternalMessageInfo
 func (m *S3Source) Reset()      { *m = S3Source{} }
 func (*S3Source) ProtoMessage() {}
 func (*S3Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{46}
+	return fileDescriptor_7a4218a80d7ff35f, []int{58}
 }
 
 func (m *S3Source) XXX_Unmarshal(b []byte) error {
@@ -1604,7 +2000,7 @@ var xxx_messageInfo_S3Source proto.InternalMessageInfo
 func (m *SASL) Reset()      { *m = SASL{} }
 func (*SASL) ProtoMessage() {}
 func (*SASL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{47}
+	return fileDescriptor_7a4218a80d7ff35f, []int{59}
 }
 
 func (m *SASL) XXX_Unmarshal(b []byte) error {
@@ -1637,7 +2033,7 @@ var xxx_messageInfo_SASL proto.InternalMessageInfo
 func (m *SQLAction) Reset()      { *m = SQLAction{} }
 func (*SQLAction) ProtoMessage() {}
 func (*SQLAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{48}
+	return fileDescriptor_7a4218a80d7ff35f, []int{60}
 }
 
 func (m *SQLAction) XXX_Unmarshal(b []byte) error {
@@ -1670,7 +2066,7 @@ var xxx_messageInfo_SQLAction proto.InternalMessageInfo
 func (m *SQLStatement) Reset()      { *m = SQLStatement{} }
 func (*SQLStatement) ProtoMessage() {}
 func (*SQLStatement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{49}
+	return fileDescriptor_7a4218a80d7ff35f, []int{61}
 }
 
 func (m *SQLStatement) XXX_Unmarshal(b []byte) error {
@@ -1703,7 +2099,7 @@ var xxx_messageInfo_SQLStatement proto.InternalMessageInfo
 func (m *STAN) Reset()      { *m = STAN{} }
 func (*STAN) ProtoMessage() {}
 func (*STAN) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{50}
+	return fileDescriptor_7a4218a80d7ff35f, []int{62}
 }
 
 func (m *STAN) XXX_Unmarshal(b []byte) error {
@@ -1736,7 +2132,7 @@ var xxx_messageInfo_STAN proto.InternalMessageInfo
 func (m *Scale) Reset()      { *m = Scale{} }
 func (*Scale) ProtoMessage() {}
 func (*Scale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{51}
+	return fileDescriptor_7a4218a80d7ff35f, []int{63}
 }
 
 func (m *Scale) XXX_Unmarshal(b []byte) error {
@@ -1766,10 +2162,109 @@ func (m *Scale) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Scale proto.InternalMessageInfo
 
+func (m *SchemaRegistry) Reset()      { *m = SchemaRegistry{} }
+func (*SchemaRegistry) ProtoMessage() {}
+func (*SchemaRegistry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{64}
+}
+
+func (m *SchemaRegistry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SchemaRegistry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *SchemaRegistry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaRegistry.Merge(m, src)
+}
+
+func (m *SchemaRegistry) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SchemaRegistry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaRegistry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaRegistry proto.InternalMessageInfo
+
+func (m *SchemaRegistrySink) Reset()      { *m = SchemaRegistrySink{} }
+func (*SchemaRegistrySink) ProtoMessage() {}
+func (*SchemaRegistrySink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{65}
+}
+
+func (m *SchemaRegistrySink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SchemaRegistrySink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *SchemaRegistrySink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaRegistrySink.Merge(m, src)
+}
+
+func (m *SchemaRegistrySink) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SchemaRegistrySink) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaRegistrySink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaRegistrySink proto.InternalMessageInfo
+
+func (m *SessionWindow) Reset()      { *m = SessionWindow{} }
+func (*SessionWindow) ProtoMessage() {}
+func (*SessionWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{66}
+}
+
+func (m *SessionWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SessionWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *SessionWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionWindow.Merge(m, src)
+}
+
+func (m *SessionWindow) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SessionWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionWindow proto.InternalMessageInfo
+
 func (m *Sidecar) Reset()      { *m = Sidecar{} }
 func (*Sidecar) ProtoMessage() {}
 func (*Sidecar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{52}
+	return fileDescriptor_7a4218a80d7ff35f, []int{67}
 }
 
 func (m *Sidecar) XXX_Unmarshal(b []byte) error {
@@ -1802,7 +2297,7 @@ var xxx_messageInfo_Sidecar proto.InternalMessageInfo
 func (m *Sink) Reset()      { *m = Sink{} }
 func (*Sink) ProtoMessage() {}
 func (*Sink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{53}
+	return fileDescriptor_7a4218a80d7ff35f, []int{68}
 }
 
 func (m *Sink) XXX_Unmarshal(b []byte) error {
@@ -1832,10 +2327,43 @@ func (m *Sink) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sink proto.InternalMessageInfo
 
+func (m *SlidingWindow) Reset()      { *m = SlidingWindow{} }
+func (*SlidingWindow) ProtoMessage() {}
+func (*SlidingWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{69}
+}
+
+func (m *SlidingWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SlidingWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *SlidingWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlidingWindow.Merge(m, src)
+}
+
+func (m *SlidingWindow) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SlidingWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlidingWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlidingWindow proto.InternalMessageInfo
+
 func (m *Source) Reset()      { *m = Source{} }
 func (*Source) ProtoMessage() {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{54}
+	return fileDescriptor_7a4218a80d7ff35f, []int{70}
 }
 
 func (m *Source) XXX_Unmarshal(b []byte) error {
@@ -1868,7 +2396,7 @@ var xxx_messageInfo_Source proto.InternalMessageInfo
 func (m *Step) Reset()      { *m = Step{} }
 func (*Step) ProtoMessage() {}
 func (*Step) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{55}
+	return fileDescriptor_7a4218a80d7ff35f, []int{71}
 }
 
 func (m *Step) XXX_Unmarshal(b []byte) error {
@@ -1901,7 +2429,7 @@ var xxx_messageInfo_Step proto.InternalMessageInfo
 func (m *StepList) Reset()      { *m = StepList{} }
 func (*StepList) ProtoMessage() {}
 func (*StepList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{56}
+	return fileDescriptor_7a4218a80d7ff35f, []int{72}
 }
 
 func (m *StepList) XXX_Unmarshal(b []byte) error {
@@ -1934,7 +2462,7 @@ var xxx_messageInfo_StepList proto.InternalMessageInfo
 func (m *StepSpec) Reset()      { *m = StepSpec{} }
 func (*StepSpec) ProtoMessage() {}
 func (*StepSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{57}
+	return fileDescriptor_7a4218a80d7ff35f, []int{73}
 }
 
 func (m *StepSpec) XXX_Unmarshal(b []byte) error {
@@ -1967,7 +2495,7 @@ var xxx_messageInfo_StepSpec proto.InternalMessageInfo
 func (m *StepStatus) Reset()      { *m = StepStatus{} }
 func (*StepStatus) ProtoMessage() {}
 func (*StepStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{58}
+	return fileDescriptor_7a4218a80d7ff35f, []int{74}
 }
 
 func (m *StepStatus) XXX_Unmarshal(b []byte) error {
@@ -2000,7 +2528,7 @@ var xxx_messageInfo_StepStatus proto.InternalMessageInfo
 func (m *Storage) Reset()      { *m = Storage{} }
 func (*Storage) ProtoMessage() {}
 func (*Storage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{59}
+	return fileDescriptor_7a4218a80d7ff35f, []int{75}
 }
 
 func (m *Storage) XXX_Unmarshal(b []byte) error {
@@ -2033,7 +2561,7 @@ var xxx_messageInfo_Storage proto.InternalMessageInfo
 func (m *TLS) Reset()      { *m = TLS{} }
 func (*TLS) ProtoMessage() {}
 func (*TLS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{60}
+	return fileDescriptor_7a4218a80d7ff35f, []int{76}
 }
 
 func (m *TLS) XXX_Unmarshal(b []byte) error {
@@ -2063,10 +2591,43 @@ func (m *TLS) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TLS proto.InternalMessageInfo
 
+func (m *TumblingWindow) Reset()      { *m = TumblingWindow{} }
+func (*TumblingWindow) ProtoMessage() {}
+func (*TumblingWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{77}
+}
+
+func (m *TumblingWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TumblingWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *TumblingWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TumblingWindow.Merge(m, src)
+}
+
+func (m *TumblingWindow) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TumblingWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TumblingWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TumblingWindow proto.InternalMessageInfo
+
 func (m *VolumeSink) Reset()      { *m = VolumeSink{} }
 func (*VolumeSink) ProtoMessage() {}
 func (*VolumeSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{61}
+	return fileDescriptor_7a4218a80d7ff35f, []int{78}
 }
 
 func (m *VolumeSink) XXX_Unmarshal(b []byte) error {
@@ -2099,7 +2660,7 @@ var xxx_messageInfo_VolumeSink proto.InternalMessageInfo
 func (m *VolumeSource) Reset()      { *m = VolumeSource{} }
 func (*VolumeSource) ProtoMessage() {}
 func (*VolumeSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a4218a80d7ff35f, []int{62}
+	return fileDescriptor_7a4218a80d7ff35f, []int{79}
 }
 
 func (m *VolumeSource) XXX_Unmarshal(b []byte) error {
@@ -2129,13 +2690,52 @@ func (m *VolumeSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VolumeSource proto.InternalMessageInfo
 
+func (m *Window) Reset()      { *m = Window{} }
+func (*Window) ProtoMessage() {}
+func (*Window) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a4218a80d7ff35f, []int{80}
+}
+
+func (m *Window) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Window) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+
+func (m *Window) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Window.Merge(m, src)
+}
+
+func (m *Window) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Window) XXX_DiscardUnknown() {
+	xxx_messageInfo_Window.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Window proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AWSCredentials)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.AWSCredentials")
 	proto.RegisterType((*AWSEndpoint)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.AWSEndpoint")
 	proto.RegisterType((*AbstractStep)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.AbstractStep")
 	proto.RegisterType((*AbstractVolumeSource)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.AbstractVolumeSource")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Backoff")
+	proto.RegisterType((*BatchMessage)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.BatchMessage")
+	proto.RegisterType((*BatchOutput)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.BatchOutput")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.BatchOutput.HeadersEntry")
+	proto.RegisterType((*BatchResult)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.BatchResult")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.BatchResult.HeadersEntry")
 	proto.RegisterType((*Cat)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Cat")
+	proto.RegisterType((*CircuitBreaker)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.CircuitBreaker")
 	proto.RegisterType((*Code)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Code")
 	proto.RegisterType((*Container)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Container")
 	proto.RegisterType((*Cron)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Cron")
@@ -2144,10 +2744,12 @@ func init() {
 	proto.RegisterType((*DBSink)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.DBSink")
 	proto.RegisterType((*DBSource)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.DBSource")
 	proto.RegisterType((*Database)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Database")
+	proto.RegisterType((*DeadLetter)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.DeadLetter")
 	proto.RegisterType((*Dedupe)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Dedupe")
 	proto.RegisterType((*Expand)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Expand")
 	proto.RegisterType((*Filter)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Filter")
 	proto.RegisterType((*Flatten)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Flatten")
+	proto.RegisterType((*GRPC)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.GRPC")
 	proto.RegisterType((*GetPodSpecReq)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.GetPodSpecReq")
 	proto.RegisterType((*Git)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Git")
 	proto.RegisterType((*Group)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Group")
@@ -2160,14 +2762,18 @@ func init() {
 	proto.RegisterType((*JetStream)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.JetStream")
 	proto.RegisterType((*JetStreamSink)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.JetStreamSink")
 	proto.RegisterType((*JetStreamSource)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.JetStreamSource")
+	proto.RegisterType((*Join)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Join")
+	proto.RegisterType((*JoinSide)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.JoinSide")
 	proto.RegisterType((*Kafka)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Kafka")
 	proto.RegisterType((*KafkaConfig)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.KafkaConfig")
 	proto.RegisterType((*KafkaNET)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.KafkaNET")
 	proto.RegisterType((*KafkaSink)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.KafkaSink")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.KafkaSink.HeadersEntry")
 	proto.RegisterType((*KafkaSource)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.KafkaSource")
 	proto.RegisterType((*Log)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Log")
 	proto.RegisterType((*Map)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Map")
 	proto.RegisterType((*Meta)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Meta")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Meta.HeadersEntry")
 	proto.RegisterType((*Metadata)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Metadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Metadata.LabelsEntry")
@@ -2176,6 +2782,11 @@ func init() {
 	proto.RegisterType((*PipelineList)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.PipelineList")
 	proto.RegisterType((*PipelineSpec)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.PipelineSpec")
 	proto.RegisterType((*PipelineStatus)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.PipelineStatus")
+	proto.RegisterMapType((map[string]uint32)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.PipelineStatus.SuspendedReplicasEntry")
+	proto.RegisterType((*RateLimit)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.RateLimit")
+	proto.RegisterType((*RedisStore)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.RedisStore")
+	proto.RegisterType((*Replay)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Replay")
+	proto.RegisterType((*RollingFile)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.RollingFile")
 	proto.RegisterType((*S3)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.S3")
 	proto.RegisterType((*S3Sink)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.S3Sink")
 	proto.RegisterType((*S3Source)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.S3Source")
@@ -2184,8 +2795,12 @@ func init() {
 	proto.RegisterType((*SQLStatement)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.SQLStatement")
 	proto.RegisterType((*STAN)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.STAN")
 	proto.RegisterType((*Scale)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Scale")
+	proto.RegisterType((*SchemaRegistry)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.SchemaRegistry")
+	proto.RegisterType((*SchemaRegistrySink)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.SchemaRegistrySink")
+	proto.RegisterType((*SessionWindow)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.SessionWindow")
 	proto.RegisterType((*Sidecar)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Sidecar")
 	proto.RegisterType((*Sink)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Sink")
+	proto.RegisterType((*SlidingWindow)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.SlidingWindow")
 	proto.RegisterType((*Source)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Source")
 	proto.RegisterType((*Step)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Step")
 	proto.RegisterType((*StepList)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.StepList")
@@ -2194,8 +2809,10 @@ func init() {
 	proto.RegisterType((*StepStatus)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.StepStatus")
 	proto.RegisterType((*Storage)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Storage")
 	proto.RegisterType((*TLS)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.TLS")
+	proto.RegisterType((*TumblingWindow)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.TumblingWindow")
 	proto.RegisterType((*VolumeSink)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.VolumeSink")
 	proto.RegisterType((*VolumeSource)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.VolumeSource")
+	proto.RegisterType((*Window)(nil), "github.com.argoproj_labs.argo_dataflow.api.v1alpha1.Window")
 }
 
 func init() {
@@ -2203,346 +2820,446 @@ func init() {
 }
 
 var fileDescriptor_7a4218a80d7ff35f = []byte{
-	// 5415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4b, 0x8c, 0x1c, 0xc7,
-	0x79, 0xbf, 0x66, 0x66, 0x77, 0x76, 0xe6, 0xdb, 0x07, 0x97, 0x25, 0xd2, 0x1e, 0xf3, 0x2f, 0x71,
-	0x89, 0xd6, 0xdf, 0xb2, 0x9c, 0xc8, 0xbb, 0x12, 0x29, 0x21, 0x92, 0x1d, 0x3f, 0x76, 0x66, 0x77,
-	0xa9, 0x11, 0xf7, 0xc5, 0xea, 0x25, 0x65, 0x47, 0xb2, 0x98, 0xda, 0xee, 0x9a, 0x99, 0xe6, 0xf4,
-	0x74, 0x37, 0xbb, 0x6a, 0x96, 0x5c, 0xe7, 0x22, 0xc8, 0xb0, 0x01, 0x1d, 0x02, 0xe4, 0x9e, 0x43,
-	0x80, 0x00, 0x41, 0x80, 0x1c, 0x03, 0x24, 0x88, 0x2f, 0x06, 0x72, 0xb2, 0x80, 0x5c, 0x14, 0xe4,
-	0x10, 0xc3, 0x01, 0x16, 0xd2, 0x26, 0xa7, 0xdc, 0x92, 0x83, 0x0f, 0xbc, 0x24, 0xa8, 0x57, 0x3f,
-	0xe6, 0x41, 0xed, 0xce, 0xe8, 0xe1, 0xdc, 0xa6, 0xeb, 0xfb, 0xea, 0xf7, 0xd5, 0xf3, 0xab, 0xef,
-	0x51, 0x35, 0xd0, 0x68, 0x7b, 0xbc, 0xd3, 0x3f, 0x5c, 0x75, 0xc2, 0xde, 0x1a, 0x89, 0xdb, 0x61,
-	0x14, 0x87, 0xf7, 0xbf, 0xe5, 0x93, 0x43, 0x26, 0xbf, 0xbe, 0xe5, 0x12, 0x4e, 0x5a, 0x7e, 0xf8,
-	0x70, 0x8d, 0x44, 0xde, 0xda, 0xd1, 0xcb, 0xc4, 0x8f, 0x3a, 0xe4, 0xe5, 0xb5, 0x36, 0x0d, 0x68,
-	0x4c, 0x38, 0x75, 0x57, 0xa3, 0x38, 0xe4, 0x21, 0xba, 0x91, 0x82, 0xac, 0x1a, 0x90, 0x7b, 0x02,
-	0x44, 0x7e, 0xdd, 0x33, 0x20, 0xab, 0x24, 0xf2, 0x56, 0x0d, 0xc8, 0x95, 0x6f, 0x65, 0x24, 0xb7,
-	0xc3, 0x76, 0xb8, 0x26, 0xb1, 0x0e, 0xfb, 0x2d, 0xf9, 0x25, 0x3f, 0xe4, 0x2f, 0x25, 0xe3, 0x8a,
-	0xd5, 0x7d, 0x8d, 0xad, 0x7a, 0xa1, 0x6c, 0x88, 0x13, 0xc6, 0x74, 0xed, 0x68, 0xa8, 0x1d, 0x57,
-	0x5e, 0x49, 0x79, 0x7a, 0xc4, 0xe9, 0x78, 0x01, 0x8d, 0x8f, 0xd7, 0xa2, 0x6e, 0x5b, 0x56, 0x8a,
-	0x29, 0x0b, 0xfb, 0xb1, 0x43, 0xcf, 0x55, 0x8b, 0xad, 0xf5, 0x28, 0x27, 0xa3, 0x64, 0xdd, 0x18,
-	0x57, 0xab, 0xcf, 0x3d, 0x7f, 0xcd, 0x0b, 0x38, 0xe3, 0xf1, 0x60, 0x25, 0xeb, 0x17, 0x45, 0x58,
-	0x5a, 0x7f, 0xcb, 0x6e, 0xc4, 0xd4, 0xa5, 0x01, 0xf7, 0x88, 0xcf, 0xd0, 0x3b, 0x30, 0x4f, 0x1c,
-	0x87, 0x32, 0x76, 0x8b, 0x1e, 0x37, 0xdd, 0x5a, 0xe1, 0x5a, 0xe1, 0x85, 0xf9, 0xeb, 0x5f, 0x5f,
-	0x55, 0xe8, 0x72, 0xc4, 0x44, 0x6f, 0x57, 0x8f, 0x5e, 0x5e, 0xb5, 0xa9, 0x13, 0x53, 0x7e, 0x8b,
-	0x1e, 0xdb, 0xd4, 0xa7, 0x0e, 0x0f, 0xe3, 0xfa, 0xd3, 0x1f, 0x9e, 0xac, 0x3c, 0x75, 0x7a, 0xb2,
-	0x32, 0xbf, 0x9e, 0x20, 0x6c, 0xe0, 0x2c, 0x1c, 0xea, 0xc0, 0x05, 0x26, 0xab, 0x25, 0x1c, 0xb5,
-	0xe2, 0x79, 0x24, 0x7c, 0x55, 0x4b, 0xb8, 0x60, 0xe7, 0x51, 0xf0, 0x20, 0x2c, 0xba, 0x07, 0x0b,
-	0x8c, 0x32, 0xe6, 0x85, 0xc1, 0x41, 0xd8, 0xa5, 0x41, 0xad, 0x74, 0x1e, 0x31, 0x97, 0xb4, 0x98,
-	0x05, 0x3b, 0x03, 0x81, 0x73, 0x80, 0xd6, 0x8b, 0x30, 0xbf, 0xfe, 0x96, 0xbd, 0x19, 0xb8, 0x51,
-	0xe8, 0x05, 0x1c, 0x3d, 0x0b, 0xa5, 0x7e, 0xec, 0xcb, 0xf1, 0xaa, 0xd6, 0xe7, 0x75, 0xfd, 0xd2,
-	0x1d, 0xbc, 0x8d, 0x45, 0xb9, 0xe5, 0xc1, 0xc2, 0xfa, 0x21, 0xe3, 0x31, 0x71, 0xb8, 0xcd, 0x69,
-	0x84, 0x7e, 0x04, 0x55, 0xb3, 0x00, 0x98, 0x1e, 0xe4, 0x17, 0x46, 0xb5, 0x0d, 0x6b, 0x26, 0x4c,
-	0x1f, 0xf4, 0xbd, 0x98, 0xf6, 0x68, 0xc0, 0x59, 0xfd, 0xa2, 0x86, 0xaf, 0x1a, 0x2a, 0xc3, 0x29,
-	0x9a, 0xf5, 0x97, 0x97, 0xe0, 0x92, 0x91, 0x75, 0x37, 0xf4, 0xfb, 0x3d, 0x6a, 0x4b, 0x0a, 0xc2,
-	0x50, 0xe9, 0x84, 0x8c, 0xef, 0x13, 0xde, 0x79, 0x92, 0xc8, 0x37, 0x34, 0x4f, 0xb6, 0x6e, 0x7d,
-	0xe1, 0xf4, 0x64, 0xa5, 0x62, 0x28, 0x38, 0xc1, 0x11, 0x98, 0xb4, 0x17, 0xf1, 0xe3, 0x0d, 0x2f,
-	0xd6, 0x33, 0x39, 0x12, 0x73, 0x53, 0xf3, 0x0c, 0x63, 0x1a, 0x0a, 0x4e, 0x70, 0xd0, 0x11, 0x5c,
-	0x6c, 0x3b, 0x74, 0x9f, 0xc6, 0xcc, 0x63, 0x9c, 0x06, 0x7c, 0xc3, 0x63, 0x5d, 0x3d, 0x7f, 0x2f,
-	0x8f, 0x02, 0xbf, 0xd9, 0xd8, 0xcc, 0x33, 0xe7, 0xa4, 0x5c, 0x3e, 0x3d, 0x59, 0xb9, 0x38, 0xc4,
-	0x82, 0x87, 0x45, 0xa0, 0xf7, 0x0b, 0x70, 0x89, 0x3c, 0x64, 0x9b, 0x3e, 0x61, 0xdc, 0x73, 0xea,
-	0x7e, 0xe8, 0x74, 0x6d, 0x1e, 0xc6, 0xb4, 0x36, 0x23, 0x65, 0xbf, 0x32, 0x4a, 0xb6, 0x58, 0x02,
-	0x83, 0xfc, 0x39, 0xf1, 0xb5, 0xd3, 0x93, 0x95, 0x4b, 0xa3, 0xb8, 0xf0, 0x48, 0x59, 0x68, 0x17,
-	0xe6, 0xda, 0x1e, 0xc7, 0x34, 0x0a, 0x6b, 0xb3, 0x52, 0xec, 0x37, 0x46, 0x76, 0x59, 0xb1, 0xe4,
-	0x24, 0xcd, 0x9f, 0x9e, 0xac, 0xcc, 0x69, 0x02, 0x36, 0x20, 0xe8, 0x4d, 0x28, 0xab, 0xad, 0x51,
-	0x2b, 0x4b, 0xb8, 0xe7, 0xc7, 0xef, 0x80, 0x1c, 0x1a, 0x9c, 0x9e, 0xac, 0x94, 0x55, 0x39, 0xd6,
-	0x08, 0xe8, 0x7b, 0x50, 0x0a, 0x5a, 0xac, 0x36, 0x27, 0x81, 0x9e, 0x1b, 0x05, 0xb4, 0xbb, 0x65,
-	0xe7, 0x50, 0xe6, 0xc4, 0x26, 0xd8, 0xdd, 0xb2, 0xb1, 0xa8, 0x88, 0xb6, 0x60, 0xd6, 0x63, 0x0e,
-	0xf3, 0x6a, 0x95, 0xf1, 0x9b, 0xb1, 0x69, 0x37, 0xec, 0x66, 0x0e, 0xa3, 0x7a, 0x7a, 0xb2, 0x32,
-	0x2b, 0x8b, 0xb1, 0xaa, 0x8e, 0xee, 0x42, 0xb5, 0xed, 0xf7, 0x19, 0xa7, 0x71, 0x8b, 0xd5, 0xaa,
-	0x12, 0xeb, 0x9b, 0x23, 0x47, 0xc9, 0x30, 0xe5, 0xf0, 0x16, 0xc5, 0xce, 0x49, 0x48, 0x38, 0x85,
-	0x42, 0x3f, 0x2f, 0xc0, 0xe5, 0x28, 0x59, 0x13, 0xaa, 0x52, 0xc3, 0x27, 0x5e, 0xaf, 0x06, 0x52,
-	0xc8, 0xab, 0xa3, 0x84, 0xec, 0x8f, 0xaa, 0x90, 0x13, 0xf8, 0xb5, 0xd3, 0x93, 0x95, 0xcb, 0x23,
-	0xd9, 0xf0, 0x68, 0x71, 0x62, 0xa0, 0xe3, 0x43, 0xb7, 0x36, 0x3f, 0x7e, 0xa0, 0x71, 0x7d, 0x63,
-	0x78, 0xa0, 0x71, 0x7d, 0x03, 0x8b, 0x8a, 0xe8, 0x00, 0xa0, 0xe5, 0xd3, 0x47, 0x8a, 0xa3, 0xb6,
-	0x20, 0x61, 0xfe, 0xff, 0x28, 0x98, 0xad, 0x84, 0x4b, 0xe3, 0x2c, 0x9d, 0x9e, 0xac, 0x40, 0x5a,
-	0x8a, 0x33, 0x38, 0x62, 0x29, 0x39, 0x5e, 0xe0, 0xd2, 0xb8, 0xb6, 0x38, 0x7e, 0x29, 0x35, 0x24,
-	0xc7, 0xf0, 0x52, 0x52, 0xe5, 0x58, 0x23, 0x48, 0x2c, 0x1a, 0x75, 0x5a, 0xac, 0xb6, 0xf4, 0x04,
-	0x2c, 0x1a, 0x75, 0x06, 0x16, 0x94, 0xc2, 0x92, 0xe5, 0x58, 0x23, 0x88, 0x2d, 0xd3, 0x12, 0x1b,
-	0x88, 0xc6, 0xb5, 0x0b, 0xe3, 0xb7, 0xcc, 0x96, 0x62, 0x19, 0xde, 0x32, 0x9a, 0x80, 0x0d, 0x08,
-	0x7a, 0x17, 0xe6, 0xdd, 0xf0, 0x61, 0xf0, 0x90, 0xc4, 0xee, 0xfa, 0x7e, 0xb3, 0xb6, 0x2c, 0x31,
-	0x7f, 0x7f, 0x14, 0xe6, 0x46, 0xca, 0x96, 0xc3, 0xbd, 0x20, 0x0e, 0xc1, 0x0c, 0x11, 0x67, 0x01,
-	0xd1, 0xb7, 0xa1, 0xd8, 0x72, 0x6a, 0x17, 0x25, 0xac, 0x35, 0xb2, 0xa9, 0x8d, 0x1c, 0x5a, 0xf9,
-	0xf4, 0x64, 0xa5, 0xb8, 0xd5, 0xc0, 0xc5, 0x96, 0x23, 0x96, 0x3e, 0xf9, 0x49, 0x3f, 0xa6, 0x5b,
-	0x9e, 0x4f, 0x6b, 0x68, 0xfc, 0xd2, 0x5f, 0x37, 0x4c, 0xc3, 0x4b, 0x3f, 0x21, 0xe1, 0x14, 0x4a,
-	0xe0, 0x3a, 0x61, 0xd0, 0xf2, 0xda, 0x3b, 0x24, 0xaa, 0x3d, 0x3d, 0x1e, 0xb7, 0x61, 0x98, 0x86,
-	0x71, 0x13, 0x12, 0x4e, 0xa1, 0x50, 0x17, 0x16, 0x8f, 0x58, 0xd4, 0xa1, 0x46, 0x2b, 0xd6, 0x2e,
-	0x49, 0xec, 0xeb, 0xa3, 0xb0, 0xef, 0x6a, 0x46, 0x2f, 0xe6, 0x7d, 0xe2, 0x0f, 0x29, 0xf2, 0x8b,
-	0xa7, 0x27, 0x2b, 0x8b, 0x77, 0xb3, 0x60, 0x38, 0x8f, 0x2d, 0x16, 0xc2, 0x83, 0x7e, 0x78, 0x78,
-	0xcc, 0x69, 0xed, 0xf2, 0xf8, 0x85, 0x70, 0x5b, 0xb1, 0x0c, 0x2f, 0x04, 0x4d, 0xc0, 0x06, 0x24,
-	0x19, 0x6c, 0x79, 0x00, 0x7d, 0xe5, 0x53, 0x06, 0x7b, 0xa8, 0xbd, 0xe9, 0x60, 0xcb, 0x03, 0x27,
-	0x85, 0x92, 0x07, 0x4d, 0xd4, 0x09, 0x79, 0x18, 0x0c, 0x1c, 0x72, 0x5f, 0x1d, 0x7f, 0xd0, 0xec,
-	0x8f, 0xe0, 0x1f, 0x3e, 0x68, 0x46, 0x71, 0xe1, 0x91, 0xb2, 0x44, 0xe7, 0x84, 0x5d, 0x4c, 0x1d,
-	0x4e, 0xdd, 0xda, 0x95, 0xf1, 0x9d, 0xdb, 0x37, 0x4c, 0xc3, 0x9d, 0x4b, 0x48, 0x38, 0x85, 0x42,
-	0x2e, 0x2c, 0x45, 0x61, 0xcc, 0x1f, 0x86, 0xb1, 0xd1, 0x3f, 0xb5, 0xf1, 0x76, 0xc1, 0x7e, 0x8e,
-	0x53, 0x63, 0xa3, 0xd3, 0x93, 0x95, 0xa5, 0x3c, 0x05, 0x0f, 0x60, 0x8a, 0xa9, 0x66, 0x0e, 0xf1,
-	0x69, 0x73, 0xaf, 0xf6, 0xb5, 0xf1, 0x53, 0x6d, 0x2b, 0x96, 0xe1, 0xa9, 0xd6, 0x04, 0x6c, 0x40,
-	0xc4, 0x68, 0x30, 0x1e, 0xc6, 0xa4, 0x4d, 0x43, 0x56, 0xfb, 0x7f, 0xe3, 0x47, 0xc3, 0x56, 0x4c,
-	0x7b, 0xf6, 0xf0, 0x68, 0x24, 0x24, 0x9c, 0x42, 0x09, 0x4d, 0x2e, 0x0e, 0xbc, 0x67, 0xc6, 0x6b,
-	0xf2, 0xc1, 0xe3, 0x4e, 0x6a, 0x72, 0x71, 0xd8, 0x95, 0xf4, 0x51, 0x47, 0xa3, 0x0e, 0xed, 0xd1,
-	0x98, 0xf8, 0xb5, 0x67, 0xc7, 0xb7, 0x6b, 0xd3, 0x30, 0x0d, 0xb7, 0x2b, 0x21, 0xe1, 0x14, 0xca,
-	0xfa, 0xa7, 0x22, 0xcc, 0xd5, 0x89, 0xd3, 0x0d, 0x5b, 0x2d, 0xf4, 0x43, 0xa8, 0xb8, 0xfd, 0x98,
-	0x70, 0x2f, 0x0c, 0xb4, 0xa9, 0xb3, 0x9a, 0x11, 0x91, 0x78, 0x13, 0xab, 0x51, 0xb7, 0x2d, 0x0a,
-	0xd8, 0xaa, 0xf0, 0x41, 0xa4, 0xfa, 0xd3, 0xb5, 0x94, 0x25, 0x67, 0xbe, 0x70, 0x82, 0x86, 0x5e,
-	0x82, 0xe5, 0x2d, 0x22, 0x2c, 0xea, 0x7d, 0x1a, 0x3b, 0x34, 0xe0, 0xa4, 0x4d, 0xa5, 0x55, 0xb3,
-	0x58, 0x9f, 0x11, 0x26, 0x2c, 0x1e, 0xa2, 0xa2, 0xe7, 0x60, 0x96, 0x71, 0x1a, 0x29, 0x9b, 0x78,
-	0xa6, 0xbe, 0xa8, 0x2d, 0xdd, 0x59, 0x61, 0x34, 0x33, 0xac, 0x68, 0xa8, 0x09, 0x25, 0x87, 0x44,
-	0xda, 0xde, 0x3c, 0x6f, 0x5b, 0xd5, 0xf8, 0x92, 0x08, 0x0b, 0x0c, 0xb4, 0x01, 0xcb, 0xf7, 0x3d,
-	0xce, 0x69, 0xb6, 0x85, 0x25, 0xd9, 0xc2, 0x9a, 0x16, 0xbd, 0xfc, 0xe6, 0x00, 0x1d, 0x0f, 0xd5,
-	0xb0, 0xde, 0x2f, 0x40, 0xa9, 0x41, 0x38, 0xfa, 0x13, 0x58, 0x20, 0x19, 0x2b, 0x5f, 0x5b, 0xd9,
-	0xeb, 0xab, 0x13, 0xf8, 0xa3, 0xab, 0x59, 0x77, 0x21, 0x75, 0x48, 0xb2, 0xa5, 0x38, 0x27, 0xcc,
-	0xfa, 0xa0, 0x00, 0x33, 0x8d, 0xd0, 0xa5, 0xe8, 0x15, 0x98, 0x8b, 0xfb, 0x01, 0xf7, 0x7a, 0xca,
-	0x72, 0xad, 0xd6, 0xaf, 0xe8, 0xda, 0x73, 0x58, 0x15, 0x3f, 0x4e, 0x7f, 0x62, 0xc3, 0x2a, 0x46,
-	0xde, 0xeb, 0x99, 0x09, 0xaa, 0xa6, 0x23, 0xdf, 0x14, 0x85, 0x58, 0xd1, 0xd0, 0xf3, 0x50, 0x56,
-	0x6e, 0x86, 0x1c, 0xa4, 0x6a, 0x7d, 0x49, 0x73, 0x95, 0xd5, 0x82, 0xc3, 0x9a, 0x6a, 0xfd, 0xb2,
-	0x04, 0xe2, 0x3c, 0xe0, 0x44, 0xcc, 0x46, 0x0a, 0x5d, 0x78, 0x02, 0xf4, 0x8f, 0x60, 0xe1, 0x48,
-	0xae, 0xdd, 0x9d, 0xb0, 0x1f, 0x70, 0x56, 0x9b, 0xbd, 0x56, 0x7a, 0x61, 0xfe, 0xfa, 0xca, 0xc8,
-	0x83, 0x22, 0xe5, 0x4b, 0x47, 0x26, 0x53, 0xc8, 0x70, 0x0e, 0x0a, 0xdd, 0x85, 0xa2, 0x67, 0x3c,
-	0xc0, 0xef, 0x4d, 0x34, 0x19, 0xcd, 0x40, 0x58, 0x88, 0xc4, 0x1c, 0xc6, 0xcd, 0x00, 0x17, 0xbd,
-	0x00, 0x7d, 0x1d, 0xe6, 0x9c, 0xb0, 0xd7, 0x23, 0x81, 0x5b, 0x2b, 0x5f, 0x2b, 0x09, 0xbf, 0x4f,
-	0x0c, 0x72, 0x43, 0x15, 0x61, 0x43, 0x43, 0xcf, 0xc0, 0x0c, 0x89, 0xdb, 0xc2, 0x6e, 0x16, 0x3c,
-	0x95, 0xd3, 0x93, 0x95, 0x99, 0xf5, 0xb8, 0xcd, 0xb0, 0x2c, 0x45, 0xaf, 0x43, 0x89, 0x06, 0x47,
-	0xb5, 0x8a, 0xec, 0xee, 0x95, 0x91, 0x7b, 0x3b, 0x38, 0xba, 0x4b, 0xe2, 0xd4, 0xa9, 0xdc, 0x0c,
-	0x8e, 0xb0, 0xa8, 0x93, 0x77, 0x22, 0xab, 0x9f, 0xa9, 0x13, 0xf9, 0x0e, 0xcc, 0x34, 0xe2, 0x30,
-	0x40, 0x2f, 0x42, 0x85, 0x39, 0x1d, 0xea, 0xf6, 0x7d, 0x33, 0x7b, 0xcb, 0xba, 0x5e, 0xc5, 0xd6,
-	0xe5, 0x38, 0xe1, 0x10, 0xcb, 0xc3, 0x27, 0xc7, 0x61, 0x9f, 0xcb, 0xbd, 0x99, 0x59, 0x1e, 0xdb,
-	0xb2, 0x14, 0x6b, 0xaa, 0xf5, 0xd7, 0x05, 0x58, 0xd8, 0xa8, 0x6f, 0x10, 0x4e, 0xb4, 0x6b, 0xfa,
-	0x1c, 0xcc, 0x1e, 0x11, 0xbf, 0x3f, 0xb4, 0x42, 0xee, 0x8a, 0x42, 0xac, 0x68, 0x28, 0x86, 0xaa,
-	0xfc, 0xb1, 0x15, 0x87, 0x3d, 0xbd, 0xf9, 0x37, 0x27, 0x9a, 0xcd, 0xac, 0x68, 0x01, 0xa6, 0xf4,
-	0xe4, 0x5d, 0x83, 0x8d, 0x53, 0x31, 0x56, 0x08, 0xcb, 0x83, 0xdc, 0xe8, 0x6d, 0x58, 0x60, 0x26,
-	0x64, 0x80, 0x69, 0xeb, 0x7c, 0x31, 0x92, 0x65, 0x15, 0x56, 0x48, 0xab, 0xe3, 0x1c, 0x98, 0xf5,
-	0x71, 0x01, 0xca, 0x1b, 0x75, 0xdb, 0x0b, 0xba, 0xa8, 0x0b, 0x15, 0xd1, 0xfe, 0x43, 0xc2, 0xa8,
-	0x96, 0xf1, 0xdd, 0xc9, 0xba, 0xab, 0x41, 0xd2, 0xa9, 0x33, 0x25, 0x38, 0x11, 0x80, 0x3c, 0x98,
-	0x23, 0x8e, 0x50, 0x90, 0xac, 0x56, 0x94, 0x4b, 0x71, 0xb2, 0x8d, 0x62, 0xdf, 0xde, 0x5e, 0x97,
-	0x30, 0xf5, 0x0b, 0x46, 0xe9, 0xa8, 0x6f, 0x86, 0x0d, 0xbe, 0xf5, 0x1f, 0x25, 0xa8, 0x6c, 0xd4,
-	0xf5, 0xcc, 0x7f, 0xa1, 0x9d, 0x7c, 0x0e, 0x66, 0x1f, 0xf4, 0x69, 0x7c, 0xac, 0x97, 0x67, 0xb2,
-	0xcc, 0x6e, 0x8b, 0x42, 0xac, 0x68, 0xe8, 0x35, 0x58, 0x08, 0x5b, 0x2d, 0x46, 0x79, 0x43, 0xe8,
-	0x90, 0x40, 0x6b, 0xba, 0x44, 0xcf, 0xec, 0x65, 0x68, 0x38, 0xc7, 0x89, 0x3a, 0xb0, 0x10, 0x85,
-	0xbe, 0x2f, 0x95, 0xc5, 0x11, 0xf1, 0x27, 0x3c, 0x4c, 0x13, 0x49, 0xfb, 0x19, 0x2c, 0x9c, 0x43,
-	0x46, 0x01, 0x2c, 0x09, 0xed, 0xe2, 0xf1, 0x44, 0xd6, 0xec, 0x44, 0xb2, 0xbe, 0xa2, 0x65, 0x2d,
-	0x35, 0x72, 0x68, 0x78, 0x00, 0x1d, 0x5d, 0x07, 0xf0, 0x02, 0x8f, 0x8b, 0x2d, 0xdf, 0x23, 0x32,
-	0x92, 0x50, 0xa9, 0x23, 0x5d, 0x17, 0x9a, 0x09, 0x05, 0x67, 0xb8, 0xac, 0xbf, 0x2a, 0x40, 0x32,
-	0x07, 0x42, 0x33, 0xb8, 0xb1, 0x77, 0x44, 0x63, 0xbd, 0xc3, 0x13, 0xcd, 0xb0, 0x21, 0x4b, 0xb1,
-	0xa6, 0xa2, 0x07, 0x00, 0x6e, 0xb2, 0xdb, 0xf4, 0x26, 0x5f, 0x9f, 0x7a, 0x93, 0x2b, 0xb7, 0x36,
-	0xfd, 0xc6, 0x19, 0x21, 0xd6, 0xff, 0x88, 0x1d, 0x47, 0xdd, 0x7e, 0x44, 0xbf, 0xd4, 0xf3, 0x5b,
-	0x46, 0x10, 0x3d, 0x57, 0x2f, 0xcd, 0x34, 0x82, 0xd8, 0xdc, 0xc0, 0xa2, 0x1c, 0xfd, 0x08, 0xe6,
-	0x7a, 0xe4, 0x91, 0xed, 0xfd, 0x84, 0xea, 0x93, 0xec, 0x89, 0x73, 0xbd, 0x6a, 0x54, 0xf9, 0xea,
-	0xed, 0x3e, 0x09, 0xb8, 0xc7, 0x8f, 0xd3, 0x0d, 0xb9, 0xa3, 0x60, 0xb0, 0xc1, 0xb3, 0x7e, 0x56,
-	0x80, 0xf2, 0xe6, 0xa3, 0x48, 0x9c, 0x55, 0x5f, 0xaa, 0x05, 0xf3, 0x8b, 0x02, 0x94, 0xb7, 0x3c,
-	0x9f, 0xd3, 0xf8, 0xcb, 0x9d, 0x89, 0xeb, 0x00, 0xf4, 0x51, 0x14, 0xab, 0x68, 0xaf, 0x9e, 0x90,
-	0x64, 0xb5, 0x6f, 0x26, 0x14, 0x9c, 0xe1, 0xb2, 0x7e, 0x5e, 0x80, 0xb9, 0x2d, 0x9f, 0x70, 0x4e,
-	0x83, 0x2f, 0x77, 0x10, 0x3f, 0x2e, 0xc3, 0xe2, 0x4d, 0xca, 0xf7, 0x43, 0xd7, 0x8e, 0xa8, 0x83,
-	0xe9, 0x03, 0xf4, 0x4d, 0x98, 0x73, 0x54, 0x8c, 0x4b, 0x6f, 0xbe, 0x64, 0x25, 0x34, 0x54, 0x31,
-	0x36, 0x74, 0xa1, 0xfb, 0x22, 0x2f, 0xa2, 0xbe, 0x17, 0xd0, 0x5d, 0xd2, 0xa3, 0x83, 0xba, 0x6f,
-	0x3f, 0x43, 0xc3, 0x39, 0x4e, 0x21, 0x24, 0xa6, 0x91, 0xef, 0x39, 0x44, 0xaa, 0xbd, 0xd9, 0x54,
-	0x08, 0x56, 0xc5, 0xd8, 0xd0, 0xd1, 0xab, 0x30, 0x2f, 0x4d, 0xbe, 0xad, 0x30, 0xee, 0x11, 0xae,
-	0xed, 0xcd, 0x24, 0x77, 0xd0, 0x4c, 0x49, 0x38, 0xcb, 0x27, 0xaa, 0xc5, 0xfd, 0x20, 0xa0, 0xb1,
-	0xe4, 0x90, 0x4a, 0x28, 0x53, 0x0d, 0xa7, 0x24, 0x9c, 0xe5, 0x43, 0x36, 0x40, 0xd4, 0xf7, 0xfd,
-	0xfd, 0xd0, 0xf7, 0x9c, 0x63, 0x19, 0xbb, 0xac, 0xd6, 0x6f, 0x98, 0xc9, 0xdc, 0x4f, 0x28, 0x8f,
-	0x4f, 0x56, 0x9e, 0x1d, 0x4e, 0xe9, 0xac, 0xa6, 0x0c, 0x38, 0x03, 0x83, 0xf6, 0x60, 0xa9, 0x1f,
-	0xb9, 0x84, 0xd3, 0x44, 0xff, 0x56, 0xae, 0x15, 0x5e, 0x28, 0xd5, 0xbf, 0x61, 0xf4, 0xe9, 0x9d,
-	0x1c, 0xf5, 0xf1, 0xc9, 0xca, 0xa2, 0x30, 0xb2, 0x13, 0xc5, 0x8b, 0x07, 0xaa, 0x23, 0x06, 0x20,
-	0x7c, 0x1b, 0x9b, 0x13, 0xde, 0x37, 0xb6, 0xdc, 0xf7, 0x27, 0x3b, 0x81, 0x13, 0x98, 0x74, 0xcd,
-	0xa6, 0x65, 0x38, 0x23, 0x06, 0xb5, 0x61, 0x8e, 0x79, 0x2e, 0x75, 0x48, 0xac, 0x03, 0x9c, 0x7f,
-	0x38, 0x99, 0x44, 0x85, 0x91, 0xce, 0xb8, 0x2e, 0xc0, 0x06, 0x1d, 0x05, 0xb0, 0x2c, 0x67, 0x52,
-	0x8c, 0xa6, 0xb2, 0x7d, 0x58, 0x6d, 0x5e, 0x5a, 0x19, 0x23, 0xed, 0xd5, 0xed, 0xd0, 0x21, 0xfe,
-	0xde, 0xe1, 0x7d, 0xea, 0x70, 0x4c, 0x5b, 0x34, 0xa6, 0x81, 0x43, 0x53, 0x7f, 0xac, 0x39, 0x80,
-	0x84, 0x87, 0xb0, 0x85, 0xd5, 0xda, 0x09, 0x19, 0x0f, 0x88, 0x8e, 0x7e, 0x66, 0xac, 0xd6, 0x37,
-	0x74, 0x39, 0x4e, 0x38, 0xd0, 0x1a, 0x54, 0x59, 0xff, 0xd0, 0x0d, 0x7b, 0xc4, 0x0b, 0x64, 0x68,
-	0xb3, 0x9a, 0x1a, 0xc7, 0xb6, 0x21, 0xe0, 0x94, 0xc7, 0x7a, 0x7f, 0x16, 0x4a, 0x37, 0x3d, 0x7e,
-	0x36, 0xbf, 0xe6, 0x8c, 0x4e, 0x82, 0xce, 0x1f, 0x15, 0x47, 0xe7, 0x8f, 0x10, 0x81, 0xa5, 0x3e,
-	0xa3, 0xb1, 0x68, 0xaf, 0xea, 0xa4, 0x8e, 0xc2, 0x9f, 0xd1, 0xea, 0x94, 0x21, 0x95, 0x3b, 0x39,
-	0x00, 0x3c, 0x00, 0x28, 0x44, 0x44, 0x84, 0xb1, 0x87, 0x61, 0xec, 0x6a, 0x11, 0x95, 0x73, 0x8b,
-	0xd8, 0xcf, 0x01, 0xe0, 0x01, 0x40, 0x64, 0xc3, 0x65, 0x2f, 0x60, 0xd4, 0xe9, 0xc7, 0xb4, 0xd9,
-	0x0e, 0xc2, 0x98, 0x8a, 0xd9, 0xb8, 0x45, 0x8f, 0xe5, 0xf2, 0xab, 0xd4, 0x9f, 0xd5, 0xdd, 0xbe,
-	0xdc, 0x1c, 0xc5, 0x84, 0x47, 0xd7, 0x45, 0x11, 0x3c, 0xcd, 0x58, 0x67, 0x3f, 0xf6, 0x8e, 0x08,
-	0xa7, 0xb2, 0x45, 0xb2, 0xf1, 0xd5, 0x73, 0xe5, 0x15, 0x4f, 0x4f, 0x56, 0x9e, 0xb6, 0xed, 0x37,
-	0x06, 0x51, 0xf0, 0x28, 0x68, 0x74, 0x0d, 0x66, 0x22, 0xc2, 0x3b, 0x5a, 0x3b, 0x2e, 0xe8, 0x56,
-	0xcf, 0xc8, 0xd4, 0x98, 0xa4, 0x08, 0x73, 0xe7, 0x30, 0x26, 0x81, 0xd3, 0xd1, 0x1e, 0x78, 0x62,
-	0xee, 0xd4, 0x65, 0x29, 0xd6, 0x54, 0xe3, 0xfc, 0xcd, 0x9e, 0xdf, 0xf9, 0xb3, 0x7e, 0x5b, 0x80,
-	0xd9, 0x9b, 0x71, 0xd8, 0x97, 0x86, 0x43, 0x97, 0x1e, 0x0f, 0xa6, 0x1e, 0xc5, 0x88, 0x89, 0x72,
-	0x79, 0x9a, 0x05, 0xee, 0x5e, 0x4b, 0x32, 0x0f, 0x9d, 0x66, 0x09, 0x05, 0x67, 0xb8, 0xd0, 0xab,
-	0x50, 0x6e, 0x29, 0xed, 0xac, 0xfa, 0x68, 0x66, 0xa6, 0xac, 0x74, 0xf1, 0xe3, 0x93, 0x95, 0x79,
-	0xc9, 0xa8, 0xf5, 0xb4, 0x66, 0x46, 0x0e, 0xcc, 0xe9, 0xd0, 0x97, 0xb6, 0x7d, 0x27, 0x54, 0x28,
-	0x0a, 0x43, 0x87, 0xea, 0xd4, 0x07, 0x36, 0xc8, 0x56, 0x19, 0x66, 0xde, 0x38, 0x38, 0xd8, 0xb7,
-	0x7e, 0x55, 0x00, 0x10, 0x3f, 0xde, 0xa0, 0xc4, 0xa5, 0xb1, 0x98, 0x14, 0xb9, 0xdf, 0x0b, 0xf9,
-	0x49, 0x91, 0x47, 0x95, 0xa4, 0xa4, 0x4e, 0x66, 0xf1, 0xac, 0x4e, 0x66, 0x69, 0x0a, 0x27, 0x33,
-	0x6d, 0x5a, 0x36, 0x18, 0x37, 0xd2, 0xc9, 0x64, 0xb0, 0x3c, 0xc8, 0xad, 0xf2, 0xd7, 0x93, 0x3a,
-	0x99, 0x99, 0xfc, 0xf5, 0x58, 0x47, 0xf3, 0x93, 0x02, 0x54, 0x84, 0x54, 0xe9, 0x6a, 0x3e, 0x39,
-	0x7b, 0x8d, 0xee, 0xc3, 0x5c, 0x47, 0x36, 0xce, 0x38, 0x87, 0xdf, 0x9f, 0x72, 0x48, 0xd2, 0xb3,
-	0x42, 0x7d, 0x33, 0x6c, 0x04, 0xa0, 0x37, 0x01, 0x99, 0x7d, 0x6e, 0x77, 0xbd, 0xe8, 0x2e, 0x8d,
-	0xbd, 0xd6, 0xb1, 0x9c, 0x89, 0x4a, 0x12, 0xc8, 0x42, 0xcd, 0x21, 0x0e, 0x3c, 0xa2, 0x96, 0xd5,
-	0x50, 0x2b, 0x44, 0x0f, 0xe9, 0xab, 0x30, 0xcf, 0x68, 0x7c, 0xe4, 0x39, 0xca, 0xb6, 0x29, 0xe4,
-	0x0d, 0x08, 0x3b, 0x25, 0xe1, 0x2c, 0x9f, 0xb0, 0xec, 0xaa, 0x49, 0xfc, 0x47, 0x2c, 0xb3, 0x96,
-	0xd7, 0x0a, 0x65, 0xed, 0x4a, 0xba, 0xcc, 0xb6, 0x9a, 0x5b, 0x7b, 0x58, 0x52, 0xd0, 0x5b, 0x30,
-	0xd3, 0xe1, 0xdc, 0x84, 0x27, 0x5f, 0x9f, 0x78, 0xa4, 0x54, 0xa4, 0x48, 0xfc, 0xc2, 0x12, 0xd0,
-	0xfa, 0xb8, 0x00, 0xd5, 0x37, 0x29, 0xb7, 0x79, 0x4c, 0x49, 0xef, 0x0c, 0xeb, 0xfd, 0x9b, 0x30,
-	0x17, 0x10, 0xce, 0xee, 0x24, 0xc7, 0x4a, 0x32, 0xe8, 0xbb, 0xeb, 0x07, 0xb6, 0x98, 0x5c, 0x43,
-	0x17, 0xac, 0xac, 0x2f, 0x0f, 0x5c, 0xbd, 0xe1, 0xd3, 0xb3, 0x5c, 0x15, 0x63, 0x43, 0x47, 0x6f,
-	0xc3, 0x0c, 0xe9, 0xf3, 0x8e, 0xde, 0xe0, 0x93, 0x39, 0xeb, 0x42, 0xfe, 0x7a, 0x9f, 0x77, 0x74,
-	0x30, 0xac, 0x2f, 0xf4, 0xa6, 0x00, 0xb5, 0xde, 0x2b, 0xc0, 0x62, 0xd2, 0x45, 0xb9, 0x32, 0x43,
-	0xa8, 0xde, 0xa7, 0x9c, 0xc9, 0x02, 0xbd, 0x09, 0x26, 0x8b, 0x4c, 0x24, 0xb0, 0xe9, 0xe1, 0x9e,
-	0x14, 0xe1, 0x54, 0x86, 0xf5, 0x7e, 0x01, 0x2e, 0xa4, 0x4d, 0x50, 0x2b, 0xe7, 0x0b, 0x6f, 0xc4,
-	0xaf, 0x0a, 0x30, 0x7b, 0x8b, 0xb4, 0xba, 0xe4, 0x0c, 0xd3, 0xfc, 0x10, 0xe6, 0xbb, 0x82, 0x55,
-	0xe5, 0xdf, 0xf4, 0xbc, 0xfc, 0x60, 0xa2, 0xe6, 0xdd, 0x4a, 0x71, 0xd2, 0x8d, 0x91, 0x29, 0xc4,
-	0x59, 0x49, 0x42, 0x9f, 0xf2, 0x30, 0xf2, 0x1c, 0xbd, 0x64, 0x12, 0x7d, 0x7a, 0x20, 0x0a, 0xb1,
-	0xa2, 0x59, 0xff, 0x5c, 0x80, 0x2c, 0x82, 0x30, 0x87, 0x0e, 0xe3, 0xb0, 0x2b, 0x54, 0x49, 0x21,
-	0x35, 0x87, 0xea, 0xaa, 0x08, 0x1b, 0x1a, 0xfa, 0x21, 0x94, 0x02, 0xca, 0xb5, 0x02, 0xfe, 0xee,
-	0xe4, 0x9d, 0xd9, 0xdd, 0x3c, 0xd0, 0x97, 0x10, 0x36, 0x0f, 0xb0, 0x80, 0x44, 0xeb, 0x70, 0xa1,
-	0x47, 0x1e, 0xed, 0x50, 0xc6, 0xc4, 0x11, 0x73, 0xcc, 0x29, 0xd3, 0x0e, 0x4b, 0x72, 0xb7, 0x68,
-	0x27, 0x4f, 0xc6, 0x83, 0xfc, 0xd6, 0x3f, 0x14, 0xa0, 0x62, 0xd0, 0x91, 0x0d, 0x25, 0xee, 0x9b,
-	0x3b, 0x3c, 0xaf, 0x4d, 0xd4, 0xd2, 0x83, 0x6d, 0x5b, 0x35, 0xf2, 0x60, 0xdb, 0xc6, 0x02, 0x4d,
-	0xe8, 0x10, 0x46, 0x98, 0x3f, 0x95, 0x0e, 0xb1, 0xd7, 0xed, 0x6d, 0xb5, 0xc1, 0xc4, 0x2f, 0x2c,
-	0x01, 0xad, 0x7f, 0x9c, 0x85, 0xaa, 0x6c, 0xba, 0xdc, 0x5c, 0xf7, 0x60, 0x56, 0x4e, 0xa8, 0x6e,
-	0xfd, 0xb7, 0x27, 0x1f, 0xe7, 0x74, 0xf6, 0xe5, 0x27, 0x56, 0xb8, 0x62, 0x89, 0x10, 0x76, 0x1c,
-	0x38, 0xb2, 0x23, 0x95, 0x94, 0x69, 0x5d, 0x14, 0x62, 0x45, 0x43, 0x6f, 0x43, 0xf5, 0x90, 0x70,
-	0xa7, 0x33, 0x45, 0x6c, 0x43, 0x9e, 0xad, 0x75, 0x03, 0x82, 0x53, 0x3c, 0x84, 0xa1, 0xec, 0x7b,
-	0x41, 0x9b, 0xc6, 0x13, 0x46, 0xe3, 0xe4, 0x85, 0x83, 0x6d, 0x89, 0x80, 0x35, 0x92, 0x58, 0x42,
-	0x4e, 0xd8, 0x33, 0xae, 0xff, 0xc1, 0x71, 0x64, 0x92, 0x26, 0xc9, 0x12, 0x6a, 0xe4, 0xc9, 0x78,
-	0x90, 0x1f, 0xed, 0xc2, 0x0c, 0x71, 0xba, 0x4c, 0x5f, 0xca, 0x79, 0x69, 0x6c, 0xa3, 0xfa, 0xdc,
-	0xf3, 0x57, 0xd5, 0xed, 0xbd, 0xd5, 0x66, 0xc0, 0xf7, 0x62, 0x9b, 0xc7, 0x5e, 0xd0, 0xd6, 0x8a,
-	0xd3, 0xe9, 0x32, 0x2c, 0x71, 0xd0, 0x4d, 0xb8, 0x48, 0x03, 0x72, 0xe8, 0xd3, 0xa6, 0x4b, 0x7b,
-	0x51, 0xc8, 0x85, 0xcb, 0x24, 0x5d, 0x84, 0x4a, 0xfd, 0x6b, 0xba, 0x51, 0x17, 0x37, 0x07, 0x19,
-	0xf0, 0x70, 0x1d, 0x74, 0x1f, 0x96, 0x7a, 0x6a, 0xad, 0x1f, 0x78, 0x3d, 0x1a, 0xf6, 0x8d, 0x17,
-	0x70, 0xde, 0x71, 0x93, 0xee, 0xc0, 0x4e, 0x0e, 0x09, 0x0f, 0x20, 0x8b, 0x03, 0xb9, 0x47, 0x1e,
-	0x35, 0x83, 0x96, 0xef, 0xb5, 0x3b, 0xca, 0x62, 0x5f, 0x4c, 0xf5, 0xce, 0x4e, 0x4a, 0xc2, 0x59,
-	0x3e, 0xeb, 0x2f, 0x4a, 0x5a, 0xa5, 0x24, 0xa6, 0xd2, 0xe7, 0xbc, 0x8a, 0x37, 0x60, 0x9e, 0x71,
-	0x12, 0x73, 0x15, 0xfa, 0xd5, 0x87, 0xa9, 0x95, 0x18, 0x0e, 0x29, 0xe9, 0xb1, 0x51, 0x97, 0xea,
-	0x13, 0x67, 0xab, 0xa1, 0x1f, 0x42, 0xa5, 0x45, 0xb9, 0xd3, 0xd9, 0x49, 0x72, 0x51, 0xe7, 0x5d,
-	0xe5, 0x32, 0xcd, 0xba, 0xa5, 0x31, 0x70, 0x82, 0x86, 0x5c, 0x58, 0x90, 0xbf, 0xdf, 0x22, 0x1e,
-	0xdf, 0x21, 0x8f, 0x26, 0x5c, 0xe9, 0x32, 0x33, 0xb1, 0x95, 0xc1, 0xc1, 0x39, 0x54, 0x61, 0x23,
-	0xb4, 0x85, 0xcd, 0xdf, 0x74, 0xf5, 0x6a, 0x4f, 0x6c, 0x04, 0xe9, 0x0a, 0x34, 0x37, 0xb0, 0xa1,
-	0x5b, 0x6b, 0x50, 0xda, 0x0e, 0xdb, 0xe8, 0x05, 0xa8, 0xf0, 0xb8, 0x1f, 0x38, 0x84, 0x53, 0x9d,
-	0xcf, 0x95, 0x3d, 0x38, 0xd0, 0x65, 0x38, 0xa1, 0x5a, 0x7f, 0x5f, 0x80, 0xd2, 0x0e, 0x89, 0xfe,
-	0xef, 0x85, 0xfd, 0x7c, 0x98, 0xd9, 0xa1, 0x9c, 0x64, 0x12, 0xa3, 0x85, 0x27, 0x25, 0x46, 0xd1,
-	0x15, 0x28, 0x26, 0x31, 0x5e, 0xd0, 0x3c, 0xc5, 0xe6, 0x06, 0x2e, 0x7a, 0xae, 0x38, 0xea, 0x65,
-	0xd2, 0xb6, 0x24, 0x43, 0x49, 0xc9, 0x51, 0x2f, 0x76, 0x0b, 0x96, 0x14, 0xeb, 0xbd, 0x12, 0x54,
-	0x84, 0x38, 0xd1, 0x61, 0xf4, 0xb3, 0x02, 0xcc, 0x93, 0x20, 0x08, 0x39, 0x51, 0x69, 0x9b, 0x82,
-	0xb4, 0xcc, 0x77, 0x27, 0x1a, 0x2b, 0x03, 0xba, 0xba, 0x9e, 0x02, 0x6e, 0x06, 0x3c, 0x3e, 0xce,
-	0xdc, 0xe9, 0x4d, 0x29, 0x38, 0x2b, 0x17, 0x3d, 0x80, 0xb2, 0x4f, 0x0e, 0xa9, 0x6f, 0x7c, 0x83,
-	0xe6, 0x74, 0x2d, 0xd8, 0x96, 0x58, 0x4a, 0x78, 0x26, 0x7f, 0x28, 0x0a, 0xb1, 0x16, 0x74, 0xe5,
-	0x7b, 0xb0, 0x3c, 0xd8, 0x50, 0xb4, 0x9c, 0xf1, 0x82, 0x95, 0xe3, 0x7b, 0x29, 0xe7, 0xef, 0x69,
-	0x07, 0xef, 0xdb, 0xc5, 0xd7, 0x0a, 0x57, 0x5e, 0x87, 0xf9, 0x8c, 0x98, 0xf3, 0x54, 0xb5, 0x30,
-	0x54, 0x8c, 0xf5, 0x8a, 0xb6, 0x84, 0x01, 0xd4, 0xa5, 0xc1, 0xf9, 0x9c, 0xb3, 0xaa, 0xb2, 0x91,
-	0xba, 0x34, 0xc0, 0xaa, 0xba, 0xf5, 0xcb, 0x22, 0x54, 0x4c, 0x68, 0x15, 0xfd, 0x31, 0x54, 0x7a,
-	0x7a, 0x2c, 0x34, 0xee, 0x4b, 0x67, 0xdb, 0xc8, 0x2a, 0x60, 0x26, 0xc6, 0x31, 0x5d, 0xb5, 0x69,
-	0x19, 0x4e, 0x50, 0x91, 0x03, 0x33, 0x2c, 0xa2, 0xce, 0x54, 0xd9, 0x15, 0xd3, 0x5c, 0x3b, 0xa2,
-	0x4e, 0xba, 0x54, 0x65, 0xc4, 0x59, 0x82, 0xa3, 0x2e, 0x94, 0x99, 0x0a, 0x66, 0x2a, 0x5d, 0xd7,
-	0x98, 0x4e, 0x8c, 0x0a, 0x68, 0xa6, 0xbb, 0x4a, 0x05, 0x33, 0xb5, 0x08, 0xeb, 0xa3, 0x02, 0x24,
-	0xb1, 0xe9, 0x6d, 0x8f, 0x71, 0xf4, 0xce, 0xd0, 0x20, 0x9e, 0x51, 0x1b, 0x8a, 0xda, 0x72, 0x08,
-	0x93, 0x80, 0xa1, 0x29, 0xc9, 0x0c, 0xe0, 0x21, 0xcc, 0x7a, 0x9c, 0xf6, 0xcc, 0x82, 0xff, 0xee,
-	0x54, 0x5d, 0xcb, 0x84, 0x0d, 0x05, 0x26, 0x56, 0xd0, 0xd6, 0xbf, 0x65, 0xba, 0x24, 0x86, 0x55,
-	0x08, 0x35, 0x37, 0x63, 0x26, 0x17, 0x2a, 0x03, 0xc1, 0x62, 0xca, 0x46, 0x5f, 0xac, 0x69, 0xc3,
-	0xa2, 0x4b, 0x7d, 0x2a, 0x76, 0xd5, 0x06, 0xf5, 0xc9, 0xf1, 0x84, 0x57, 0x6c, 0xe4, 0x4d, 0xbd,
-	0x8d, 0x2c, 0x10, 0xce, 0xe3, 0xca, 0x87, 0x07, 0xf9, 0xb9, 0x45, 0xaf, 0xc0, 0x6c, 0xd4, 0x31,
-	0x59, 0xe0, 0x6a, 0xfd, 0xaa, 0x69, 0xe0, 0xbe, 0x28, 0x7c, 0x7c, 0xb2, 0xb2, 0x68, 0xf8, 0x65,
-	0x01, 0x56, 0xcc, 0xe2, 0x50, 0xd2, 0x46, 0xc5, 0xa0, 0x8f, 0xab, 0x6d, 0x0f, 0x6c, 0xe8, 0xc8,
-	0x01, 0x70, 0xc2, 0xc0, 0xf5, 0x94, 0xb6, 0x2c, 0xc9, 0x51, 0x5c, 0x3b, 0x5b, 0xcf, 0x1a, 0xa6,
-	0x5e, 0xba, 0xb3, 0x92, 0x22, 0x86, 0x33, 0xb0, 0x88, 0xc0, 0xbc, 0x4f, 0x18, 0x57, 0xe1, 0x7f,
-	0x57, 0x9f, 0xc4, 0xbf, 0x77, 0x36, 0x29, 0x42, 0xd1, 0xa7, 0xfa, 0x76, 0x3b, 0x85, 0xc1, 0x59,
-	0x4c, 0xeb, 0x37, 0x45, 0x28, 0xda, 0x37, 0xce, 0xe0, 0x18, 0x3e, 0x0f, 0xe5, 0xc3, 0xbe, 0xd3,
-	0xa5, 0x43, 0xb7, 0x31, 0xea, 0xb2, 0x14, 0x6b, 0xaa, 0xe0, 0x8b, 0x69, 0x5b, 0x9c, 0x79, 0x03,
-	0x97, 0x7a, 0xb0, 0x2c, 0xc5, 0x9a, 0x8a, 0x8e, 0x60, 0xde, 0x49, 0x5f, 0x8a, 0xe8, 0xbe, 0x4d,
-	0xb6, 0xaf, 0xf3, 0x8f, 0x4e, 0xd4, 0x7d, 0xd9, 0x4c, 0x01, 0xce, 0x0a, 0x42, 0xf7, 0xa1, 0x42,
-	0xf5, 0x33, 0x0b, 0x9d, 0xe6, 0xfe, 0xc1, 0xa4, 0x42, 0xcd, 0x73, 0x0d, 0xfd, 0xf6, 0x40, 0x7f,
-	0xe1, 0x04, 0xdf, 0xfa, 0x31, 0x94, 0xed, 0x1b, 0xd2, 0x37, 0xb2, 0xa1, 0xc8, 0x6e, 0xe8, 0x4e,
-	0xfe, 0xc1, 0x64, 0x9b, 0xed, 0x46, 0x7a, 0xc4, 0xdb, 0x37, 0x70, 0x91, 0xdd, 0xb0, 0x7e, 0x5b,
-	0x80, 0x8a, 0x7d, 0x43, 0xdb, 0xad, 0x4a, 0xc2, 0xdc, 0x67, 0x2a, 0x01, 0xbd, 0x0b, 0x10, 0x85,
-	0xbe, 0xbf, 0x4f, 0x63, 0x2f, 0x74, 0xb5, 0x7b, 0x71, 0xde, 0xfd, 0x2b, 0xb3, 0xe5, 0xfb, 0x09,
-	0x0a, 0xce, 0x20, 0x0a, 0x9b, 0xdd, 0x09, 0x03, 0xa7, 0x1f, 0xc7, 0x34, 0x70, 0x8e, 0xa5, 0x73,
-	0x90, 0xb1, 0xd9, 0x1b, 0x29, 0x09, 0x67, 0xf9, 0xac, 0xff, 0x2c, 0x80, 0x74, 0x43, 0xd1, 0x0f,
-	0xa0, 0xda, 0xa3, 0x4e, 0x87, 0x04, 0x1e, 0xeb, 0xe9, 0xb5, 0x6b, 0x2c, 0xe9, 0xea, 0x8e, 0x21,
-	0x88, 0xed, 0x2e, 0xb8, 0x93, 0x02, 0x9c, 0x56, 0x42, 0x4d, 0x98, 0xe9, 0x33, 0x1a, 0x9f, 0xef,
-	0xe1, 0x90, 0xec, 0xd2, 0x1d, 0x46, 0x63, 0x1d, 0xd7, 0x97, 0x10, 0xe8, 0x0e, 0x54, 0x4c, 0x86,
-	0xe2, 0x7c, 0x0f, 0x84, 0x46, 0x25, 0x3b, 0x12, 0x28, 0xeb, 0xbf, 0x8b, 0x50, 0x4d, 0x2e, 0xc2,
-	0xa0, 0x3e, 0x54, 0xc5, 0x31, 0x25, 0xaf, 0x5d, 0x4d, 0x65, 0xd0, 0xda, 0xb7, 0xb7, 0x6d, 0x03,
-	0x94, 0x09, 0xf1, 0x66, 0x4a, 0x71, 0x2a, 0x09, 0xfd, 0xb4, 0x00, 0xcb, 0x61, 0x80, 0xa9, 0x13,
-	0xc6, 0xee, 0x6e, 0xc8, 0xb7, 0xc2, 0x7e, 0xe0, 0x4e, 0x75, 0xe4, 0xe7, 0xc5, 0x9f, 0x9e, 0xac,
-	0x2c, 0xef, 0x0d, 0xc0, 0xe3, 0x21, 0x81, 0xa8, 0x03, 0x73, 0x61, 0xb0, 0x19, 0xc7, 0x61, 0xac,
-	0x07, 0xf8, 0x33, 0x90, 0x2d, 0x23, 0x46, 0x7b, 0x0a, 0x15, 0x1b, 0x78, 0xeb, 0x16, 0xe4, 0x86,
-	0x02, 0x3d, 0x0b, 0x25, 0xf6, 0x60, 0x28, 0xa4, 0x6d, 0xdf, 0xde, 0xc6, 0xa2, 0x3c, 0xb9, 0x94,
-	0x57, 0x1c, 0x75, 0x29, 0xcf, 0xfa, 0x4d, 0x09, 0x66, 0xec, 0x83, 0xf5, 0xdd, 0xf3, 0x45, 0x59,
-	0x67, 0x3e, 0x25, 0xca, 0x7a, 0x13, 0x2e, 0x8a, 0x9f, 0x3b, 0x61, 0xe0, 0xf1, 0x50, 0xb8, 0xf1,
-	0xa2, 0x52, 0x45, 0x56, 0x4a, 0x9c, 0x74, 0x51, 0x29, 0xc3, 0x80, 0xb7, 0xf1, 0x70, 0x1d, 0xb4,
-	0x06, 0x55, 0x9d, 0xb1, 0x4f, 0x9c, 0xb1, 0x24, 0x9e, 0xa8, 0x73, 0xfa, 0xcd, 0x0d, 0x9c, 0xf2,
-	0x9c, 0x27, 0xbe, 0xbb, 0x0d, 0x8b, 0xfa, 0xe7, 0x7e, 0x4c, 0x5b, 0xde, 0x23, 0x9d, 0x68, 0x7f,
-	0x5e, 0x57, 0x58, 0xb4, 0xb3, 0xc4, 0xc7, 0x83, 0x05, 0x38, 0x5f, 0x39, 0x89, 0x16, 0xcf, 0x7d,
-	0x0e, 0xd1, 0xe2, 0x49, 0xe3, 0x07, 0x7f, 0x57, 0x80, 0x59, 0x79, 0x01, 0x1c, 0xad, 0xc3, 0x05,
-	0x97, 0x32, 0x2f, 0xa6, 0xae, 0xbe, 0xa4, 0xc0, 0xf4, 0x44, 0x27, 0x81, 0x9c, 0x8d, 0x3c, 0x19,
-	0x0f, 0xf2, 0x8b, 0xa9, 0x88, 0x28, 0xed, 0xa6, 0xe6, 0x52, 0x66, 0x2a, 0xf6, 0x0d, 0x01, 0xa7,
-	0x3c, 0xe8, 0x35, 0x58, 0x60, 0x0e, 0xf1, 0xbd, 0xa0, 0xad, 0xea, 0x0c, 0x5c, 0xb1, 0xb0, 0x33,
-	0x34, 0x9c, 0xe3, 0xb4, 0x5c, 0x30, 0x99, 0xf5, 0xcf, 0xf3, 0xf9, 0xe0, 0xbf, 0x96, 0x61, 0x46,
-	0x1e, 0x80, 0x9f, 0xbe, 0xf4, 0xdf, 0x82, 0x19, 0xc6, 0x49, 0x30, 0x5d, 0x94, 0xf2, 0x60, 0x7d,
-	0x57, 0x47, 0x29, 0x0f, 0xd6, 0x77, 0xb1, 0x04, 0x4c, 0x23, 0x3a, 0xd3, 0xdc, 0xd9, 0x4d, 0xc2,
-	0x9c, 0xca, 0xe3, 0xca, 0x45, 0x74, 0x6c, 0x28, 0xf9, 0xa1, 0x89, 0x95, 0x4f, 0x16, 0xb4, 0xdd,
-	0x0e, 0xdb, 0x2a, 0x68, 0xbb, 0x1d, 0xb6, 0xb1, 0x40, 0x13, 0x6b, 0x5d, 0x26, 0x7e, 0x66, 0xa7,
-	0x58, 0xeb, 0x26, 0x23, 0x37, 0x98, 0xfc, 0xd1, 0xc6, 0x82, 0x3a, 0xcf, 0xbf, 0x33, 0xa1, 0xb1,
-	0x20, 0x81, 0xcb, 0x19, 0x63, 0xc1, 0x86, 0xa2, 0x7b, 0xa8, 0xf7, 0xe6, 0x77, 0x26, 0xbc, 0x65,
-	0x97, 0x82, 0x6e, 0xd4, 0x71, 0xd1, 0x3d, 0x44, 0x0e, 0x94, 0xd5, 0xed, 0x6b, 0x1d, 0x39, 0x9c,
-	0x2c, 0x57, 0xa8, 0xdf, 0x31, 0x08, 0x70, 0x19, 0x82, 0xd5, 0xef, 0x40, 0x34, 0x74, 0x3e, 0x23,
-	0xa3, 0x52, 0xfd, 0xf5, 0xe9, 0x32, 0x32, 0x52, 0xd4, 0xe2, 0xb8, 0x8c, 0x8c, 0x52, 0x15, 0xc4,
-	0xdd, 0xa6, 0x9c, 0xd3, 0xf8, 0x76, 0x9f, 0xf6, 0xa9, 0xbe, 0xb4, 0x90, 0x51, 0x15, 0x39, 0x32,
-	0x1e, 0xe4, 0xb7, 0x3e, 0x98, 0x03, 0x1d, 0x0e, 0x3a, 0xdb, 0xde, 0x72, 0xe2, 0x70, 0xba, 0xbd,
-	0xd5, 0x88, 0xc3, 0x40, 0x2d, 0x24, 0xf1, 0x0b, 0x4b, 0xc0, 0x64, 0xd3, 0x96, 0x3e, 0xeb, 0x4d,
-	0x4b, 0xcc, 0xa6, 0x9d, 0x3a, 0x03, 0x95, 0x7d, 0xf8, 0x99, 0xdb, 0xb6, 0x3f, 0xce, 0xed, 0xb0,
-	0xc9, 0x93, 0xd0, 0x5a, 0xc0, 0xe0, 0x1e, 0xbb, 0x23, 0xf7, 0x58, 0x65, 0x8a, 0xed, 0x6b, 0x6c,
-	0xfb, 0xdc, 0x2e, 0xbb, 0x23, 0x77, 0x59, 0x79, 0x9a, 0xcb, 0xcd, 0xf5, 0x2c, 0xac, 0xde, 0x67,
-	0x34, 0xd9, 0x67, 0xd5, 0x29, 0x2c, 0xab, 0xe1, 0xd7, 0x95, 0x03, 0x3b, 0xed, 0x41, 0x76, 0xa7,
-	0xa9, 0x6b, 0x62, 0x1b, 0x53, 0xee, 0xb4, 0xcc, 0x7d, 0x88, 0x91, 0x7b, 0x8d, 0xc0, 0x6c, 0x4c,
-	0x79, 0x7c, 0xac, 0x35, 0xd3, 0x64, 0x97, 0x48, 0xf4, 0xeb, 0xa6, 0x34, 0xd2, 0x81, 0x05, 0x24,
-	0x56, 0xc8, 0xd6, 0xdf, 0x16, 0x61, 0x46, 0x06, 0x7d, 0x3f, 0xff, 0x70, 0xdb, 0xbd, 0x5c, 0xb8,
-	0x6d, 0xca, 0xb8, 0xcd, 0xa8, 0x50, 0x5b, 0x7b, 0x20, 0xd4, 0x36, 0xf5, 0xbd, 0xc1, 0x71, 0x61,
-	0xb6, 0x0f, 0x85, 0xf7, 0xca, 0x69, 0xf4, 0x05, 0x84, 0xd8, 0xde, 0xcd, 0x87, 0xd8, 0x5e, 0x9f,
-	0xb8, 0x4b, 0x63, 0xc2, 0x6b, 0x3f, 0x45, 0xaa, 0x2b, 0x32, 0xb4, 0x66, 0xb4, 0x71, 0x79, 0xac,
-	0x36, 0xb6, 0xa1, 0xe4, 0x10, 0xae, 0x9f, 0x17, 0x4f, 0x66, 0x2f, 0x34, 0x08, 0x37, 0x6f, 0xcf,
-	0x38, 0x16, 0x68, 0xa8, 0x2b, 0xdf, 0xdc, 0xaa, 0x37, 0x52, 0x53, 0xdd, 0x2a, 0x48, 0x5e, 0x5a,
-	0x25, 0x0f, 0x71, 0xd5, 0x27, 0x4e, 0xf1, 0xd1, 0x3d, 0x28, 0xbb, 0xf2, 0x92, 0xbb, 0x7e, 0xdd,
-	0x38, 0xe1, 0x71, 0x2f, 0x21, 0x94, 0x9e, 0x50, 0xbf, 0xb1, 0x86, 0x15, 0x02, 0xa8, 0xbc, 0x43,
-	0xae, 0x1f, 0x93, 0x4e, 0x26, 0x40, 0x5d, 0x43, 0x57, 0x02, 0xd4, 0x6f, 0xac, 0x61, 0x85, 0x80,
-	0x96, 0xbc, 0x1c, 0xae, 0x35, 0xf4, 0x64, 0x02, 0xd4, 0xfd, 0x72, 0x25, 0x40, 0xfd, 0xc6, 0x1a,
-	0x16, 0x39, 0x30, 0xd7, 0x52, 0x37, 0xb8, 0xf5, 0x9b, 0xd2, 0xc9, 0x14, 0x8f, 0xbe, 0x05, 0x6e,
-	0x1e, 0x97, 0xcb, 0x0f, 0x6c, 0x90, 0xc5, 0x4a, 0x6a, 0x7b, 0x5c, 0xbf, 0xc9, 0x9f, 0x6c, 0x25,
-	0xdd, 0xf4, 0xf4, 0x4a, 0xba, 0xe9, 0x71, 0x2c, 0xd0, 0xd0, 0xdb, 0x30, 0x2b, 0x53, 0x6f, 0xfa,
-	0x1f, 0x03, 0x26, 0xcb, 0x80, 0xca, 0x2c, 0x9e, 0x3a, 0x74, 0xd5, 0x85, 0x40, 0x85, 0x29, 0x2d,
-	0x91, 0xd0, 0xa5, 0x5a, 0x19, 0x4f, 0x68, 0x89, 0x84, 0xae, 0x3e, 0x6e, 0xc5, 0x2f, 0x2c, 0x01,
-	0xc5, 0x50, 0xf4, 0x48, 0xa4, 0x4f, 0xaf, 0xc9, 0x86, 0x62, 0x87, 0x44, 0x6a, 0x28, 0x76, 0x48,
-	0x84, 0x05, 0x1a, 0x7a, 0x11, 0x2a, 0xb1, 0xf1, 0xe5, 0xbe, 0x2a, 0x1d, 0xc2, 0x44, 0xcd, 0x24,
-	0x4e, 0x5c, 0xc2, 0x21, 0x1c, 0x0d, 0xf9, 0x02, 0x58, 0xbf, 0x51, 0x9e, 0x6c, 0xe0, 0xa4, 0x2f,
-	0x99, 0x89, 0xa8, 0x8b, 0x4f, 0xac, 0x70, 0x51, 0x0b, 0xe6, 0x8c, 0x9b, 0xa6, 0x22, 0xce, 0x13,
-	0xda, 0xee, 0xfa, 0x7f, 0x05, 0x12, 0xaf, 0x5d, 0xfb, 0x6d, 0x06, 0x5c, 0xe8, 0x4b, 0xe6, 0x05,
-	0x5d, 0x56, 0x9b, 0x99, 0x46, 0x5f, 0x4a, 0x13, 0x38, 0xe9, 0x87, 0xc0, 0xc3, 0x0a, 0x16, 0xdd,
-	0x83, 0xc5, 0x98, 0xca, 0x6c, 0xb6, 0xbe, 0x48, 0xaf, 0xa2, 0x0e, 0xaf, 0x9b, 0xa8, 0x00, 0xce,
-	0x12, 0x1f, 0x9f, 0xac, 0x5c, 0x1b, 0x71, 0x97, 0x3e, 0xc7, 0x83, 0xf3, 0x78, 0xe8, 0x3a, 0x00,
-	0xa7, 0x71, 0xcf, 0x0b, 0x08, 0x0f, 0x63, 0x6d, 0x5a, 0x27, 0xe7, 0xea, 0x41, 0x42, 0xc1, 0x19,
-	0x2e, 0xb4, 0x09, 0x73, 0xca, 0x48, 0x61, 0xb5, 0xc5, 0xf1, 0x37, 0x68, 0x95, 0x3d, 0x93, 0x8e,
-	0x9d, 0xfa, 0x66, 0xd8, 0xd4, 0x45, 0x6f, 0x02, 0xd2, 0xf7, 0xfd, 0xd6, 0x1d, 0x27, 0xec, 0x07,
-	0x5c, 0x5e, 0x0f, 0x5c, 0xca, 0x3d, 0x9d, 0x45, 0xf6, 0x10, 0x07, 0x1e, 0x51, 0x0b, 0xb5, 0x33,
-	0xa7, 0xe2, 0xf2, 0x14, 0x07, 0xbe, 0x49, 0x87, 0xaa, 0x40, 0xb5, 0xf9, 0xca, 0x1c, 0x90, 0x1f,
-	0x14, 0x60, 0x21, 0x08, 0x5d, 0x6a, 0x42, 0x92, 0xb5, 0x8b, 0x72, 0x04, 0xf6, 0xa6, 0x32, 0x2f,
-	0x56, 0x77, 0x33, 0x88, 0x2a, 0x05, 0x9b, 0x04, 0x26, 0xb2, 0x24, 0x9c, 0x13, 0x8d, 0xb6, 0xa0,
-	0x42, 0x5a, 0x2d, 0x2f, 0xf0, 0xf8, 0xb1, 0xfe, 0x4f, 0x8a, 0x67, 0x46, 0xfe, 0x4d, 0x82, 0xe6,
-	0x51, 0x7d, 0x32, 0x5f, 0x38, 0xa9, 0x8b, 0xee, 0xc0, 0x3c, 0x0f, 0x7d, 0x1a, 0xeb, 0x84, 0xf6,
-	0xd3, 0xb2, 0x47, 0x57, 0x47, 0x41, 0x1d, 0x24, 0x6c, 0x69, 0xbc, 0x27, 0x2d, 0x63, 0x38, 0x8b,
-	0x93, 0x7d, 0xe6, 0xf0, 0xcc, 0x17, 0xfe, 0xcc, 0xe1, 0xd2, 0xe7, 0xf7, 0xcc, 0xe1, 0xca, 0xf7,
-	0xe1, 0xe2, 0xd0, 0x84, 0x9d, 0x2b, 0x99, 0xfd, 0x2f, 0x45, 0xc8, 0xbc, 0x0d, 0x41, 0x2f, 0xe5,
-	0x53, 0x70, 0x57, 0x06, 0x53, 0x70, 0x55, 0xc1, 0x9b, 0x4b, 0xbf, 0xc9, 0xd4, 0x11, 0x61, 0x61,
-	0xa0, 0x6d, 0xa7, 0x4c, 0xea, 0x48, 0x94, 0x62, 0x4d, 0x3d, 0x4f, 0x9a, 0x2e, 0xab, 0xc0, 0x4b,
-	0x9f, 0xaa, 0xc0, 0x5f, 0x84, 0x0a, 0x33, 0x3b, 0x60, 0x76, 0xe0, 0x7d, 0xb2, 0x59, 0xac, 0x09,
-	0x07, 0x72, 0x61, 0xc1, 0x27, 0x8c, 0x4b, 0x0d, 0xed, 0xae, 0xf3, 0x09, 0xd2, 0x73, 0xc9, 0x76,
-	0xd8, 0xce, 0xe0, 0xe0, 0x1c, 0xaa, 0x75, 0x17, 0xcc, 0xa5, 0xf5, 0xb3, 0x85, 0x8f, 0x59, 0xff,
-	0x50, 0xfe, 0x27, 0x57, 0x71, 0x28, 0x32, 0x2b, 0x5f, 0x14, 0x18, 0xba, 0xf5, 0xa7, 0x45, 0x28,
-	0x1d, 0x6c, 0xdb, 0xe8, 0x6d, 0x58, 0x70, 0x48, 0x83, 0xc6, 0x5c, 0xbf, 0x74, 0x38, 0xff, 0xfb,
-	0xe3, 0xc6, 0x7a, 0x5a, 0x1d, 0xe7, 0xc0, 0xd0, 0x1d, 0x00, 0x27, 0x85, 0x3e, 0x7f, 0x8e, 0x25,
-	0x03, 0x9c, 0x01, 0x42, 0x18, 0xaa, 0xdd, 0xe4, 0x69, 0xc6, 0xb9, 0x52, 0x2d, 0xd2, 0xa4, 0x4d,
-	0x1f, 0x64, 0xa4, 0x30, 0xd6, 0xdf, 0x14, 0x00, 0xd2, 0x30, 0x11, 0xfa, 0xf3, 0x02, 0x5c, 0x22,
-	0x23, 0xfe, 0xf7, 0x4c, 0x8f, 0x4f, 0x73, 0xaa, 0x4b, 0x44, 0x39, 0x37, 0xf9, 0x19, 0x3d, 0x45,
-	0x23, 0xff, 0x66, 0x0d, 0x8f, 0x6c, 0x84, 0xf5, 0x5f, 0x45, 0x58, 0xc8, 0xfd, 0x1b, 0xdb, 0xd8,
-	0xe6, 0x56, 0x7f, 0x07, 0x9a, 0xfb, 0x3b, 0x9a, 0x46, 0x54, 0xca, 0x81, 0xb8, 0x7b, 0x81, 0x6f,
-	0xde, 0x0c, 0x65, 0x94, 0x83, 0x2a, 0xc7, 0x09, 0x47, 0x7d, 0xf5, 0xc3, 0x4f, 0xae, 0x3e, 0xf5,
-	0xd1, 0x27, 0x57, 0x9f, 0xfa, 0xf5, 0x27, 0x57, 0x9f, 0x7a, 0xef, 0xf4, 0x6a, 0xe1, 0xc3, 0xd3,
-	0xab, 0x85, 0x8f, 0x4e, 0xaf, 0x16, 0x7e, 0x7d, 0x7a, 0xb5, 0xf0, 0xf1, 0xe9, 0xd5, 0xc2, 0x9f,
-	0xfd, 0xfb, 0xd5, 0xa7, 0xfe, 0xa8, 0x62, 0x46, 0xef, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd3,
-	0x79, 0x38, 0x1c, 0x7d, 0x52, 0x00, 0x00,
+	// 7023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5d, 0x4d, 0x6c, 0x24, 0xc7,
+	0x75, 0xd6, 0xfc, 0x72, 0xa6, 0xf8, 0xb3, 0xdc, 0xd2, 0xae, 0xdd, 0xa2, 0xa5, 0xe5, 0xa2, 0x15,
+	0xdb, 0x52, 0x62, 0x73, 0x2d, 0xad, 0x94, 0x48, 0xb2, 0x2d, 0x99, 0xc3, 0x21, 0x57, 0xd4, 0x92,
+	0x4b, 0xee, 0x6b, 0xee, 0xca, 0xb6, 0x64, 0xad, 0x9b, 0xdd, 0x35, 0xc3, 0x16, 0x7b, 0xba, 0x67,
+	0xbb, 0x7b, 0xb8, 0x4b, 0xe7, 0x10, 0xc3, 0x81, 0x8d, 0x04, 0x88, 0x81, 0x04, 0x39, 0xe4, 0x10,
+	0xe4, 0x92, 0x40, 0xc9, 0x21, 0x07, 0x03, 0x01, 0x12, 0xc4, 0x17, 0x03, 0xc9, 0x21, 0x11, 0x90,
+	0x8b, 0x83, 0x5c, 0x0c, 0x03, 0x61, 0x2c, 0x26, 0x40, 0x90, 0xdc, 0xe2, 0x00, 0x39, 0x2c, 0x72,
+	0x08, 0x5e, 0xfd, 0x75, 0xf7, 0xfc, 0xec, 0x92, 0x33, 0xbb, 0x2b, 0xe7, 0x36, 0x5d, 0xef, 0xd5,
+	0xf7, 0xaa, 0xab, 0xab, 0x5e, 0xbd, 0x7a, 0xef, 0x55, 0x0d, 0x59, 0x69, 0x7b, 0xc9, 0x5e, 0x6f,
+	0x77, 0xc9, 0x09, 0x3b, 0x97, 0xec, 0xa8, 0x1d, 0x76, 0xa3, 0xf0, 0xfd, 0xcf, 0xfb, 0xf6, 0x6e,
+	0xcc, 0x9f, 0x3e, 0xef, 0xda, 0x89, 0xdd, 0xf2, 0xc3, 0x3b, 0x97, 0xec, 0xae, 0x77, 0xe9, 0xe0,
+	0x05, 0xdb, 0xef, 0xee, 0xd9, 0x2f, 0x5c, 0x6a, 0xb3, 0x80, 0x45, 0x76, 0xc2, 0xdc, 0xa5, 0x6e,
+	0x14, 0x26, 0x21, 0xbd, 0x9c, 0x82, 0x2c, 0x29, 0x90, 0x5b, 0x08, 0xc2, 0x9f, 0x6e, 0x29, 0x90,
+	0x25, 0xbb, 0xeb, 0x2d, 0x29, 0x90, 0x85, 0xcf, 0x67, 0x24, 0xb7, 0xc3, 0x76, 0x78, 0x89, 0x63,
+	0xed, 0xf6, 0x5a, 0xfc, 0x89, 0x3f, 0xf0, 0x5f, 0x42, 0xc6, 0x82, 0xb9, 0xff, 0x4a, 0xbc, 0xe4,
+	0x85, 0xbc, 0x21, 0x4e, 0x18, 0xb1, 0x4b, 0x07, 0x03, 0xed, 0x58, 0x78, 0x29, 0xe5, 0xe9, 0xd8,
+	0xce, 0x9e, 0x17, 0xb0, 0xe8, 0xf0, 0x52, 0x77, 0xbf, 0xcd, 0x2b, 0x45, 0x2c, 0x0e, 0x7b, 0x91,
+	0xc3, 0x4e, 0x55, 0x2b, 0xbe, 0xd4, 0x61, 0x89, 0x3d, 0x4c, 0xd6, 0xe5, 0x51, 0xb5, 0x7a, 0x89,
+	0xe7, 0x5f, 0xf2, 0x82, 0x24, 0x4e, 0xa2, 0xfe, 0x4a, 0xe6, 0x0f, 0x8b, 0x64, 0x6e, 0xf9, 0x6d,
+	0x6b, 0x25, 0x62, 0x2e, 0x0b, 0x12, 0xcf, 0xf6, 0x63, 0xfa, 0x2e, 0x99, 0xb6, 0x1d, 0x87, 0xc5,
+	0xf1, 0x55, 0x76, 0xb8, 0xee, 0x1a, 0x85, 0x8b, 0x85, 0xe7, 0xa6, 0x5f, 0xfc, 0xf4, 0x92, 0x40,
+	0xe7, 0x3d, 0x86, 0x6f, 0xbb, 0x74, 0xf0, 0xc2, 0x92, 0xc5, 0x9c, 0x88, 0x25, 0x57, 0xd9, 0xa1,
+	0xc5, 0x7c, 0xe6, 0x24, 0x61, 0xd4, 0x78, 0xf2, 0xc3, 0xa3, 0xc5, 0x27, 0x8e, 0x8f, 0x16, 0xa7,
+	0x97, 0x35, 0x42, 0x13, 0xb2, 0x70, 0x74, 0x8f, 0x9c, 0x89, 0x79, 0x35, 0xcd, 0x61, 0x14, 0x4f,
+	0x23, 0xe1, 0x93, 0x52, 0xc2, 0x19, 0x2b, 0x8f, 0x02, 0xfd, 0xb0, 0xf4, 0x16, 0x99, 0x89, 0x59,
+	0x1c, 0x7b, 0x61, 0xb0, 0x13, 0xee, 0xb3, 0xc0, 0x28, 0x9d, 0x46, 0xcc, 0x39, 0x29, 0x66, 0xc6,
+	0xca, 0x40, 0x40, 0x0e, 0xd0, 0xfc, 0x1c, 0x99, 0x5e, 0x7e, 0xdb, 0x5a, 0x0d, 0xdc, 0x6e, 0xe8,
+	0x05, 0x09, 0x7d, 0x86, 0x94, 0x7a, 0x91, 0xcf, 0xfb, 0xab, 0xde, 0x98, 0x96, 0xf5, 0x4b, 0x37,
+	0x60, 0x03, 0xb0, 0xdc, 0xf4, 0xc8, 0xcc, 0xf2, 0x6e, 0x9c, 0x44, 0xb6, 0x93, 0x58, 0x09, 0xeb,
+	0xd2, 0xaf, 0x91, 0xba, 0x1a, 0x00, 0xb1, 0xec, 0xe4, 0xe7, 0x86, 0xb5, 0x0d, 0x24, 0x13, 0xb0,
+	0xdb, 0x3d, 0x2f, 0x62, 0x1d, 0x16, 0x24, 0x71, 0xe3, 0xac, 0x84, 0xaf, 0x2b, 0x6a, 0x0c, 0x29,
+	0x9a, 0xf9, 0xc7, 0xe7, 0xc8, 0x39, 0x25, 0xeb, 0x66, 0xe8, 0xf7, 0x3a, 0xcc, 0xe2, 0x14, 0x0a,
+	0xa4, 0xb6, 0x17, 0xc6, 0xc9, 0xb6, 0x9d, 0xec, 0xdd, 0x4f, 0xe4, 0x9b, 0x92, 0x27, 0x5b, 0xb7,
+	0x31, 0x73, 0x7c, 0xb4, 0x58, 0x53, 0x14, 0xd0, 0x38, 0x88, 0xc9, 0x3a, 0xdd, 0xe4, 0xb0, 0xe9,
+	0x45, 0x46, 0x71, 0x34, 0xe6, 0xaa, 0xe4, 0x19, 0xc4, 0x54, 0x14, 0xd0, 0x38, 0xf4, 0x80, 0x9c,
+	0x6d, 0x3b, 0x6c, 0x9b, 0x45, 0xb1, 0x17, 0x27, 0x2c, 0x48, 0x9a, 0x5e, 0xbc, 0x2f, 0xbf, 0xdf,
+	0x0b, 0xc3, 0xc0, 0xaf, 0xac, 0xac, 0xe6, 0x99, 0x73, 0x52, 0xce, 0x1f, 0x1f, 0x2d, 0x9e, 0x1d,
+	0x60, 0x81, 0x41, 0x11, 0xf4, 0x3b, 0x05, 0x72, 0xce, 0xbe, 0x13, 0xaf, 0xfa, 0x76, 0x9c, 0x78,
+	0x4e, 0xc3, 0x0f, 0x9d, 0x7d, 0x2b, 0x09, 0x23, 0x66, 0x94, 0xb9, 0xec, 0x97, 0x86, 0xc9, 0xc6,
+	0x21, 0xd0, 0xcf, 0x9f, 0x13, 0x6f, 0x1c, 0x1f, 0x2d, 0x9e, 0x1b, 0xc6, 0x05, 0x43, 0x65, 0xd1,
+	0x6b, 0x64, 0xaa, 0xed, 0x25, 0xc0, 0xba, 0xa1, 0x51, 0xe1, 0x62, 0x3f, 0x3b, 0xf4, 0x95, 0x05,
+	0x4b, 0x4e, 0xd2, 0xf4, 0xf1, 0xd1, 0xe2, 0x94, 0x24, 0x80, 0x02, 0xa1, 0x6f, 0x91, 0xaa, 0x98,
+	0x1a, 0x46, 0x95, 0xc3, 0x7d, 0x66, 0xf4, 0x0c, 0xc8, 0xa1, 0x91, 0xe3, 0xa3, 0xc5, 0xaa, 0x28,
+	0x07, 0x89, 0x40, 0x5f, 0x27, 0xa5, 0xa0, 0x15, 0x1b, 0x53, 0x1c, 0xe8, 0xd9, 0x61, 0x40, 0xd7,
+	0xd6, 0xac, 0x1c, 0xca, 0x14, 0x4e, 0x82, 0x6b, 0x6b, 0x16, 0x60, 0x45, 0xba, 0x46, 0x2a, 0x5e,
+	0xec, 0xc4, 0x9e, 0x51, 0x1b, 0x3d, 0x19, 0xd7, 0xad, 0x15, 0x6b, 0x3d, 0x87, 0x51, 0x3f, 0x3e,
+	0x5a, 0xac, 0xf0, 0x62, 0x10, 0xd5, 0xe9, 0x4d, 0x52, 0x6f, 0xfb, 0xbd, 0x38, 0x61, 0x51, 0x2b,
+	0x36, 0xea, 0x1c, 0xeb, 0xf9, 0xa1, 0xbd, 0xa4, 0x98, 0x72, 0x78, 0xb3, 0x38, 0x73, 0x34, 0x09,
+	0x52, 0x28, 0xfa, 0xbd, 0x02, 0x39, 0xdf, 0xd5, 0x63, 0x42, 0x54, 0x5a, 0xf1, 0x6d, 0xaf, 0x63,
+	0x10, 0x2e, 0xe4, 0xe5, 0x61, 0x42, 0xb6, 0x87, 0x55, 0xc8, 0x09, 0x7c, 0xea, 0xf8, 0x68, 0xf1,
+	0xfc, 0x50, 0x36, 0x18, 0x2e, 0x0e, 0x3b, 0x3a, 0xda, 0x75, 0x8d, 0xe9, 0xd1, 0x1d, 0x0d, 0x8d,
+	0xe6, 0x60, 0x47, 0x43, 0xa3, 0x09, 0x58, 0x91, 0xee, 0x10, 0xd2, 0xf2, 0xd9, 0x5d, 0xc1, 0x61,
+	0xcc, 0x70, 0x98, 0x5f, 0x1a, 0x06, 0xb3, 0xa6, 0xb9, 0x24, 0xce, 0xdc, 0xf1, 0xd1, 0x22, 0x49,
+	0x4b, 0x21, 0x83, 0x83, 0x43, 0xc9, 0xf1, 0x02, 0x97, 0x45, 0xc6, 0xec, 0xe8, 0xa1, 0xb4, 0xc2,
+	0x39, 0x06, 0x87, 0x92, 0x28, 0x07, 0x89, 0xc0, 0xb1, 0x58, 0x77, 0xaf, 0x15, 0x1b, 0x73, 0xf7,
+	0xc1, 0x62, 0xdd, 0xbd, 0x35, 0x6b, 0x08, 0x16, 0x2f, 0x07, 0x89, 0x80, 0x53, 0xa6, 0x85, 0x13,
+	0x88, 0x45, 0xc6, 0x99, 0xd1, 0x53, 0x66, 0x4d, 0xb0, 0x0c, 0x4e, 0x19, 0x49, 0x00, 0x05, 0x42,
+	0xdf, 0x23, 0xd3, 0x6e, 0x78, 0x27, 0xb8, 0x63, 0x47, 0xee, 0xf2, 0xf6, 0xba, 0x31, 0xcf, 0x31,
+	0x7f, 0x65, 0x18, 0x66, 0x33, 0x65, 0xcb, 0xe1, 0x9e, 0xc1, 0x45, 0x30, 0x43, 0x84, 0x2c, 0x20,
+	0x7d, 0x8d, 0x14, 0x5b, 0x8e, 0x71, 0x96, 0xc3, 0x9a, 0x43, 0x9b, 0xba, 0x92, 0x43, 0xab, 0x1e,
+	0x1f, 0x2d, 0x16, 0xd7, 0x56, 0xa0, 0xd8, 0x72, 0x70, 0xe8, 0xdb, 0xdf, 0xea, 0x45, 0x6c, 0xcd,
+	0xf3, 0x99, 0x41, 0x47, 0x0f, 0xfd, 0x65, 0xc5, 0x34, 0x38, 0xf4, 0x35, 0x09, 0x52, 0x28, 0xc4,
+	0x75, 0xc2, 0xa0, 0xe5, 0xb5, 0x37, 0xed, 0xae, 0xf1, 0xe4, 0x68, 0xdc, 0x15, 0xc5, 0x34, 0x88,
+	0xab, 0x49, 0x90, 0x42, 0xd1, 0x7d, 0x32, 0x7b, 0x10, 0x77, 0xf7, 0x98, 0xd2, 0x8a, 0xc6, 0x39,
+	0x8e, 0xfd, 0xe2, 0x30, 0xec, 0x9b, 0x92, 0xd1, 0x8b, 0x92, 0x9e, 0xed, 0x0f, 0x28, 0xf2, 0xb3,
+	0xc7, 0x47, 0x8b, 0xb3, 0x37, 0xb3, 0x60, 0x90, 0xc7, 0xc6, 0x81, 0x70, 0xbb, 0x17, 0xee, 0x1e,
+	0x26, 0xcc, 0x38, 0x3f, 0x7a, 0x20, 0x5c, 0x17, 0x2c, 0x83, 0x03, 0x41, 0x12, 0x40, 0x81, 0xe8,
+	0xce, 0xe6, 0x0b, 0xd0, 0x27, 0x1e, 0xd0, 0xd9, 0x03, 0xed, 0x4d, 0x3b, 0x1b, 0x49, 0x90, 0x42,
+	0xf1, 0x85, 0xa6, 0xbb, 0x17, 0x26, 0x61, 0xd0, 0xb7, 0xc8, 0x7d, 0x72, 0xf4, 0x42, 0xb3, 0x3d,
+	0x84, 0x7f, 0x70, 0xa1, 0x19, 0xc6, 0x05, 0x43, 0x65, 0xe1, 0xcb, 0xa1, 0x5d, 0xcc, 0x9c, 0x84,
+	0xb9, 0xc6, 0xc2, 0xe8, 0x97, 0xdb, 0x56, 0x4c, 0x83, 0x2f, 0xa7, 0x49, 0x90, 0x42, 0x51, 0x97,
+	0xcc, 0x75, 0xc3, 0x28, 0xb9, 0x13, 0x46, 0x4a, 0xff, 0x18, 0xa3, 0xed, 0x82, 0xed, 0x1c, 0xa7,
+	0xc4, 0xa6, 0xc7, 0x47, 0x8b, 0x73, 0x79, 0x0a, 0xf4, 0x61, 0xe2, 0xa7, 0x8e, 0x1d, 0xdb, 0x67,
+	0xeb, 0x5b, 0xc6, 0x53, 0xa3, 0x3f, 0xb5, 0x25, 0x58, 0x06, 0x3f, 0xb5, 0x24, 0x80, 0x02, 0xc1,
+	0xde, 0x88, 0x93, 0x30, 0xb2, 0xdb, 0x2c, 0x8c, 0x8d, 0x4f, 0x8d, 0xee, 0x0d, 0x4b, 0x30, 0x6d,
+	0x59, 0x83, 0xbd, 0xa1, 0x49, 0x90, 0x42, 0xa1, 0x26, 0xc7, 0x05, 0xef, 0xe9, 0xd1, 0x9a, 0xbc,
+	0x7f, 0xb9, 0xe3, 0x9a, 0x1c, 0x17, 0xbb, 0x92, 0x5c, 0xea, 0x58, 0x77, 0x8f, 0x75, 0x58, 0x64,
+	0xfb, 0xc6, 0x33, 0xa3, 0xdb, 0xb5, 0xaa, 0x98, 0x06, 0xdb, 0xa5, 0x49, 0x90, 0x42, 0x99, 0xff,
+	0x50, 0x24, 0x53, 0x0d, 0xdb, 0xd9, 0x0f, 0x5b, 0x2d, 0xfa, 0x55, 0x52, 0x73, 0x7b, 0x91, 0x9d,
+	0x78, 0x61, 0x20, 0x4d, 0x9d, 0xa5, 0x8c, 0x08, 0xbd, 0x9b, 0x58, 0xea, 0xee, 0xb7, 0xb1, 0x20,
+	0x5e, 0xc2, 0x3d, 0x08, 0x57, 0x7f, 0xb2, 0x96, 0xb0, 0xe4, 0xd4, 0x13, 0x68, 0x34, 0xfa, 0x05,
+	0x32, 0xbf, 0x66, 0xa3, 0x45, 0xbd, 0xcd, 0x22, 0x87, 0x05, 0x89, 0xdd, 0x66, 0xdc, 0xaa, 0x99,
+	0x6d, 0x94, 0xd1, 0x84, 0x85, 0x01, 0x2a, 0x7d, 0x96, 0x54, 0xe2, 0x84, 0x75, 0x85, 0x4d, 0x5c,
+	0x6e, 0xcc, 0x4a, 0x4b, 0xb7, 0x82, 0x46, 0x73, 0x0c, 0x82, 0x46, 0xd7, 0x49, 0xc9, 0xb1, 0xbb,
+	0x46, 0x71, 0xac, 0xb6, 0x8a, 0xfe, 0xb5, 0xbb, 0x80, 0x18, 0xb4, 0x49, 0xe6, 0xdf, 0xf7, 0x92,
+	0x84, 0x65, 0x5b, 0x58, 0xe2, 0x2d, 0x34, 0xa4, 0xe8, 0xf9, 0xb7, 0xfa, 0xe8, 0x30, 0x50, 0xc3,
+	0xfc, 0xed, 0x02, 0x99, 0x69, 0xd8, 0x89, 0xb3, 0xb7, 0xc9, 0xe2, 0x18, 0x5f, 0xe3, 0x1d, 0x52,
+	0x46, 0xc1, 0xd2, 0xcc, 0x7e, 0x75, 0x69, 0x8c, 0x0d, 0xe9, 0xd2, 0x26, 0x4b, 0xec, 0xc6, 0x8c,
+	0x6c, 0x45, 0x19, 0x9f, 0x80, 0x83, 0xd2, 0xa7, 0x49, 0x19, 0x6b, 0xf0, 0xf7, 0x9f, 0x69, 0xd4,
+	0x90, 0xda, 0xb4, 0x91, 0x8a, 0xa5, 0xe6, 0x47, 0x05, 0x32, 0xcd, 0xdb, 0xb2, 0xd5, 0x4b, 0xba,
+	0xbd, 0x44, 0x73, 0x17, 0x86, 0x71, 0xd3, 0xbb, 0x64, 0x6a, 0x8f, 0xd9, 0x2e, 0x8b, 0x62, 0xa3,
+	0x78, 0xb1, 0xf4, 0xdc, 0xf4, 0x8b, 0x9b, 0x63, 0xb5, 0x35, 0x23, 0x70, 0xe9, 0x4d, 0x81, 0xb7,
+	0x1a, 0x24, 0xd1, 0x61, 0xe3, 0x8c, 0x6c, 0xff, 0x94, 0x2c, 0x05, 0x25, 0x6e, 0xe1, 0x35, 0x32,
+	0x93, 0xe5, 0xa4, 0xf3, 0xa4, 0xb4, 0xcf, 0x0e, 0xc5, 0x06, 0x0a, 0xf0, 0x27, 0x3d, 0x47, 0x2a,
+	0x07, 0xb6, 0xdf, 0x63, 0xfc, 0x45, 0xeb, 0x20, 0x1e, 0x5e, 0x2b, 0xbe, 0x52, 0x30, 0xff, 0xbb,
+	0x28, 0xdf, 0x11, 0x58, 0xdc, 0xf3, 0x1f, 0xf4, 0x8e, 0xcf, 0x92, 0x0a, 0x8b, 0xa2, 0x50, 0x6c,
+	0x50, 0xea, 0xe9, 0x98, 0x5a, 0xc5, 0x42, 0x10, 0x34, 0xba, 0x4f, 0xa6, 0x42, 0xde, 0xfe, 0xd8,
+	0x28, 0xf1, 0x8e, 0xf8, 0xca, 0xa4, 0x1d, 0x91, 0xbe, 0xbb, 0x78, 0x8e, 0x41, 0x49, 0xc8, 0xf6,
+	0x7a, 0x79, 0xd2, 0x5e, 0x17, 0x5d, 0xf0, 0x38, 0x7a, 0xfd, 0x3b, 0x05, 0x52, 0x5a, 0xb1, 0x13,
+	0xfa, 0xeb, 0x64, 0xc6, 0xce, 0xec, 0x65, 0xe5, 0x20, 0x5f, 0x1e, 0xeb, 0x15, 0xb2, 0x9b, 0xe2,
+	0x74, 0xdb, 0x9d, 0x2d, 0x85, 0x9c, 0x30, 0xf3, 0x7f, 0x0b, 0x64, 0x6e, 0xc5, 0x8b, 0x9c, 0x9e,
+	0x97, 0x34, 0x22, 0x66, 0xa3, 0xbd, 0xd6, 0x24, 0xf3, 0x2d, 0xdb, 0xf3, 0x7b, 0x11, 0xdb, 0xd9,
+	0x8b, 0x58, 0xbc, 0x17, 0xfa, 0xc2, 0x6f, 0x91, 0x99, 0xc3, 0x6b, 0x7d, 0x74, 0x18, 0xa8, 0x41,
+	0x5d, 0x32, 0x13, 0x76, 0x59, 0xa0, 0xf4, 0xc4, 0x98, 0xda, 0x65, 0x1e, 0x9b, 0xbf, 0x95, 0xc1,
+	0x81, 0x1c, 0x2a, 0x7d, 0x9d, 0xcc, 0xed, 0xd9, 0x7e, 0x0b, 0x39, 0xb6, 0xa3, 0x70, 0x97, 0xc5,
+	0x52, 0xdb, 0x7c, 0x42, 0xb6, 0x74, 0xee, 0xcd, 0x1c, 0x15, 0xfa, 0xb8, 0x51, 0xd3, 0x94, 0x57,
+	0x42, 0x97, 0xd1, 0x97, 0xc8, 0x54, 0xd4, 0x0b, 0x12, 0xaf, 0x23, 0xb6, 0xa7, 0xf5, 0xc6, 0x82,
+	0xfa, 0xe6, 0x20, 0x8a, 0xef, 0xa5, 0x3f, 0x41, 0xb1, 0xe2, 0x54, 0xf0, 0x3a, 0x4a, 0x0b, 0x67,
+	0xa6, 0xc2, 0x3a, 0x16, 0x82, 0xa0, 0xd1, 0xcf, 0x90, 0xaa, 0xf0, 0x25, 0xf0, 0xb6, 0xd5, 0x1b,
+	0x73, 0x92, 0xab, 0x2a, 0x56, 0x15, 0x90, 0x54, 0xf3, 0x47, 0x25, 0x82, 0x46, 0x5f, 0x62, 0x63,
+	0xa7, 0xa4, 0xd0, 0x85, 0xfb, 0x40, 0x7f, 0x8d, 0xcc, 0x1c, 0xf0, 0x05, 0x6a, 0x33, 0xec, 0x05,
+	0x49, 0x6c, 0x54, 0xf8, 0xe8, 0x5f, 0x1c, 0x6a, 0x0d, 0xa6, 0x7c, 0xe9, 0xc0, 0xc8, 0x14, 0xc6,
+	0x90, 0x83, 0xa2, 0x37, 0x49, 0xd1, 0x53, 0x6e, 0x9e, 0xd7, 0xc7, 0x1a, 0x8b, 0xeb, 0x01, 0x6e,
+	0x03, 0x6d, 0x65, 0x71, 0xaf, 0x07, 0x50, 0xf4, 0x02, 0xfa, 0x69, 0x32, 0xe5, 0x84, 0x9d, 0x8e,
+	0x1d, 0xb8, 0x46, 0xf5, 0x62, 0x09, 0x9d, 0x3b, 0xd8, 0xc9, 0x2b, 0xa2, 0x08, 0x14, 0x0d, 0x55,
+	0x90, 0x1d, 0xb5, 0x71, 0x73, 0x8c, 0x3c, 0x5c, 0x05, 0x2d, 0x47, 0xed, 0x18, 0x78, 0x29, 0x7d,
+	0x95, 0x94, 0x58, 0x70, 0x60, 0xd4, 0xf8, 0xeb, 0x2e, 0x0c, 0x5d, 0xc0, 0x83, 0x83, 0x9b, 0x76,
+	0x94, 0x7a, 0x8e, 0x56, 0x83, 0x03, 0xc0, 0x3a, 0x79, 0x4f, 0x51, 0xfd, 0xa1, 0x7a, 0x8a, 0xde,
+	0x25, 0xe5, 0x95, 0x28, 0x0c, 0xe8, 0xe7, 0x48, 0x2d, 0x76, 0xf6, 0x98, 0xdb, 0xf3, 0xd5, 0xd7,
+	0x9b, 0x97, 0xf5, 0x6a, 0x96, 0x2c, 0x07, 0xcd, 0x81, 0xc3, 0xc3, 0xb7, 0x0f, 0xc3, 0x5e, 0x62,
+	0x14, 0xf3, 0xc3, 0x63, 0x83, 0x97, 0x82, 0xa4, 0x9a, 0x7f, 0x56, 0x20, 0x33, 0xcd, 0x06, 0xea,
+	0x61, 0xe9, 0x7f, 0x7a, 0x56, 0x69, 0x96, 0xbe, 0x11, 0x72, 0x13, 0x0b, 0xa5, 0xa2, 0xa1, 0x11,
+	0xa9, 0xf3, 0x1f, 0x6b, 0x51, 0xd8, 0x91, 0x73, 0x70, 0x75, 0xac, 0xaf, 0x99, 0x15, 0x8d, 0x60,
+	0xc2, 0x18, 0xba, 0xa9, 0xb0, 0x21, 0x15, 0x63, 0x86, 0x64, 0xbe, 0x9f, 0x9b, 0xbe, 0x43, 0x66,
+	0x84, 0xd7, 0x03, 0xbd, 0x8b, 0xac, 0x75, 0x3a, 0x47, 0xe8, 0xbc, 0xf0, 0x1d, 0xa6, 0xd5, 0x21,
+	0x07, 0x66, 0xfe, 0xac, 0x40, 0xaa, 0xcd, 0x86, 0xe5, 0x05, 0xfb, 0x74, 0x9f, 0xd4, 0xb0, 0xfd,
+	0xbb, 0x76, 0xcc, 0xa4, 0x8c, 0x2f, 0x8f, 0xf7, 0xba, 0x12, 0x24, 0xfd, 0x74, 0xaa, 0x04, 0xb4,
+	0x00, 0xea, 0x91, 0x29, 0xdb, 0x41, 0x3d, 0xa4, 0x56, 0xfb, 0xf1, 0x26, 0x8a, 0x75, 0x7d, 0x63,
+	0x99, 0xc3, 0xa4, 0x0b, 0x8d, 0x78, 0x8e, 0x41, 0xe1, 0x9b, 0xff, 0x56, 0x22, 0xb5, 0x66, 0x43,
+	0x7e, 0xf9, 0xc7, 0xfa, 0x92, 0xcf, 0x92, 0xca, 0xed, 0x1e, 0x8b, 0x0e, 0xfb, 0x97, 0xfb, 0xeb,
+	0x58, 0x08, 0x82, 0x46, 0x5f, 0x21, 0x33, 0x61, 0xab, 0x15, 0xb3, 0x64, 0x05, 0x75, 0x48, 0x20,
+	0x35, 0x9d, 0xd6, 0x33, 0x5b, 0x19, 0x1a, 0xe4, 0x38, 0xe9, 0x1e, 0x99, 0xe9, 0x86, 0xbe, 0xcf,
+	0x95, 0xc5, 0x81, 0xed, 0x8f, 0x69, 0x31, 0x6b, 0x49, 0xdb, 0x19, 0x2c, 0xc8, 0x21, 0xd3, 0x80,
+	0xcc, 0xa1, 0x76, 0xf1, 0x12, 0x2d, 0xab, 0x32, 0x96, 0x2c, 0xbd, 0xb6, 0xac, 0xe4, 0xd0, 0xa0,
+	0x0f, 0x9d, 0xbe, 0x48, 0x88, 0x17, 0x78, 0x09, 0x4e, 0xf9, 0x8e, 0xcd, 0xdd, 0x85, 0xb5, 0x06,
+	0x95, 0x75, 0xc9, 0xba, 0xa6, 0x40, 0x86, 0xcb, 0xfc, 0xa0, 0x40, 0xf4, 0x37, 0x40, 0xcd, 0xe0,
+	0x46, 0xde, 0x01, 0x8b, 0x8c, 0x42, 0x5e, 0x33, 0x34, 0x79, 0x29, 0x48, 0x2a, 0xbd, 0x4d, 0x88,
+	0xab, 0x67, 0x9b, 0x51, 0x9c, 0xc0, 0x7c, 0xc8, 0x4e, 0x5b, 0xe1, 0xbb, 0x4a, 0x9f, 0x21, 0x23,
+	0xc4, 0xfc, 0xa3, 0x32, 0x21, 0x4d, 0x66, 0xbb, 0x1b, 0x0c, 0x4d, 0xf7, 0xd4, 0x24, 0x2c, 0xdc,
+	0xc7, 0x24, 0x44, 0xb5, 0x98, 0xb0, 0xee, 0x35, 0xbb, 0x23, 0x8d, 0xa1, 0x8c, 0x5a, 0x94, 0xe5,
+	0xa0, 0x39, 0xb0, 0xf7, 0x84, 0x5e, 0xe5, 0xfc, 0x62, 0x3c, 0xe9, 0xde, 0xb3, 0x34, 0x05, 0x32,
+	0x5c, 0x28, 0xc1, 0x4e, 0x12, 0x74, 0x7c, 0xc7, 0x7c, 0x1c, 0x95, 0x53, 0x09, 0xcb, 0xb2, 0x1c,
+	0x34, 0x07, 0xed, 0x92, 0xf9, 0x96, 0x17, 0xc5, 0x89, 0x32, 0x66, 0x70, 0xed, 0x17, 0x23, 0xe2,
+	0x97, 0x4f, 0x36, 0x22, 0xb0, 0x46, 0xc6, 0x26, 0xea, 0xc3, 0x82, 0x01, 0x74, 0xda, 0x21, 0x67,
+	0x7c, 0x3b, 0x57, 0x64, 0x54, 0x4f, 0x2d, 0x50, 0xc7, 0x6c, 0x36, 0xf2, 0x50, 0xd0, 0x8f, 0xad,
+	0x77, 0x4d, 0x53, 0x8f, 0x72, 0xd7, 0x54, 0x1b, 0xba, 0x6b, 0xfa, 0xbd, 0x32, 0xa9, 0x36, 0x99,
+	0xdb, 0xeb, 0xb2, 0x8f, 0xd5, 0xbc, 0xe5, 0x61, 0x24, 0xcf, 0x95, 0xc3, 0x2d, 0x0d, 0x23, 0xad,
+	0x37, 0x01, 0xcb, 0xe9, 0xd7, 0xc8, 0x54, 0xc7, 0xbe, 0x6b, 0x79, 0xdf, 0x62, 0x46, 0xe9, 0xc1,
+	0xba, 0x60, 0x49, 0x2d, 0xf5, 0x4b, 0xd7, 0x7b, 0x76, 0x90, 0x78, 0x49, 0x66, 0x67, 0xb0, 0x29,
+	0x60, 0x40, 0xe1, 0xe1, 0xa6, 0x3a, 0x49, 0xc6, 0x55, 0x67, 0x7c, 0x53, 0xbd, 0xb3, 0xb3, 0x01,
+	0x88, 0x41, 0x1d, 0x32, 0x25, 0x3d, 0x20, 0x72, 0x7c, 0x7e, 0x69, 0xbc, 0x65, 0x46, 0x60, 0x48,
+	0x8f, 0x8d, 0x78, 0x00, 0x85, 0x4c, 0xbf, 0x49, 0x2a, 0x11, 0x73, 0xbd, 0x58, 0x8e, 0xc8, 0x37,
+	0xc6, 0x12, 0x01, 0x88, 0x80, 0xd0, 0x32, 0xcc, 0xc0, 0x9f, 0x41, 0x00, 0x9b, 0xdf, 0x2d, 0x90,
+	0xea, 0xea, 0xdd, 0x2e, 0x5a, 0x77, 0x1f, 0xeb, 0x96, 0xe7, 0x87, 0x05, 0x52, 0x5d, 0xf3, 0x7c,
+	0xd4, 0x5b, 0x1f, 0xeb, 0xd8, 0x7c, 0x91, 0x10, 0x76, 0xb7, 0x1b, 0x89, 0x20, 0xa8, 0x51, 0xcc,
+	0x6b, 0xb8, 0x55, 0x4d, 0x81, 0x0c, 0x97, 0xf9, 0xbd, 0x02, 0x99, 0x5a, 0xf3, 0x51, 0x85, 0x05,
+	0x1f, 0x6f, 0x27, 0x56, 0x49, 0xf9, 0x0a, 0x6c, 0xaf, 0x98, 0x3f, 0xab, 0x92, 0xd9, 0x2b, 0x2c,
+	0xd9, 0x0e, 0x5d, 0xab, 0xcb, 0x1c, 0x60, 0xb7, 0xe9, 0xf3, 0x64, 0xca, 0x11, 0x21, 0x20, 0xb9,
+	0x1a, 0xe8, 0x39, 0xb2, 0x22, 0x8a, 0x41, 0xd1, 0xd1, 0x6a, 0xe8, 0x7a, 0x5d, 0xe6, 0x7b, 0x41,
+	0x56, 0xcb, 0xa7, 0x6b, 0x79, 0x86, 0x06, 0x39, 0x4e, 0x14, 0x12, 0xb1, 0xae, 0xef, 0x39, 0x36,
+	0x9f, 0x61, 0x95, 0x54, 0x08, 0x88, 0x62, 0x50, 0x74, 0xfa, 0x32, 0x99, 0xe6, 0x9b, 0xa5, 0xb5,
+	0x30, 0xea, 0xd8, 0x89, 0xdc, 0xa9, 0xe9, 0xd0, 0xfa, 0x7a, 0x4a, 0x82, 0x2c, 0x1f, 0x56, 0x8b,
+	0x7a, 0x41, 0xc0, 0x22, 0xce, 0x61, 0x54, 0xf3, 0xd5, 0x20, 0x25, 0x41, 0x96, 0x8f, 0x5a, 0x84,
+	0x74, 0x7b, 0xbe, 0xbf, 0x1d, 0xfa, 0x9e, 0x73, 0xc8, 0x35, 0x6f, 0xbd, 0x71, 0x59, 0x7d, 0xd4,
+	0x6d, 0x4d, 0xb9, 0x77, 0xb4, 0xf8, 0xcc, 0x60, 0xc6, 0xc3, 0x52, 0xca, 0x00, 0x19, 0x18, 0xba,
+	0x45, 0xe6, 0x7a, 0x5d, 0xd7, 0x4e, 0x98, 0xb6, 0x5c, 0x50, 0xeb, 0x96, 0x1a, 0x9f, 0x55, 0x96,
+	0xc8, 0x8d, 0x1c, 0xf5, 0xde, 0xd1, 0xe2, 0x2c, 0x6e, 0x4f, 0xb5, 0x3e, 0x81, 0xbe, 0xea, 0x34,
+	0x26, 0x04, 0x17, 0x5a, 0x2b, 0xb1, 0x93, 0x9e, 0xda, 0x05, 0xbd, 0x31, 0xa6, 0x52, 0x51, 0x30,
+	0x99, 0xd5, 0x59, 0x97, 0x41, 0x46, 0x0c, 0x6d, 0x93, 0xa9, 0xd8, 0x73, 0x99, 0x63, 0x47, 0x06,
+	0x99, 0x44, 0x8d, 0x09, 0x8c, 0xf4, 0x8b, 0xcb, 0x02, 0x50, 0xe8, 0x34, 0x20, 0xf3, 0xfc, 0x4b,
+	0x62, 0x6f, 0x8a, 0x5d, 0x43, 0x6c, 0x4c, 0x5f, 0x2c, 0x8d, 0xda, 0xe9, 0x6d, 0x84, 0x8e, 0xed,
+	0x6f, 0xed, 0xa2, 0xbf, 0x1d, 0x58, 0x8b, 0x45, 0x2c, 0x70, 0x32, 0xcb, 0xfa, 0x7a, 0x1f, 0x12,
+	0x0c, 0x60, 0xa3, 0xd9, 0x81, 0x01, 0xfc, 0xc0, 0x96, 0xc1, 0xc1, 0x8c, 0x61, 0xf3, 0xa6, 0x2c,
+	0x07, 0xcd, 0x41, 0x2f, 0x91, 0x7a, 0xdc, 0xdb, 0x75, 0xc3, 0x8e, 0xed, 0x05, 0x3c, 0xf2, 0x57,
+	0x4f, 0xb7, 0x95, 0x96, 0x22, 0x40, 0xca, 0x63, 0x7e, 0xa7, 0x42, 0x4a, 0x57, 0xbc, 0xe4, 0x64,
+	0x1e, 0x81, 0x13, 0x6e, 0xaf, 0x65, 0x7a, 0x45, 0x71, 0x78, 0x7a, 0x05, 0xb5, 0xc9, 0x5c, 0x2f,
+	0x66, 0x11, 0xb6, 0x57, 0xbc, 0xa4, 0x31, 0x75, 0x9a, 0xfd, 0x1a, 0x8f, 0x38, 0xdc, 0xc8, 0x01,
+	0x40, 0x1f, 0x20, 0x8a, 0xe8, 0xda, 0x71, 0x7c, 0x27, 0x8c, 0x5c, 0x29, 0xa2, 0x76, 0x6a, 0x11,
+	0xdb, 0x39, 0x00, 0xe8, 0x03, 0xa4, 0x16, 0x39, 0xef, 0x05, 0x31, 0x73, 0x7a, 0x11, 0x5b, 0x6f,
+	0x07, 0x61, 0xc4, 0xf0, 0x6b, 0x60, 0x8e, 0x0c, 0xe1, 0xb6, 0xf8, 0x33, 0xf2, 0xb5, 0xcf, 0xaf,
+	0x0f, 0x63, 0x82, 0xe1, 0x75, 0x69, 0x97, 0x3c, 0x19, 0xc7, 0x7b, 0xdb, 0x91, 0x77, 0x60, 0x27,
+	0x8c, 0xb7, 0x88, 0x37, 0xbe, 0x7e, 0xaa, 0xb4, 0x9b, 0xe3, 0xa3, 0xc5, 0x27, 0x2d, 0xeb, 0xcd,
+	0x7e, 0x14, 0x18, 0x06, 0x4d, 0x2f, 0x92, 0x72, 0x17, 0x73, 0x4c, 0x84, 0x76, 0xd4, 0xb6, 0x18,
+	0xcf, 0x1c, 0xe1, 0x14, 0xdc, 0x28, 0xec, 0x46, 0x76, 0xe0, 0xec, 0x19, 0xe5, 0xfc, 0x46, 0xa1,
+	0xc1, 0x4b, 0x41, 0x52, 0x95, 0xdb, 0xa4, 0x72, 0x7a, 0xb7, 0x89, 0xf9, 0x93, 0x12, 0xa9, 0x5c,
+	0x89, 0xc2, 0x1e, 0x37, 0xa9, 0xb4, 0x8b, 0x33, 0x65, 0xc4, 0x1e, 0xc3, 0x72, 0xbe, 0xaa, 0x05,
+	0xee, 0x56, 0x8b, 0x33, 0x0f, 0xac, 0x6a, 0x9a, 0x02, 0x19, 0x2e, 0xfa, 0x32, 0xa9, 0xb6, 0x84,
+	0x76, 0x16, 0xef, 0xa8, 0xbe, 0x4c, 0x55, 0xe8, 0xe2, 0x7b, 0x47, 0x8b, 0xd3, 0x9c, 0x51, 0x3c,
+	0x82, 0x64, 0xce, 0xda, 0x45, 0xe5, 0x47, 0x66, 0x17, 0x3d, 0x9f, 0x9a, 0x88, 0x22, 0xd4, 0x32,
+	0xda, 0xe4, 0x03, 0x52, 0xed, 0xd8, 0x77, 0x97, 0xdb, 0xca, 0xaa, 0x3f, 0xad, 0xd5, 0xc7, 0x83,
+	0xf1, 0x9b, 0x1c, 0x01, 0x24, 0x12, 0xb5, 0xc9, 0xb4, 0xe7, 0xfa, 0xdc, 0x9e, 0x0f, 0x7b, 0x6a,
+	0x1a, 0x9e, 0x16, 0x98, 0xc7, 0xcf, 0xd7, 0x53, 0x18, 0xc8, 0x62, 0x9a, 0x7f, 0x5a, 0x20, 0xe5,
+	0x37, 0x77, 0x76, 0xb6, 0x71, 0x39, 0xee, 0xd8, 0x77, 0xb9, 0x17, 0x9c, 0xbf, 0xaf, 0x70, 0xfa,
+	0xea, 0xe5, 0x78, 0x33, 0x43, 0x83, 0x1c, 0x27, 0x3a, 0x7b, 0xd5, 0xf3, 0xdb, 0xb6, 0x97, 0x4c,
+	0xe2, 0xec, 0xdd, 0xcc, 0xe0, 0x40, 0x0e, 0xd5, 0xfc, 0xfb, 0x02, 0x21, 0xd8, 0x50, 0xe1, 0x71,
+	0xc7, 0x79, 0xc1, 0x55, 0x6e, 0x21, 0x3f, 0x2f, 0xb8, 0xb5, 0xc0, 0x29, 0xa9, 0x87, 0xac, 0x78,
+	0x52, 0x0f, 0x59, 0x69, 0x02, 0x0f, 0x59, 0xda, 0xb4, 0x6c, 0xb8, 0x70, 0xa8, 0x87, 0x2c, 0x26,
+	0xf3, 0xfd, 0xdc, 0x22, 0xc3, 0x6e, 0x5c, 0x0f, 0x59, 0x26, 0xc3, 0x6e, 0xa4, 0x97, 0xec, 0xcf,
+	0x8b, 0xa4, 0x86, 0x52, 0xb9, 0x9f, 0xec, 0xfe, 0xf9, 0x75, 0xf4, 0xfd, 0xfe, 0x38, 0xd6, 0x1b,
+	0x13, 0x76, 0xc9, 0xe8, 0x18, 0x0a, 0x7d, 0x8b, 0x50, 0xa5, 0x6a, 0xad, 0x7d, 0xaf, 0x7b, 0x93,
+	0x45, 0x5e, 0xeb, 0x90, 0x7f, 0x89, 0x9a, 0xf6, 0xc2, 0xd3, 0xf5, 0x01, 0x0e, 0x18, 0x52, 0x8b,
+	0xbe, 0x49, 0xa6, 0x1d, 0x3f, 0xec, 0xb9, 0xab, 0x07, 0xe8, 0xaf, 0x95, 0xea, 0xf0, 0x33, 0xca,
+	0x6a, 0x5b, 0x49, 0x49, 0xf7, 0x8e, 0x16, 0xcf, 0x64, 0x1e, 0x37, 0x43, 0x97, 0x41, 0xb6, 0xaa,
+	0xf9, 0x7d, 0x39, 0xd8, 0xe4, 0xd7, 0x79, 0x99, 0x4c, 0xc7, 0x2c, 0x3a, 0xf0, 0xa4, 0x3f, 0xa2,
+	0x90, 0x37, 0x07, 0xad, 0x94, 0x04, 0x59, 0xbe, 0xfe, 0xf6, 0x14, 0xc7, 0x6f, 0xcf, 0xbf, 0x14,
+	0x48, 0x5d, 0x7b, 0xd4, 0x71, 0xec, 0xb7, 0xbc, 0x56, 0xc8, 0xdb, 0x51, 0x4b, 0xc7, 0xfe, 0xda,
+	0xfa, 0xda, 0x16, 0x70, 0x0a, 0x7d, 0x9b, 0x94, 0xf7, 0x92, 0x44, 0x45, 0x75, 0x5f, 0x1d, 0xfb,
+	0xf3, 0x89, 0xad, 0x3d, 0xfe, 0x02, 0x0e, 0x88, 0xc0, 0xed, 0xa8, 0xeb, 0x18, 0xa5, 0x09, 0x80,
+	0x71, 0xeb, 0x20, 0x80, 0xf1, 0x17, 0x70, 0x40, 0xf4, 0xe2, 0xd6, 0xdf, 0x62, 0x89, 0x95, 0x44,
+	0xcc, 0xee, 0x9c, 0x60, 0x76, 0x3f, 0x4f, 0xa6, 0x02, 0x3b, 0x89, 0x6f, 0x68, 0x3b, 0x46, 0x0f,
+	0xb1, 0x6b, 0xcb, 0x3b, 0x16, 0x0e, 0x65, 0x45, 0x47, 0xd6, 0xb8, 0xc7, 0x2d, 0x3c, 0xa3, 0x94,
+	0x67, 0xb5, 0x44, 0x31, 0x28, 0x3a, 0x3a, 0x4d, 0xec, 0x5e, 0xb2, 0x67, 0x94, 0x27, 0xf0, 0xab,
+	0xa2, 0xfc, 0xe5, 0x5e, 0xb2, 0x27, 0xe3, 0x16, 0x3d, 0x5c, 0xa8, 0x11, 0xd4, 0xfc, 0x76, 0x81,
+	0xcc, 0xea, 0x57, 0xe4, 0xf3, 0x30, 0x24, 0xf5, 0xf7, 0x19, 0x26, 0x13, 0x33, 0xbb, 0x23, 0xa7,
+	0xfc, 0x78, 0x4e, 0x64, 0x0d, 0x9b, 0x5a, 0x93, 0xba, 0x08, 0x52, 0x19, 0x18, 0x75, 0x3c, 0x93,
+	0x36, 0x41, 0x0c, 0xee, 0xc7, 0xde, 0x88, 0x7f, 0x2e, 0x93, 0xf2, 0x5b, 0xa1, 0xf7, 0xf1, 0xee,
+	0x61, 0xe9, 0x2d, 0x52, 0xf6, 0x59, 0x4b, 0xad, 0x56, 0xe3, 0x7d, 0x6a, 0x7c, 0x0b, 0xdc, 0x80,
+	0xa4, 0x23, 0x74, 0x83, 0xb5, 0x12, 0xe0, 0xc0, 0x74, 0x97, 0x54, 0x22, 0xaf, 0xbd, 0x97, 0x18,
+	0xa5, 0x87, 0x21, 0x41, 0x2f, 0x5f, 0x80, 0x98, 0x20, 0xa0, 0xd1, 0xe8, 0xb8, 0xe3, 0x05, 0x6e,
+	0x78, 0xc7, 0x28, 0x8f, 0x6f, 0x74, 0xbc, 0xcd, 0x11, 0x40, 0x22, 0xd1, 0xcf, 0x91, 0x72, 0x72,
+	0xd8, 0x55, 0x51, 0x4d, 0xb5, 0x15, 0x2a, 0xef, 0x1c, 0x76, 0x31, 0x0c, 0x5a, 0xc3, 0x16, 0xe1,
+	0x6f, 0xe0, 0x5c, 0xb8, 0xfd, 0x41, 0x8f, 0xaa, 0x6f, 0x27, 0x6a, 0x9b, 0xac, 0xb7, 0x3f, 0x3b,
+	0xb2, 0x1c, 0x34, 0x47, 0xd6, 0x68, 0x9b, 0x7a, 0x54, 0x46, 0x9b, 0x79, 0x9d, 0xd4, 0x54, 0xb7,
+	0x65, 0xc2, 0xaf, 0x85, 0xfb, 0x85, 0x5f, 0x95, 0x5d, 0x5b, 0x1c, 0x6e, 0xd7, 0xa2, 0xf1, 0x51,
+	0xb9, 0x6a, 0xb7, 0xf6, 0xed, 0x13, 0x68, 0xa6, 0x3b, 0x64, 0x7a, 0x1f, 0x59, 0x45, 0x0a, 0x9f,
+	0xfc, 0x30, 0xe3, 0x25, 0x40, 0x5c, 0x4d, 0x71, 0xd2, 0xe5, 0x26, 0x53, 0x08, 0x59, 0x49, 0x68,
+	0xf0, 0x24, 0x61, 0xd7, 0x73, 0xa4, 0x96, 0xd3, 0x23, 0x66, 0x07, 0x0b, 0x41, 0xd0, 0xcc, 0x7f,
+	0x2c, 0x90, 0x2c, 0x02, 0x6e, 0x19, 0x77, 0xa3, 0x70, 0x1f, 0xd7, 0xfa, 0x42, 0xba, 0x65, 0x6c,
+	0x88, 0x22, 0x50, 0x34, 0xfa, 0x55, 0x52, 0x0a, 0xd8, 0x64, 0x43, 0x99, 0x4b, 0xbd, 0xb6, 0xba,
+	0x23, 0xf3, 0x98, 0x57, 0x77, 0x00, 0x21, 0xe9, 0x32, 0x39, 0xd3, 0xb1, 0xef, 0xca, 0x5c, 0x9f,
+	0xc6, 0x61, 0xc2, 0x62, 0xe9, 0xd4, 0xd1, 0xae, 0xee, 0xcd, 0x3c, 0x19, 0xfa, 0xf9, 0xcd, 0xbf,
+	0x2e, 0x90, 0x9a, 0x42, 0xa7, 0x16, 0x29, 0x25, 0xbe, 0x3a, 0x06, 0xf0, 0xca, 0x58, 0x2d, 0xdd,
+	0xd9, 0xb0, 0xa4, 0x13, 0x76, 0xc3, 0x02, 0x44, 0xc3, 0x65, 0x2f, 0xb6, 0x63, 0x7f, 0xa2, 0xf5,
+	0xd4, 0x5a, 0xb6, 0x36, 0xc4, 0x9a, 0x80, 0xbf, 0x80, 0x03, 0x9a, 0xbf, 0x55, 0x27, 0x75, 0xde,
+	0x74, 0xbe, 0x1e, 0xdc, 0x22, 0x15, 0xfe, 0x41, 0x65, 0xeb, 0x5f, 0x1b, 0xbf, 0x9f, 0xd3, 0xaf,
+	0xcf, 0x1f, 0x41, 0xe0, 0xe2, 0x10, 0xb1, 0xe3, 0xc3, 0xc0, 0xe1, 0x2f, 0x52, 0x4b, 0x99, 0x96,
+	0xb1, 0x10, 0x04, 0x8d, 0xbe, 0x43, 0xea, 0xbb, 0x7a, 0x1b, 0x30, 0x9e, 0x67, 0x9c, 0x1b, 0xbf,
+	0xe9, 0x7e, 0x21, 0xc5, 0x43, 0x8d, 0xe5, 0x7b, 0x41, 0x9b, 0x45, 0x93, 0x68, 0xac, 0x0d, 0x8e,
+	0x00, 0x12, 0x09, 0x87, 0x90, 0x13, 0x76, 0x94, 0x9b, 0x74, 0x27, 0x55, 0x5e, 0x7a, 0x08, 0xad,
+	0xe4, 0xc9, 0xd0, 0xcf, 0x4f, 0xaf, 0x91, 0xb2, 0xed, 0xec, 0x2b, 0xff, 0xf7, 0x17, 0x46, 0x36,
+	0x0a, 0x0f, 0x00, 0x2d, 0x89, 0x03, 0x40, 0x98, 0xe2, 0xb0, 0x15, 0x59, 0x49, 0xe4, 0x05, 0x6d,
+	0xb9, 0xd6, 0x3b, 0xfb, 0x98, 0xa3, 0xe0, 0xec, 0xc7, 0xf4, 0x0a, 0x39, 0xcb, 0x02, 0x7b, 0xd7,
+	0x67, 0xeb, 0x2e, 0xeb, 0x74, 0xc3, 0x04, 0xdd, 0x4a, 0x5c, 0xe5, 0xd5, 0x1a, 0x4f, 0xc9, 0x46,
+	0x9d, 0x5d, 0xed, 0x67, 0x80, 0xc1, 0x3a, 0xf4, 0x7d, 0x32, 0xd7, 0x11, 0x63, 0x5d, 0xed, 0x02,
+	0x6b, 0x63, 0xf5, 0x1b, 0x77, 0x99, 0x6c, 0xe6, 0x90, 0xa0, 0x0f, 0x19, 0xcd, 0xdc, 0x8e, 0x7d,
+	0x77, 0x3d, 0x68, 0xf9, 0x7c, 0xdd, 0xaa, 0xf3, 0x1d, 0xa0, 0xd6, 0x3b, 0x9b, 0x29, 0x09, 0xb2,
+	0x7c, 0x4a, 0x77, 0x92, 0x11, 0x3e, 0x81, 0x4b, 0xa4, 0xde, 0xb5, 0xa3, 0xc4, 0xc3, 0x66, 0x18,
+	0xd3, 0x79, 0x97, 0xd7, 0xb6, 0x22, 0x40, 0xca, 0x43, 0x0f, 0xd2, 0xed, 0xc7, 0x0c, 0xdf, 0x7e,
+	0x5c, 0x1d, 0x7f, 0x1e, 0xe0, 0xb4, 0x3a, 0x61, 0x3a, 0x17, 0xfd, 0x22, 0x99, 0x4d, 0x22, 0x3b,
+	0x88, 0x45, 0xd4, 0xdd, 0xf6, 0xb9, 0x7f, 0xae, 0xd6, 0x38, 0x2f, 0x2b, 0xcc, 0xee, 0x64, 0x89,
+	0x90, 0xe7, 0xa5, 0xbf, 0x59, 0x20, 0x73, 0xb1, 0x08, 0xe9, 0xb2, 0xb6, 0x17, 0x27, 0xd1, 0xa1,
+	0x4c, 0xc6, 0xbf, 0x32, 0x9e, 0xb2, 0xc8, 0x41, 0xe1, 0x5b, 0x88, 0x2f, 0x98, 0x2f, 0x87, 0x3e,
+	0x91, 0x13, 0x65, 0xa4, 0xfd, 0xa0, 0x22, 0x57, 0x06, 0xbd, 0x25, 0x7d, 0xc4, 0xca, 0xa8, 0x49,
+	0xa6, 0xe3, 0xc4, 0x8e, 0x12, 0x91, 0x1f, 0x20, 0xd7, 0x5e, 0x53, 0xef, 0xaa, 0x52, 0xd2, 0x3d,
+	0xb5, 0xea, 0x89, 0x47, 0xc8, 0x56, 0xc3, 0x84, 0xdb, 0x16, 0xc3, 0x6c, 0x51, 0x9d, 0xb0, 0x74,
+	0x5a, 0x65, 0xc5, 0x13, 0x6e, 0xd7, 0x24, 0x06, 0x68, 0x34, 0xf4, 0x6b, 0xb4, 0x98, 0x74, 0x3f,
+	0x6c, 0xda, 0x77, 0x8d, 0xf2, 0xf8, 0x7e, 0x8d, 0xb5, 0x0c, 0x0e, 0xe4, 0x50, 0x71, 0x77, 0xd2,
+	0x46, 0xf7, 0xd6, 0xba, 0x2b, 0x95, 0x96, 0x1e, 0xa0, 0xdc, 0xeb, 0xb5, 0xde, 0x04, 0x45, 0xa7,
+	0x26, 0xa9, 0xf2, 0x45, 0x3c, 0x96, 0xde, 0x5d, 0xae, 0x0b, 0xf9, 0xea, 0x1e, 0x83, 0xa4, 0xd0,
+	0xdf, 0x18, 0x18, 0x86, 0xc2, 0xd0, 0x5a, 0x79, 0x08, 0xc3, 0xf0, 0x24, 0x43, 0x10, 0x95, 0x88,
+	0x13, 0x06, 0x4e, 0x2f, 0x42, 0x57, 0xfa, 0xa1, 0x51, 0xcb, 0x2b, 0x91, 0x95, 0x94, 0x04, 0x59,
+	0x3e, 0xf4, 0x1c, 0xee, 0xb3, 0xc3, 0xad, 0xc8, 0x65, 0x11, 0x73, 0x8d, 0x7a, 0x3e, 0x5f, 0xe2,
+	0xaa, 0xa6, 0x40, 0x86, 0xcb, 0xbc, 0x44, 0x4a, 0x1b, 0x61, 0x9b, 0x3e, 0x47, 0x6a, 0x49, 0xd4,
+	0x0b, 0x1c, 0x34, 0x41, 0x45, 0xa6, 0x33, 0xff, 0xa2, 0x3b, 0xb2, 0x0c, 0x34, 0xd5, 0xfc, 0xab,
+	0x02, 0x29, 0xe1, 0x41, 0x8a, 0xff, 0x77, 0x91, 0xbf, 0x0f, 0x4a, 0x84, 0x87, 0xdf, 0x4f, 0x6c,
+	0xcf, 0x2e, 0x90, 0xa2, 0x8e, 0x7c, 0x13, 0xc9, 0x53, 0x5c, 0x6f, 0x42, 0xd1, 0x73, 0xd1, 0x84,
+	0xe5, 0xa9, 0x8e, 0x25, 0x1e, 0x46, 0xd2, 0x26, 0x2c, 0x4f, 0x23, 0xe0, 0x94, 0xbe, 0xf4, 0x8b,
+	0xf2, 0x89, 0xd2, 0x2f, 0xb4, 0xf5, 0x59, 0x19, 0x6d, 0x7d, 0xe6, 0xd7, 0x82, 0x2a, 0x37, 0xf3,
+	0xee, 0xbf, 0x16, 0xdc, 0x4e, 0xd7, 0x82, 0x29, 0xbe, 0x16, 0xac, 0x8d, 0x9d, 0xc8, 0xf0, 0x38,
+	0xb2, 0x7a, 0xbf, 0x5d, 0x22, 0x35, 0x94, 0x85, 0xad, 0xa0, 0xdf, 0x2d, 0x90, 0x69, 0x3b, 0x08,
+	0xc2, 0xc4, 0x16, 0x59, 0x62, 0x05, 0xfe, 0x02, 0xd7, 0xc6, 0x7e, 0x01, 0xa4, 0x2c, 0x2d, 0xa7,
+	0x80, 0xe2, 0x45, 0xd2, 0x73, 0xc2, 0x29, 0x05, 0xb2, 0x72, 0xe9, 0x6d, 0xcc, 0x31, 0xdc, 0x65,
+	0xbe, 0xf2, 0xe6, 0xad, 0x4f, 0xd6, 0x82, 0x0d, 0x8e, 0x25, 0x84, 0x67, 0xd2, 0x15, 0xb1, 0x10,
+	0xa4, 0xa0, 0x85, 0xd7, 0xc9, 0x7c, 0x7f, 0x43, 0x4f, 0xd3, 0x8f, 0x0b, 0xaf, 0x92, 0xe9, 0x8c,
+	0x98, 0x53, 0x7d, 0x02, 0x20, 0x35, 0xe5, 0x81, 0xc1, 0x33, 0x92, 0x09, 0x3f, 0xb0, 0x7c, 0x2a,
+	0x77, 0x6a, 0x5d, 0x0c, 0x5b, 0x3c, 0xa5, 0x2c, 0xaa, 0x9b, 0x3f, 0x2a, 0x92, 0x9a, 0x8a, 0x47,
+	0xd3, 0x6f, 0x92, 0x5a, 0x47, 0xf6, 0x85, 0x51, 0x78, 0x80, 0xb9, 0x98, 0x5b, 0x12, 0x44, 0x94,
+	0x91, 0xe7, 0xd4, 0xe8, 0xc9, 0x94, 0x96, 0x81, 0x46, 0xa5, 0x0e, 0x29, 0xc7, 0x5d, 0xe6, 0x4c,
+	0x94, 0xcc, 0xa5, 0x9a, 0x8b, 0x81, 0xf9, 0x74, 0x8e, 0xe3, 0x13, 0x70, 0x70, 0xba, 0x4f, 0xaa,
+	0xb1, 0x88, 0x00, 0x97, 0x26, 0x58, 0x20, 0xb4, 0x18, 0x0e, 0x95, 0x51, 0x47, 0xfc, 0x19, 0xa4,
+	0x08, 0xf3, 0xc7, 0x05, 0xa2, 0x03, 0xfa, 0x1b, 0x5e, 0x9c, 0xd0, 0x77, 0x07, 0x3a, 0xf1, 0x84,
+	0xeb, 0x2a, 0xd6, 0xe6, 0x5d, 0xa8, 0xdd, 0x0c, 0xaa, 0x24, 0xd3, 0x81, 0xbb, 0xa4, 0xe2, 0x25,
+	0xac, 0xa3, 0x06, 0xfc, 0x97, 0x27, 0x7a, 0xb5, 0x4c, 0xac, 0x15, 0x31, 0x41, 0x40, 0x9b, 0xbf,
+	0x53, 0x4c, 0x5f, 0x09, 0xbb, 0x15, 0x85, 0xaa, 0xd3, 0x36, 0xe3, 0x0b, 0xe5, 0xd1, 0x73, 0xfc,
+	0x64, 0xc3, 0x0f, 0xeb, 0xb4, 0xc9, 0xac, 0xcb, 0x7c, 0x86, 0xb3, 0xaa, 0xc9, 0x7c, 0xfb, 0x70,
+	0xcc, 0x58, 0x0b, 0x3f, 0xfd, 0xd7, 0xcc, 0x02, 0x41, 0x1e, 0x57, 0xc4, 0xa9, 0xe3, 0x2e, 0x0b,
+	0x5c, 0xe6, 0x4a, 0x6f, 0x7c, 0x26, 0x4e, 0x2d, 0x09, 0x90, 0xf2, 0x98, 0x1f, 0x94, 0xc9, 0x5c,
+	0x7e, 0x30, 0xd0, 0x97, 0x48, 0xa5, 0xbb, 0xa7, 0xb2, 0x54, 0xeb, 0x8d, 0x0b, 0xea, 0x8d, 0xb6,
+	0xb1, 0x10, 0xd3, 0x14, 0x14, 0x3f, 0x2f, 0x00, 0xc1, 0xcc, 0x43, 0x6e, 0x62, 0x5b, 0xd2, 0xef,
+	0xd8, 0x95, 0xbb, 0x17, 0x50, 0x74, 0xea, 0x10, 0xe2, 0x84, 0x81, 0xeb, 0x09, 0xf5, 0x2a, 0x4e,
+	0x9a, 0x5c, 0x3a, 0x59, 0x57, 0xac, 0xa8, 0x7a, 0xe9, 0x54, 0xd4, 0x45, 0x31, 0x64, 0x60, 0x31,
+	0x06, 0xe7, 0xdb, 0x71, 0x22, 0x92, 0x2c, 0x5c, 0xa3, 0x7c, 0xea, 0x94, 0x3d, 0xad, 0xa0, 0x37,
+	0x52, 0x18, 0xc8, 0x62, 0xd2, 0x3f, 0x29, 0x90, 0xb3, 0xba, 0x27, 0x65, 0x0a, 0x8b, 0x4a, 0xe7,
+	0xff, 0xfa, 0x43, 0x98, 0x96, 0x4b, 0x56, 0x3f, 0xb8, 0xd0, 0xde, 0x7a, 0x27, 0x3a, 0x40, 0x87,
+	0xc1, 0xf6, 0x2c, 0x34, 0xc9, 0x27, 0x86, 0xe3, 0x3c, 0x48, 0x3d, 0xcf, 0x66, 0xd5, 0xf3, 0x21,
+	0xa9, 0x83, 0x9d, 0xb0, 0x0d, 0xaf, 0xe3, 0x25, 0xb8, 0x4b, 0x96, 0xdf, 0x32, 0xde, 0x66, 0x91,
+	0xc5, 0xb0, 0xdb, 0x65, 0xe0, 0x51, 0xb7, 0x6d, 0xb3, 0x9f, 0x01, 0x06, 0xeb, 0xa0, 0xf1, 0xb1,
+	0xdb, 0x8b, 0x62, 0xb1, 0x89, 0x98, 0x4d, 0x27, 0x4f, 0x03, 0x0b, 0x41, 0xd0, 0xcc, 0x7f, 0x2f,
+	0x10, 0x92, 0xe6, 0xa8, 0xe1, 0x40, 0xb3, 0x5d, 0x17, 0x2d, 0xac, 0xfe, 0x54, 0xa5, 0x65, 0x51,
+	0x0c, 0x8a, 0x3e, 0x24, 0x5d, 0xa1, 0xf8, 0xb0, 0xd3, 0x15, 0x16, 0x48, 0xd1, 0xdd, 0xe5, 0x33,
+	0xad, 0x92, 0x1a, 0x6c, 0xcd, 0x06, 0x14, 0xdd, 0x5d, 0x9c, 0x8c, 0xfb, 0xec, 0x70, 0x3b, 0x62,
+	0x2d, 0xef, 0xae, 0xb4, 0xc6, 0xf4, 0x64, 0xbc, 0xaa, 0x08, 0x90, 0xf2, 0x98, 0xbf, 0x5f, 0x24,
+	0x55, 0xfc, 0x44, 0xf6, 0x21, 0x1a, 0x8c, 0x3c, 0x01, 0x37, 0xee, 0x37, 0x18, 0x79, 0x76, 0x6e,
+	0x0c, 0x92, 0x4a, 0xaf, 0x92, 0x4a, 0xec, 0x05, 0x3a, 0x83, 0xf8, 0x34, 0x03, 0x9c, 0xaf, 0x97,
+	0x16, 0x56, 0x06, 0x81, 0x81, 0x60, 0x78, 0x46, 0xc6, 0x37, 0x4a, 0xe3, 0x81, 0xdd, 0xc0, 0xca,
+	0x20, 0x30, 0x86, 0x0f, 0x92, 0xf2, 0xe9, 0x07, 0x09, 0xba, 0x09, 0xa7, 0x21, 0xf4, 0xd1, 0x69,
+	0xc4, 0x8f, 0x69, 0xdf, 0x48, 0x83, 0xfb, 0x85, 0xb1, 0x36, 0x8e, 0xd3, 0x0f, 0x48, 0x04, 0x28,
+	0x3e, 0xac, 0x44, 0x00, 0xf3, 0xa7, 0x45, 0x52, 0xb4, 0x2e, 0x9f, 0xc0, 0xf9, 0x8c, 0xc9, 0x20,
+	0x3d, 0x67, 0x9f, 0x0d, 0x9c, 0x27, 0x69, 0xf0, 0x52, 0x90, 0x54, 0xe4, 0x8b, 0x58, 0x1b, 0xad,
+	0xf0, 0xbe, 0x63, 0x49, 0xc0, 0x4b, 0x41, 0x52, 0xe9, 0x01, 0x99, 0x76, 0xd2, 0x0b, 0x6d, 0x8c,
+	0xf2, 0x04, 0xa6, 0x42, 0xfe, 0x6e, 0x1c, 0x91, 0x96, 0x90, 0x29, 0x80, 0xac, 0x20, 0xfa, 0x3e,
+	0xa9, 0x31, 0x79, 0x1b, 0x8c, 0x51, 0x99, 0xc0, 0x83, 0x9e, 0xb9, 0x55, 0x46, 0x5e, 0x91, 0x22,
+	0x9f, 0x40, 0xe3, 0x9b, 0xdf, 0x20, 0x55, 0xeb, 0x32, 0xf7, 0xbf, 0x5a, 0xa4, 0x18, 0x5f, 0x96,
+	0x2f, 0xf9, 0x6b, 0xe3, 0xad, 0xdf, 0x97, 0xd3, 0xd9, 0x6b, 0x5d, 0x86, 0x62, 0x7c, 0xd9, 0xfc,
+	0x9f, 0x02, 0xa9, 0x59, 0x97, 0xa5, 0x53, 0x45, 0x48, 0x98, 0x7a, 0xa8, 0x12, 0xe8, 0x7b, 0x84,
+	0x74, 0x43, 0xdf, 0xdf, 0x66, 0x91, 0x17, 0xba, 0x63, 0xa6, 0x9f, 0xf0, 0x7c, 0xff, 0x6d, 0x8d,
+	0x02, 0x19, 0xc4, 0x31, 0xb7, 0xf4, 0xe6, 0x7f, 0x16, 0x08, 0x77, 0x75, 0xd3, 0xaf, 0x90, 0x7a,
+	0x87, 0x39, 0x7b, 0x76, 0xe0, 0xc5, 0x1d, 0xa3, 0x90, 0x73, 0xf3, 0xd4, 0x37, 0x15, 0x01, 0x0d,
+	0x02, 0xe4, 0xd6, 0x05, 0x90, 0x56, 0xa2, 0xeb, 0xa4, 0x8c, 0x19, 0x64, 0xa7, 0x53, 0xbb, 0xfc,
+	0x95, 0x30, 0x11, 0x4d, 0x90, 0x80, 0x43, 0xd0, 0x1b, 0xa4, 0xa6, 0x54, 0xaf, 0x51, 0x9a, 0x54,
+	0x8b, 0x6b, 0x28, 0xf3, 0xe7, 0x45, 0x52, 0xd7, 0x47, 0x79, 0x68, 0x0f, 0x4f, 0xc0, 0xdb, 0x09,
+	0x3f, 0x38, 0x36, 0x91, 0x77, 0xc1, 0xba, 0xbe, 0x61, 0x29, 0xa0, 0x4c, 0x9e, 0x47, 0xa6, 0x14,
+	0x52, 0x49, 0xe8, 0x84, 0x9c, 0x0f, 0x03, 0x60, 0x4e, 0x18, 0xb9, 0xd7, 0xc2, 0x64, 0x2d, 0xec,
+	0x05, 0xee, 0x44, 0xbb, 0x88, 0xbc, 0x78, 0xcc, 0x88, 0xdc, 0xea, 0x83, 0x87, 0x01, 0x81, 0x74,
+	0x8f, 0x4c, 0x85, 0x01, 0x5f, 0x5e, 0x8c, 0xd2, 0xc3, 0x92, 0xcd, 0x55, 0xed, 0x96, 0x40, 0x05,
+	0x05, 0x6f, 0x5e, 0x25, 0xb9, 0xae, 0x40, 0x4f, 0x74, 0x7c, 0x7b, 0x20, 0xaf, 0xc5, 0xba, 0xbe,
+	0x01, 0x58, 0xae, 0x8f, 0x15, 0x16, 0x87, 0x1d, 0x2b, 0x34, 0x7f, 0x5a, 0x22, 0x65, 0x6b, 0x67,
+	0xf9, 0xda, 0xe9, 0x92, 0x0f, 0xca, 0x0f, 0x48, 0x3e, 0xb8, 0x42, 0xce, 0xe2, 0xcf, 0xcd, 0x30,
+	0xf0, 0x92, 0x10, 0x43, 0x05, 0x58, 0xa9, 0xc6, 0x2b, 0xe9, 0xd5, 0x0b, 0x2b, 0x65, 0x18, 0x60,
+	0x03, 0x06, 0xeb, 0xa0, 0x11, 0x20, 0x33, 0xa7, 0xb5, 0xa7, 0x50, 0x1b, 0x01, 0x32, 0xb7, 0x7a,
+	0xbd, 0x09, 0x29, 0xcf, 0x69, 0xd2, 0x1e, 0x36, 0xc8, 0xac, 0xfc, 0x29, 0x8d, 0x8c, 0x6a, 0x2e,
+	0x55, 0x65, 0xd6, 0xca, 0x12, 0xef, 0xf5, 0x17, 0x40, 0xbe, 0xb2, 0x4e, 0xa2, 0x98, 0x7a, 0x04,
+	0x49, 0x14, 0x63, 0xc6, 0x28, 0xcc, 0xbf, 0x2c, 0x90, 0x0a, 0xbf, 0xa7, 0x02, 0x83, 0x45, 0x2e,
+	0x8b, 0xbd, 0x28, 0x63, 0x69, 0x17, 0xf2, 0xc1, 0xa2, 0x66, 0x9e, 0x0c, 0xfd, 0xfc, 0xdc, 0x8b,
+	0xc5, 0xd8, 0x7e, 0xba, 0x03, 0xcb, 0x46, 0x34, 0x14, 0x01, 0x52, 0x1e, 0xcc, 0xad, 0x8b, 0x1d,
+	0x1b, 0x0d, 0x0f, 0x51, 0xa7, 0x2f, 0xd5, 0xdd, 0xca, 0xd0, 0x20, 0xc7, 0x69, 0xfe, 0x47, 0x81,
+	0xf4, 0x39, 0x5c, 0x1f, 0x94, 0xbc, 0x75, 0x83, 0x90, 0x9e, 0xd6, 0x79, 0x93, 0x29, 0xcc, 0x0c,
+	0xd0, 0x10, 0x13, 0xb8, 0xf4, 0x90, 0x4d, 0x60, 0xf3, 0x07, 0x45, 0x42, 0x07, 0xe3, 0x1e, 0xc3,
+	0x22, 0x2b, 0x85, 0x87, 0xe7, 0xd2, 0xd6, 0xe7, 0xf9, 0x1e, 0xe0, 0xd6, 0xce, 0xcc, 0xa6, 0xe2,
+	0x03, 0x66, 0xd3, 0x57, 0x08, 0x11, 0x95, 0x79, 0x24, 0x52, 0x7c, 0xeb, 0x8b, 0xda, 0x7b, 0xaa,
+	0x29, 0xf7, 0x72, 0x4f, 0x90, 0xa9, 0xc3, 0xbd, 0xbc, 0xfc, 0xa9, 0x3f, 0xa5, 0x57, 0x36, 0x52,
+	0x52, 0xcd, 0xf7, 0xc8, 0xac, 0xbc, 0x54, 0x4f, 0xe4, 0x70, 0xd0, 0x4d, 0x52, 0x6a, 0xdb, 0x5d,
+	0xa3, 0x30, 0x96, 0x09, 0xa0, 0xc7, 0xd2, 0x15, 0xbc, 0xd0, 0xa3, 0x6d, 0x77, 0x4d, 0x97, 0xa8,
+	0xfc, 0xfa, 0x47, 0x79, 0xc7, 0xde, 0xcf, 0xeb, 0xa4, 0xcc, 0xbf, 0xf4, 0x83, 0x15, 0x2f, 0xc6,
+	0xe1, 0x13, 0x3b, 0x98, 0x2c, 0x0e, 0xbf, 0xb3, 0x7c, 0x4d, 0xc6, 0xe1, 0x77, 0x96, 0xaf, 0x01,
+	0x07, 0x4c, 0x83, 0x5d, 0x93, 0x9c, 0x79, 0xd7, 0x11, 0x47, 0xb1, 0x8b, 0xc9, 0x05, 0xbb, 0x2c,
+	0x52, 0xf2, 0x43, 0x95, 0x0d, 0x32, 0x5e, 0x5a, 0xc2, 0x46, 0xd8, 0x16, 0x69, 0x09, 0x1b, 0x61,
+	0x1b, 0x10, 0x0d, 0x35, 0x2d, 0x4f, 0xf3, 0xab, 0x4c, 0xa0, 0x69, 0x55, 0x52, 0xe8, 0x40, 0xaa,
+	0x9f, 0x30, 0x55, 0x85, 0x35, 0xf9, 0xc5, 0x31, 0x4d, 0x55, 0x0e, 0x5c, 0xcd, 0x98, 0xaa, 0x16,
+	0xdf, 0xe6, 0x4e, 0x4d, 0x00, 0xda, 0x6c, 0xa4, 0xa0, 0x72, 0x7f, 0xec, 0x90, 0xaa, 0xb8, 0xbd,
+	0x40, 0xc6, 0xc6, 0xc7, 0x4b, 0x57, 0x95, 0x97, 0xfd, 0x20, 0x38, 0xdf, 0x82, 0x89, 0x67, 0x90,
+	0xd0, 0xf9, 0x34, 0x39, 0x91, 0xf0, 0xdf, 0x98, 0x2c, 0x4d, 0x8e, 0x8b, 0x9a, 0x1d, 0x95, 0x26,
+	0x27, 0x16, 0x2a, 0x75, 0xc8, 0xf6, 0x7a, 0x8f, 0xf5, 0x98, 0x3c, 0xba, 0x90, 0x59, 0xa8, 0x72,
+	0x64, 0xe8, 0xe7, 0xc7, 0x09, 0x75, 0x67, 0x8f, 0xa9, 0xa8, 0xbb, 0x9e, 0x50, 0x6f, 0xef, 0xb1,
+	0x00, 0x38, 0x05, 0xd5, 0x9a, 0xcb, 0x5a, 0x76, 0xcf, 0x4f, 0xf8, 0xe1, 0x95, 0x5a, 0xaa, 0xd6,
+	0x9a, 0xa2, 0x18, 0x14, 0x9d, 0xfa, 0xe4, 0x7c, 0x1f, 0xbe, 0x3c, 0x54, 0x25, 0x8e, 0xb1, 0xfc,
+	0xaa, 0x3a, 0x50, 0xd1, 0x1c, 0xc6, 0x74, 0x6f, 0x14, 0x01, 0x86, 0x83, 0xd2, 0x6f, 0xe0, 0x89,
+	0xc4, 0x34, 0x8a, 0xfe, 0xa5, 0x31, 0xef, 0x74, 0xe1, 0x97, 0x32, 0xa9, 0xe3, 0x88, 0xa8, 0xd7,
+	0x05, 0x2a, 0x86, 0x49, 0x9d, 0xdc, 0xc5, 0x27, 0xc6, 0x99, 0x09, 0xd6, 0x94, 0xfc, 0x1d, 0x2a,
+	0x62, 0xb1, 0xcb, 0x97, 0x41, 0x9f, 0x38, 0xf3, 0x6f, 0x0b, 0x64, 0xd6, 0xf2, 0x3d, 0xd7, 0x0b,
+	0xda, 0x52, 0x77, 0xbf, 0x9b, 0xb9, 0x39, 0x6a, 0x3c, 0x05, 0x9e, 0x1e, 0xe4, 0x1f, 0xbc, 0x3d,
+	0xca, 0x22, 0x95, 0xd8, 0xf7, 0xdc, 0x71, 0x9d, 0x12, 0xa9, 0x3b, 0x1a, 0x41, 0x40, 0x60, 0x99,
+	0x7f, 0x50, 0x27, 0x32, 0xf0, 0x78, 0x32, 0xdd, 0xed, 0x44, 0xe1, 0x64, 0xba, 0x1b, 0x6f, 0xd8,
+	0x10, 0x8a, 0x0a, 0x7f, 0x01, 0x07, 0xd4, 0x8b, 0x42, 0xe9, 0x61, 0x2f, 0x0a, 0xb6, 0x5a, 0x14,
+	0x26, 0xce, 0xe1, 0xcb, 0xde, 0xbe, 0x99, 0x5b, 0x16, 0xbe, 0x91, 0xd3, 0xe0, 0xe3, 0xe7, 0xd9,
+	0x4b, 0x01, 0xfd, 0x3a, 0xfc, 0x06, 0xd7, 0xe1, 0xb5, 0x09, 0x96, 0x07, 0xe5, 0xb9, 0xc8, 0x69,
+	0xf1, 0x1b, 0x5c, 0x8b, 0x57, 0x27, 0x80, 0x6d, 0x36, 0xb2, 0xb0, 0x52, 0x8f, 0x33, 0xad, 0xc7,
+	0xeb, 0x13, 0xec, 0x1b, 0x07, 0xaf, 0xb8, 0xec, 0xd3, 0xe4, 0xb7, 0xb3, 0x9a, 0x5c, 0x1c, 0x46,
+	0x6c, 0x4e, 0xa8, 0xc9, 0x33, 0x47, 0x3e, 0x86, 0xea, 0x72, 0x5b, 0x69, 0xb3, 0xa9, 0x87, 0xa0,
+	0xcd, 0xd2, 0x54, 0xe0, 0xac, 0x46, 0xbb, 0x85, 0x1e, 0x3d, 0x74, 0xf9, 0x1a, 0xd3, 0x13, 0xac,
+	0xae, 0xc2, 0x6b, 0x2c, 0xba, 0x4d, 0xfc, 0x06, 0x09, 0x4b, 0xf7, 0x49, 0x3d, 0x52, 0x9e, 0x7b,
+	0x63, 0x66, 0x02, 0x33, 0x49, 0xfb, 0xff, 0x45, 0x87, 0xe9, 0x47, 0x48, 0xf1, 0xf1, 0x6a, 0xa7,
+	0x8e, 0x7d, 0x37, 0xe3, 0x5a, 0x32, 0x66, 0xf3, 0x57, 0x3b, 0x6d, 0xe6, 0xa8, 0xd0, 0xc7, 0x6d,
+	0xfe, 0x45, 0x91, 0x94, 0x79, 0xb6, 0xc5, 0xa3, 0x8f, 0xd6, 0xde, 0xca, 0x45, 0x6b, 0x27, 0x0c,
+	0xfb, 0x0d, 0x8b, 0xd4, 0xb6, 0xfb, 0x22, 0xb5, 0x13, 0x9f, 0xd5, 0x1d, 0x15, 0xa5, 0xfd, 0x10,
+	0x3d, 0x95, 0x09, 0xeb, 0x3e, 0x86, 0x08, 0xed, 0x7b, 0xf9, 0x08, 0xed, 0xab, 0x63, 0xbf, 0xd2,
+	0x88, 0xe8, 0xec, 0xdf, 0x9d, 0x13, 0xaf, 0xc2, 0x23, 0xb3, 0x6a, 0x6d, 0xaa, 0x8e, 0x5c, 0x9b,
+	0x2c, 0xbc, 0x04, 0x31, 0x31, 0xce, 0x4c, 0x60, 0x9d, 0xaf, 0xd8, 0x89, 0xba, 0x0e, 0x31, 0xc1,
+	0xeb, 0x10, 0x13, 0x9c, 0x30, 0x8e, 0xba, 0xd1, 0x6b, 0xa2, 0x83, 0x15, 0xfa, 0x5e, 0x30, 0x7d,
+	0x37, 0xac, 0x78, 0x84, 0x14, 0x1f, 0xa7, 0xbf, 0xcb, 0xaf, 0xdc, 0x30, 0x3e, 0x35, 0xc1, 0xf4,
+	0x17, 0xb7, 0x76, 0x88, 0xe9, 0x2f, 0x7e, 0x83, 0x84, 0x45, 0x01, 0x8c, 0xdf, 0xdf, 0x60, 0x2c,
+	0x4c, 0x20, 0x40, 0x5c, 0x01, 0x21, 0x04, 0x88, 0xdf, 0x20, 0x61, 0x51, 0x40, 0x8b, 0x5f, 0xcc,
+	0x60, 0xd4, 0x26, 0x10, 0x20, 0xee, 0x76, 0x10, 0x02, 0xc4, 0x6f, 0x90, 0xb0, 0x78, 0xf8, 0xa0,
+	0x25, 0x6e, 0x4f, 0x30, 0x9e, 0x9a, 0x40, 0x0d, 0xcb, 0x1b, 0x18, 0xd4, 0x7d, 0xc7, 0xfc, 0x01,
+	0x14, 0x32, 0x8e, 0xa4, 0xb6, 0xd6, 0x8f, 0xe3, 0x8d, 0xa4, 0x2b, 0x9e, 0x1c, 0x49, 0x78, 0xff,
+	0x38, 0xa2, 0xd1, 0x77, 0x48, 0x85, 0xe7, 0x00, 0x1a, 0xd3, 0x13, 0xa4, 0x62, 0xf2, 0x74, 0x42,
+	0x61, 0x82, 0xf0, 0x9f, 0x20, 0x30, 0xd1, 0x7c, 0x7a, 0x3f, 0xf4, 0x02, 0x63, 0x71, 0x02, 0xf3,
+	0x09, 0xcf, 0x5b, 0x08, 0xe3, 0x03, 0x7f, 0x01, 0x07, 0x44, 0x60, 0x27, 0x74, 0xd9, 0x44, 0x37,
+	0xd0, 0xe0, 0xf5, 0x7c, 0xd2, 0xe0, 0xc3, 0x43, 0x71, 0x1c, 0x10, 0xfb, 0xb8, 0x63, 0x77, 0x8d,
+	0xfa, 0x04, 0x7d, 0xbc, 0x69, 0x77, 0x45, 0x1f, 0xe3, 0x15, 0xcb, 0x88, 0x86, 0xc3, 0x4f, 0x1e,
+	0xa5, 0xb9, 0x30, 0xc1, 0xf0, 0x13, 0xb6, 0xfc, 0x88, 0x73, 0x35, 0xb5, 0x48, 0x79, 0x1c, 0x3f,
+	0xc9, 0x17, 0x33, 0xad, 0x20, 0xb5, 0xab, 0x51, 0x73, 0xa0, 0x43, 0x82, 0x5f, 0xa7, 0x6b, 0x18,
+	0x13, 0x7c, 0x72, 0xee, 0xf1, 0xcc, 0xd8, 0xee, 0xf8, 0x08, 0x02, 0x97, 0xb6, 0xc8, 0x94, 0x72,
+	0xe7, 0x88, 0xcc, 0x89, 0x31, 0xf7, 0xf8, 0xf2, 0x92, 0x6e, 0xed, 0x0d, 0x13, 0x98, 0xa0, 0xc0,
+	0x51, 0xd3, 0xc7, 0x5e, 0xb0, 0xaf, 0x2e, 0xe7, 0x1c, 0x53, 0xd3, 0xf3, 0xad, 0xb2, 0x7e, 0x0f,
+	0xc4, 0x03, 0x01, 0x4b, 0xdf, 0x25, 0x67, 0xf1, 0x87, 0xbc, 0xfa, 0x48, 0x5e, 0xbd, 0xf1, 0x0c,
+	0xd7, 0xf4, 0x4b, 0x3a, 0xbf, 0xa1, 0x9f, 0xe1, 0xde, 0xb0, 0x42, 0x18, 0x04, 0xa2, 0xb7, 0xc8,
+	0x6c, 0xc4, 0x78, 0xba, 0xb1, 0x44, 0x16, 0x9e, 0xf7, 0x57, 0x95, 0x67, 0x1c, 0xb2, 0xc4, 0x7b,
+	0x47, 0x8b, 0x17, 0x87, 0xdc, 0xeb, 0x91, 0xe3, 0x81, 0x3c, 0x1e, 0xa6, 0x5a, 0x26, 0x2c, 0xea,
+	0x78, 0x81, 0x9d, 0x84, 0x91, 0xdc, 0xe0, 0x6b, 0x7b, 0x63, 0x47, 0x53, 0x20, 0xc3, 0x25, 0x7c,
+	0x91, 0x3c, 0x13, 0xc3, 0xb8, 0x98, 0xdf, 0xb4, 0xcb, 0x04, 0x0d, 0x50, 0x74, 0xba, 0x4a, 0xa6,
+	0x84, 0xd5, 0x1b, 0x1b, 0xb3, 0xa3, 0x0f, 0xfe, 0x0b, 0x03, 0x39, 0x85, 0x11, 0xcf, 0x31, 0xa8,
+	0xba, 0x78, 0x4a, 0x57, 0x1e, 0x6c, 0x5d, 0x76, 0x1c, 0xbc, 0x22, 0x92, 0x27, 0x86, 0xce, 0xe5,
+	0xee, 0xca, 0xa4, 0xd6, 0x00, 0x07, 0x0c, 0xa9, 0x45, 0xdb, 0x19, 0xc3, 0x62, 0x7e, 0x02, 0x9b,
+	0x49, 0x25, 0x24, 0x8a, 0xb8, 0xae, 0x7a, 0xca, 0xd8, 0x18, 0x78, 0x91, 0x70, 0x10, 0xba, 0x4c,
+	0x39, 0xa1, 0x8d, 0xb3, 0xbc, 0x07, 0xb6, 0x26, 0xb2, 0xd0, 0x96, 0xae, 0x65, 0x10, 0x45, 0x1a,
+	0x8d, 0xf6, 0xe3, 0x67, 0x49, 0x90, 0x13, 0x4d, 0xd7, 0x48, 0xcd, 0x6e, 0xb5, 0xf0, 0xae, 0xb7,
+	0x43, 0x79, 0xd3, 0xfc, 0xd3, 0x43, 0x2f, 0x3f, 0x97, 0x3c, 0xe2, 0x9d, 0xd4, 0x13, 0xe8, 0xba,
+	0xf4, 0x06, 0x99, 0x4e, 0x42, 0x9f, 0x45, 0x32, 0xa5, 0xf4, 0x49, 0xfe, 0x46, 0x17, 0x86, 0x41,
+	0xed, 0x68, 0xb6, 0x34, 0x3c, 0x92, 0x96, 0xc5, 0x90, 0xc5, 0xc9, 0xde, 0xce, 0xf2, 0xf4, 0x63,
+	0xbf, 0x9d, 0xe5, 0xdc, 0xa3, 0xbb, 0x9d, 0x65, 0xe1, 0x0d, 0x72, 0x76, 0xe0, 0x83, 0x9d, 0x2a,
+	0x9d, 0xf4, 0x9f, 0x8a, 0x24, 0x73, 0xa5, 0x0d, 0xfd, 0x42, 0x3e, 0xa7, 0x6d, 0xa1, 0x3f, 0xa7,
+	0xad, 0x8e, 0xbc, 0xb9, 0x7c, 0x36, 0x9e, 0x69, 0x61, 0xc7, 0x32, 0xdf, 0x39, 0x97, 0x69, 0x81,
+	0xa5, 0x20, 0xa9, 0xa7, 0xc9, 0x7b, 0xcb, 0xae, 0x24, 0xa5, 0x07, 0xae, 0x24, 0x78, 0xf3, 0x9e,
+	0x9a, 0x01, 0x95, 0xbe, 0x9b, 0xf7, 0xd4, 0x60, 0xd5, 0x1c, 0x78, 0xe8, 0xc1, 0xb7, 0xe3, 0x84,
+	0x2f, 0x15, 0xee, 0x72, 0x32, 0x46, 0xbe, 0x9b, 0x9e, 0x0e, 0x1b, 0x19, 0x1c, 0xc8, 0xa1, 0x9a,
+	0x37, 0x89, 0x3a, 0xb6, 0x79, 0xb2, 0x68, 0x6b, 0xdc, 0xdb, 0xe5, 0xff, 0xb4, 0x33, 0x18, 0x7a,
+	0xc1, 0x62, 0x50, 0x74, 0xf3, 0xfb, 0x45, 0x82, 0x87, 0xf6, 0xf0, 0xc2, 0x51, 0xc7, 0x5e, 0x61,
+	0x51, 0x22, 0x63, 0x55, 0xa7, 0xbf, 0x70, 0x74, 0x65, 0x39, 0xad, 0x0e, 0x39, 0x30, 0x8c, 0xb0,
+	0x39, 0x29, 0xf4, 0xe9, 0x23, 0x6c, 0x19, 0xe0, 0x0c, 0x10, 0x05, 0x9e, 0xe5, 0x35, 0x4e, 0x70,
+	0x6d, 0x56, 0x26, 0x82, 0x49, 0xd0, 0x14, 0xc6, 0x0c, 0xc8, 0xdc, 0x4e, 0xaf, 0xb3, 0xeb, 0x3f,
+	0x26, 0x2f, 0xa3, 0xf9, 0x37, 0x45, 0x42, 0x52, 0x3f, 0x3a, 0xfd, 0x43, 0xfc, 0x13, 0xa0, 0x21,
+	0xff, 0x9e, 0x24, 0x25, 0xaf, 0x4f, 0x74, 0xe0, 0x22, 0x0b, 0xd8, 0x78, 0x5a, 0x36, 0x6a, 0xe8,
+	0x9f, 0x35, 0xc1, 0xd0, 0x46, 0xe0, 0xc4, 0x68, 0x79, 0x3e, 0x1b, 0x76, 0x25, 0xe5, 0x9a, 0x2c,
+	0x07, 0xcd, 0x81, 0x2a, 0x32, 0x12, 0xc9, 0x63, 0x46, 0x69, 0x02, 0x77, 0x60, 0x26, 0x01, 0x4d,
+	0xec, 0x20, 0x64, 0x01, 0x28, 0x74, 0xf3, 0xbf, 0x8a, 0x64, 0x26, 0xd7, 0xce, 0x91, 0xbd, 0x58,
+	0xff, 0x45, 0xe8, 0xc5, 0x5f, 0xcc, 0xe4, 0x23, 0xa1, 0x23, 0x6d, 0x77, 0x2b, 0xf0, 0xd5, 0x8d,
+	0x4f, 0x19, 0x1d, 0x29, 0xca, 0x41, 0x73, 0x98, 0x1f, 0x54, 0x89, 0x34, 0xd7, 0x3f, 0xf6, 0x1b,
+	0x2b, 0xef, 0x73, 0x0c, 0x1d, 0x13, 0x0f, 0x18, 0x5e, 0x08, 0xb2, 0xe3, 0xe9, 0xfb, 0xf2, 0x74,
+	0x68, 0x75, 0x55, 0x11, 0x20, 0xe5, 0xa1, 0x1d, 0x52, 0x4b, 0xe4, 0xfc, 0x9f, 0x28, 0x77, 0x2f,
+	0xaf, 0x44, 0xe4, 0xf9, 0x2a, 0x59, 0x06, 0x5a, 0x04, 0x5e, 0x89, 0x1c, 0x8b, 0x98, 0x86, 0x51,
+	0x99, 0x20, 0x42, 0x96, 0x8b, 0x8b, 0xc8, 0x43, 0xfe, 0xa2, 0x08, 0x14, 0x3e, 0x17, 0x25, 0x8f,
+	0x50, 0x55, 0x27, 0x11, 0x95, 0x0d, 0x9f, 0x4b, 0x51, 0xa2, 0x08, 0x14, 0x3e, 0x5e, 0xdc, 0x6a,
+	0xfb, 0x7e, 0x78, 0x87, 0xb9, 0x1b, 0x76, 0xc2, 0x02, 0x4c, 0x18, 0x1e, 0xef, 0x26, 0xa6, 0x27,
+	0x31, 0x68, 0xb7, 0x9c, 0x87, 0x82, 0x7e, 0xec, 0xcc, 0x7d, 0x58, 0xb5, 0x31, 0xef, 0xc3, 0xaa,
+	0x3f, 0xaa, 0xab, 0x15, 0x1a, 0x4b, 0x1f, 0x7e, 0x74, 0xe1, 0x89, 0x1f, 0x7f, 0x74, 0xe1, 0x89,
+	0x9f, 0x7c, 0x74, 0xe1, 0x89, 0x6f, 0x1f, 0x5f, 0x28, 0x7c, 0x78, 0x7c, 0xa1, 0xf0, 0xe3, 0xe3,
+	0x0b, 0x85, 0x9f, 0x1c, 0x5f, 0x28, 0xfc, 0xec, 0xf8, 0x42, 0xe1, 0x77, 0xff, 0xf5, 0xc2, 0x13,
+	0x5f, 0xaf, 0x29, 0xb4, 0xff, 0x1b, 0x00, 0x96, 0x08, 0x38, 0x71, 0x82, 0x72, 0x00, 0x00,
 }
 
 func (m *AWSCredentials) Marshal() (dAtA []byte, err error) {
@@ -3114,7 +3831,7 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Cat) Marshal() (dAtA []byte, err error) {
+func (m *BatchMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3124,18 +3841,25 @@ func (m *Cat) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Cat) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Cat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Data != nil {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.AbstractStep.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Meta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3147,7 +3871,7 @@ func (m *Cat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Code) Marshal() (dAtA []byte, err error) {
+func (m *BatchOutput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3157,20 +3881,221 @@ func (m *Code) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Code) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchOutput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Image)
-	copy(dAtA[i:], m.Image)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
-	i--
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Data != nil {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Error)
+	copy(dAtA[i:], m.Error)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Error)))
+	i--
+	dAtA[i] = 0x12
+	if m.Data != nil {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Cat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AbstractStep.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.HalfOpenProbes))
+	i--
+	dAtA[i] = 0x18
+	if m.OpenDuration != nil {
+		{
+			size, err := m.OpenDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FailureThreshold))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Code) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Code) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
 	dAtA[i] = 0x2a
 	i -= len(m.Runtime)
 	copy(dAtA[i:], m.Runtime)
@@ -3547,6 +4472,84 @@ func (m *Database) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.Meta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.LastFailureTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.FirstFailureTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Attempts))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.SourceName)
+	copy(dAtA[i:], m.SourceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.StepName)
+	copy(dAtA[i:], m.StepName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StepName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Error)
+	copy(dAtA[i:], m.Error)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Error)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Dedupe) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3567,6 +4570,42 @@ func (m *Dedupe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Redis != nil {
+		{
+			size, err := m.Redis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Storage != nil {
+		{
+			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TTL != nil {
+		{
+			size, err := m.TTL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.MaxSize.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3699,7 +4738,7 @@ func (m *Flatten) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetPodSpecReq) Marshal() (dAtA []byte, err error) {
+func (m *GRPC) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3709,12 +4748,35 @@ func (m *GetPodSpecReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetPodSpecReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *GRPC) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetPodSpecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GRPC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPodSpecReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPodSpecReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPodSpecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3927,6 +4989,33 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IdleTimeout != nil {
+		{
+			size, err := m.IdleTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxAge != nil {
+		{
+			size, err := m.MaxAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxSize))
+	i--
+	dAtA[i] = 0x28
 	if m.Storage != nil {
 		{
 			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
@@ -3977,6 +5066,21 @@ func (m *HTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchWait != nil {
+		{
+			size, err := m.MaxBatchWait.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxBatchSize))
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
@@ -4078,6 +5182,11 @@ func (m *HTTPSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CloudEvents)
+	copy(dAtA[i:], m.CloudEvents)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloudEvents)))
+	i--
+	dAtA[i] = 0x22
 	i--
 	if m.InsecureSkipVerify {
 		dAtA[i] = 1
@@ -4128,6 +5237,11 @@ func (m *HTTPSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CloudEvents)
+	copy(dAtA[i:], m.CloudEvents)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloudEvents)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.ServiceName)
 	copy(dAtA[i:], m.ServiceName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceName)))
@@ -4156,6 +5270,18 @@ func (m *Interface) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.HTTP != nil {
 		{
 			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
@@ -4295,6 +5421,126 @@ func (m *JetStreamSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Join) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Join) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Storage != nil {
+		{
+			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x2a
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Right.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Left.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AbstractStep.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinSide) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinSide) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinSide) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Source)
+	copy(dAtA[i:], m.Source)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Kafka) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4452,6 +5698,60 @@ func (m *KafkaSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SchemaRegistry != nil {
+		{
+			size, err := m.SchemaRegistry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	i--
+	if m.Transactional {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x68
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	i -= len(m.Partition)
+	copy(dAtA[i:], m.Partition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Partition)))
+	i--
+	dAtA[i] = 0x5a
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x52
 	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxInflight))
 	i--
 	dAtA[i] = 0x48
@@ -4557,6 +5857,38 @@ func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.KeyOrdered {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Concurrency))
+	i--
+	dAtA[i] = 0x40
+	if m.SchemaRegistry != nil {
+		{
+			size, err := m.SchemaRegistry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	i -= len(m.GroupID)
 	copy(dAtA[i:], m.GroupID)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GroupID)))
@@ -4690,12 +6022,49 @@ func (m *Meta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Time))
-	i--
-	dAtA[i] = 0x18
-	i -= len(m.ID)
-	copy(dAtA[i:], m.ID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Partition))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.SourceName)
+	copy(dAtA[i:], m.SourceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceName)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Time))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
 	i--
 	dAtA[i] = 0x12
 	i -= len(m.Source)
@@ -4932,6 +6301,14 @@ func (m *PipelineSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Suspended {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
 	if m.DeletionDelay != nil {
 		{
 			size, err := m.DeletionDelay.MarshalToSizedBuffer(dAtA[:i])
@@ -4981,6 +6358,28 @@ func (m *PipelineStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SuspendedReplicas) > 0 {
+		keysForSuspendedReplicas := make([]string, 0, len(m.SuspendedReplicas))
+		for k := range m.SuspendedReplicas {
+			keysForSuspendedReplicas = append(keysForSuspendedReplicas, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForSuspendedReplicas)
+		for iNdEx := len(keysForSuspendedReplicas) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.SuspendedReplicas[string(keysForSuspendedReplicas[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForSuspendedReplicas[iNdEx])
+			copy(dAtA[i:], keysForSuspendedReplicas[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForSuspendedReplicas[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.LastUpdated.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -5018,6 +6417,185 @@ func (m *PipelineStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Burst))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MessagesPerSecond))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RedisStore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedisStore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedisStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.KeyPrefix)
+	copy(dAtA[i:], m.KeyPrefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyPrefix)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DB))
+	i--
+	dAtA[i] = 0x18
+	if m.PasswordSecret != nil {
+		{
+			size, err := m.PasswordSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MessagesPerSecond))
+	i--
+	dAtA[i] = 0x20
+	if m.Until != nil {
+		{
+			size, err := m.Until.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Since != nil {
+		{
+			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Errors)
+	copy(dAtA[i:], m.Errors)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Errors)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RollingFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollingFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollingFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAge != nil {
+		{
+			size, err := m.MaxAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxSize != nil {
+		{
+			size, err := m.MaxSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *S3) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5413,7 +6991,7 @@ func (m *Scale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Sidecar) Marshal() (dAtA []byte, err error) {
+func (m *SchemaRegistry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5423,18 +7001,85 @@ func (m *Sidecar) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Sidecar) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaRegistry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Sidecar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PasswordSecret != nil {
+		{
+			size, err := m.PasswordSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UserSecret != nil {
+		{
+			size, err := m.UserSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SchemaRegistrySink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaRegistrySink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaRegistrySink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Schema)
+	copy(dAtA[i:], m.Schema)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schema)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.SchemaType)
+	copy(dAtA[i:], m.SchemaType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SchemaType)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x12
 	{
-		size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.SchemaRegistry.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5446,7 +7091,7 @@ func (m *Sidecar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Sink) Marshal() (dAtA []byte, err error) {
+func (m *SessionWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5456,26 +7101,134 @@ func (m *Sink) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Sink) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionWindow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.DeadLetterQueue {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	{
+		size, err := m.Gap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x50
-	if m.JetStream != nil {
-		{
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Sidecar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sidecar) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sidecar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Sink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Retry != nil {
+		{
+			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	i -= len(m.DeadLetterQueueFormat)
+	copy(dAtA[i:], m.DeadLetterQueueFormat)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeadLetterQueueFormat)))
+	i--
+	dAtA[i] = 0x6a
+	i--
+	if m.Default {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x60
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x5a
+	i--
+	if m.DeadLetterQueue {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	if m.JetStream != nil {
+		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
@@ -5578,6 +7331,49 @@ func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlidingWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlidingWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlidingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Slide.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Source) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5598,6 +7394,33 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxConcurrency))
+	i--
+	dAtA[i] = 0x68
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Replay != nil {
+		{
+			size, err := m.Replay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.JetStream != nil {
 		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
@@ -5832,6 +7655,51 @@ func (m *StepSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Suspend {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x80
+	if m.Join != nil {
+		{
+			size, err := m.Join.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	i -= len(m.SinkFailurePolicy)
+	copy(dAtA[i:], m.SinkFailurePolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SinkFailurePolicy)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
 	{
 		size, err := m.Sidecar.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -6289,6 +8157,39 @@ func (m *TLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TumblingWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TumblingWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TumblingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *VolumeSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6309,6 +8210,23 @@ func (m *VolumeSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rolling != nil {
+		{
+			size, err := m.Rolling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.FileName)
+	copy(dAtA[i:], m.FileName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FileName)))
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.AbstractVolumeSource.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -6378,27 +8296,135 @@ func (m *VolumeSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Window) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *AWSCredentials) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Window) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Window) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.AccessKeyID.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SecretAccessKey.Size()
+	if m.Storage != nil {
+		{
+			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x42
+	if m.AllowedLateness != nil {
+		{
+			size, err := m.AllowedLateness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Session != nil {
+		{
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sliding != nil {
+		{
+			size, err := m.Sliding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Tumbling != nil {
+		{
+			size, err := m.Tumbling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.EventTime)
+	copy(dAtA[i:], m.EventTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventTime)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AbstractStep.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *AWSCredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AccessKeyID.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SecretAccessKey.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SessionToken.Size()
 	n += 1 + l + sovGenerated(uint64(l))
@@ -6572,6 +8598,71 @@ func (m *Backoff) Size() (n int) {
 	return n
 }
 
+func (m *BatchMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Meta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *BatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Error)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *Cat) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6583,6 +8674,21 @@ func (m *Cat) Size() (n int) {
 	return n
 }
 
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.FailureThreshold))
+	if m.OpenDuration != nil {
+		l = m.OpenDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.HalfOpenProbes))
+	return n
+}
+
 func (m *Code) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6732,6 +8838,32 @@ func (m *Database) Size() (n int) {
 	return n
 }
 
+func (m *DeadLetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StepName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SourceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Attempts))
+	l = m.FirstFailureTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastFailureTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Meta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Dedupe) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6744,6 +8876,18 @@ func (m *Dedupe) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.MaxSize.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.TTL != nil {
+		l = m.TTL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Storage != nil {
+		l = m.Storage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Redis != nil {
+		l = m.Redis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -6782,6 +8926,15 @@ func (m *Flatten) Size() (n int) {
 	return n
 }
 
+func (m *GRPC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *GetPodSpecReq) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6875,6 +9028,15 @@ func (m *Group) Size() (n int) {
 		l = m.Storage.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.MaxSize))
+	if m.MaxAge != nil {
+		l = m.MaxAge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.IdleTimeout != nil {
+		l = m.IdleTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -6884,6 +9046,11 @@ func (m *HTTP) Size() (n int) {
 	}
 	var l int
 	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxBatchSize))
+	if m.MaxBatchWait != nil {
+		l = m.MaxBatchWait.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -6930,6 +9097,8 @@ func (m *HTTPSink) Size() (n int) {
 		}
 	}
 	n += 2
+	l = len(m.CloudEvents)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -6941,6 +9110,8 @@ func (m *HTTPSource) Size() (n int) {
 	_ = l
 	l = len(m.ServiceName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CloudEvents)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -6955,6 +9126,10 @@ func (m *Interface) Size() (n int) {
 		l = m.HTTP.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -6999,6 +9174,46 @@ func (m *JetStreamSource) Size() (n int) {
 	return n
 }
 
+func (m *Join) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AbstractStep.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Left.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Right.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Storage != nil {
+		l = m.Storage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *JoinSide) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Kafka) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7080,6 +9295,23 @@ func (m *KafkaSink) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 1 + sovGenerated(uint64(m.MaxInflight))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Partition)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	n += 2
+	if m.SchemaRegistry != nil {
+		l = m.SchemaRegistry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -7103,6 +9335,18 @@ func (m *KafkaSource) Size() (n int) {
 	}
 	l = len(m.GroupID)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.SchemaRegistry != nil {
+		l = m.SchemaRegistry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Concurrency))
+	n += 2
 	return n
 }
 
@@ -7142,9 +9386,22 @@ func (m *Meta) Size() (n int) {
 	l = len(m.ID)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 1 + sovGenerated(uint64(m.Time))
-	return n
-}
-
+	l = len(m.SourceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Partition))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *Metadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7231,6 +9488,7 @@ func (m *PipelineSpec) Size() (n int) {
 		l = m.DeletionDelay.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
 	return n
 }
 
@@ -7252,6 +9510,80 @@ func (m *PipelineStatus) Size() (n int) {
 	}
 	l = m.LastUpdated.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.SuspendedReplicas) > 0 {
+		for k, v := range m.SuspendedReplicas {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MessagesPerSecond))
+	n += 1 + sovGenerated(uint64(m.Burst))
+	return n
+}
+
+func (m *RedisStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.DB))
+	l = len(m.KeyPrefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Replay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Errors)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Until != nil {
+		l = m.Until.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.MessagesPerSecond))
+	return n
+}
+
+func (m *RollingFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSize != nil {
+		l = m.MaxSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxAge != nil {
+		l = m.MaxAge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -7401,6 +9733,53 @@ func (m *Scale) Size() (n int) {
 	return n
 }
 
+func (m *SchemaRegistry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.UserSecret != nil {
+		l = m.UserSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SchemaRegistrySink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SchemaRegistry.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SchemaType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schema)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SessionWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gap.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Sidecar) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7453,6 +9832,32 @@ func (m *Sink) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.DeadLetterQueueFormat)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SlidingWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Duration.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Slide.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -7498,6 +9903,15 @@ func (m *Source) Size() (n int) {
 		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Replay != nil {
+		l = m.Replay.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.MaxConcurrency))
 	return n
 }
 
@@ -7637,6 +10051,17 @@ func (m *StepSpec) Size() (n int) {
 	}
 	l = m.Sidecar.Size()
 	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.SinkFailurePolicy)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Join != nil {
+		l = m.Join.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
 	return n
 }
 
@@ -7694,6 +10119,17 @@ func (m *TLS) Size() (n int) {
 	return n
 }
 
+func (m *TumblingWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Duration.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *VolumeSink) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7702,6 +10138,12 @@ func (m *VolumeSink) Size() (n int) {
 	_ = l
 	l = m.AbstractVolumeSource.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FileName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Rolling != nil {
+		l = m.Rolling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -7722,6 +10164,43 @@ func (m *VolumeSource) Size() (n int) {
 	return n
 }
 
+func (m *Window) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AbstractStep.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Tumbling != nil {
+		l = m.Tumbling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Sliding != nil {
+		l = m.Sliding.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AllowedLateness != nil {
+		l = m.AllowedLateness.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Storage != nil {
+		l = m.Storage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7824,6 +10303,72 @@ func (this *Backoff) String() string {
 	return s
 }
 
+func (this *BatchMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&BatchMessage{`,
+		`Meta:` + strings.Replace(strings.Replace(this.Meta.String(), "Meta", "Meta", 1), `&`, ``, 1) + `,`,
+		`Data:` + valueToStringGenerated(this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *BatchOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{
+		`&BatchOutput{`,
+		`Data:` + valueToStringGenerated(this.Data) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *BatchResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOutputs := "[]BatchOutput{"
+	for _, f := range this.Outputs {
+		repeatedStringForOutputs += strings.Replace(strings.Replace(f.String(), "BatchOutput", "BatchOutput", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOutputs += "}"
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{
+		`&BatchResult{`,
+		`Data:` + valueToStringGenerated(this.Data) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Outputs:` + repeatedStringForOutputs + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func (this *Cat) String() string {
 	if this == nil {
 		return "nil"
@@ -7836,6 +10381,20 @@ func (this *Cat) String() string {
 	return s
 }
 
+func (this *CircuitBreaker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&CircuitBreaker{`,
+		`FailureThreshold:` + fmt.Sprintf("%v", this.FailureThreshold) + `,`,
+		`OpenDuration:` + strings.Replace(fmt.Sprintf("%v", this.OpenDuration), "Duration", "v11.Duration", 1) + `,`,
+		`HalfOpenProbes:` + fmt.Sprintf("%v", this.HalfOpenProbes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func (this *Code) String() string {
 	if this == nil {
 		return "nil"
@@ -7964,6 +10523,25 @@ func (this *Database) String() string {
 	return s
 }
 
+func (this *DeadLetter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&DeadLetter{`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`StepName:` + fmt.Sprintf("%v", this.StepName) + `,`,
+		`SourceName:` + fmt.Sprintf("%v", this.SourceName) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`FirstFailureTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FirstFailureTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastFailureTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastFailureTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Meta:` + strings.Replace(strings.Replace(this.Meta.String(), "Meta", "Meta", 1), `&`, ``, 1) + `,`,
+		`Data:` + valueToStringGenerated(this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func (this *Dedupe) String() string {
 	if this == nil {
 		return "nil"
@@ -7973,6 +10551,9 @@ func (this *Dedupe) String() string {
 		`AbstractStep:` + strings.Replace(strings.Replace(this.AbstractStep.String(), "AbstractStep", "AbstractStep", 1), `&`, ``, 1) + `,`,
 		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
 		`MaxSize:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.MaxSize), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`TTL:` + strings.Replace(fmt.Sprintf("%v", this.TTL), "Duration", "v11.Duration", 1) + `,`,
+		`Storage:` + strings.Replace(this.Storage.String(), "Storage", "Storage", 1) + `,`,
+		`Redis:` + strings.Replace(this.Redis.String(), "RedisStore", "RedisStore", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8015,6 +10596,17 @@ func (this *Flatten) String() string {
 	return s
 }
 
+func (this *GRPC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&GRPC{`,
+		`}`,
+	}, "")
+	return s
+}
+
 func (this *GetPodSpecReq) String() string {
 	if this == nil {
 		return "nil"
@@ -8079,6 +10671,9 @@ func (this *Group) String() string {
 		`EndOfGroup:` + fmt.Sprintf("%v", this.EndOfGroup) + `,`,
 		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
 		`Storage:` + strings.Replace(this.Storage.String(), "Storage", "Storage", 1) + `,`,
+		`MaxSize:` + fmt.Sprintf("%v", this.MaxSize) + `,`,
+		`MaxAge:` + strings.Replace(fmt.Sprintf("%v", this.MaxAge), "Duration", "v11.Duration", 1) + `,`,
+		`IdleTimeout:` + strings.Replace(fmt.Sprintf("%v", this.IdleTimeout), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8090,6 +10685,8 @@ func (this *HTTP) String() string {
 	}
 	s := strings.Join([]string{
 		`&HTTP{`,
+		`MaxBatchSize:` + fmt.Sprintf("%v", this.MaxBatchSize) + `,`,
+		`MaxBatchWait:` + strings.Replace(fmt.Sprintf("%v", this.MaxBatchWait), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8135,6 +10732,7 @@ func (this *HTTPSink) String() string {
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`Headers:` + repeatedStringForHeaders + `,`,
 		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
+		`CloudEvents:` + fmt.Sprintf("%v", this.CloudEvents) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8147,6 +10745,7 @@ func (this *HTTPSource) String() string {
 	s := strings.Join([]string{
 		`&HTTPSource{`,
 		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
+		`CloudEvents:` + fmt.Sprintf("%v", this.CloudEvents) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8160,6 +10759,7 @@ func (this *Interface) String() string {
 		`&Interface{`,
 		`FIFO:` + fmt.Sprintf("%v", this.FIFO) + `,`,
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPC", "GRPC", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8204,6 +10804,37 @@ func (this *JetStreamSource) String() string {
 	return s
 }
 
+func (this *Join) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&Join{`,
+		`AbstractStep:` + strings.Replace(strings.Replace(this.AbstractStep.String(), "AbstractStep", "AbstractStep", 1), `&`, ``, 1) + `,`,
+		`Left:` + strings.Replace(strings.Replace(this.Left.String(), "JoinSide", "JoinSide", 1), `&`, ``, 1) + `,`,
+		`Right:` + strings.Replace(strings.Replace(this.Right.String(), "JoinSide", "JoinSide", 1), `&`, ``, 1) + `,`,
+		`Window:` + strings.Replace(fmt.Sprintf("%v", this.Window), "Duration", "v11.Duration", 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Storage:` + strings.Replace(this.Storage.String(), "Storage", "Storage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *JoinSide) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&JoinSide{`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func (this *Kafka) String() string {
 	if this == nil {
 		return "nil"
@@ -8249,6 +10880,16 @@ func (this *KafkaSink) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
 	s := strings.Join([]string{
 		`&KafkaSink{`,
 		`Kafka:` + strings.Replace(strings.Replace(this.Kafka.String(), "Kafka", "Kafka", 1), `&`, ``, 1) + `,`,
@@ -8260,6 +10901,11 @@ func (this *KafkaSink) String() string {
 		`EnableIdempotence:` + fmt.Sprintf("%v", this.EnableIdempotence) + `,`,
 		`MessageTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MessageTimeout), "Duration", "v11.Duration", 1) + `,`,
 		`MaxInflight:` + fmt.Sprintf("%v", this.MaxInflight) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Partition:` + fmt.Sprintf("%v", this.Partition) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Transactional:` + fmt.Sprintf("%v", this.Transactional) + `,`,
+		`SchemaRegistry:` + strings.Replace(this.SchemaRegistry.String(), "SchemaRegistrySink", "SchemaRegistrySink", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8276,6 +10922,10 @@ func (this *KafkaSource) String() string {
 		`FetchMin:` + strings.Replace(fmt.Sprintf("%v", this.FetchMin), "Quantity", "resource.Quantity", 1) + `,`,
 		`FetchWaitMax:` + strings.Replace(fmt.Sprintf("%v", this.FetchWaitMax), "Duration", "v11.Duration", 1) + `,`,
 		`GroupID:` + fmt.Sprintf("%v", this.GroupID) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`SchemaRegistry:` + strings.Replace(this.SchemaRegistry.String(), "SchemaRegistry", "SchemaRegistry", 1) + `,`,
+		`Concurrency:` + fmt.Sprintf("%v", this.Concurrency) + `,`,
+		`KeyOrdered:` + fmt.Sprintf("%v", this.KeyOrdered) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8310,11 +10960,25 @@ func (this *Meta) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
 	s := strings.Join([]string{
 		`&Meta{`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`SourceName:` + fmt.Sprintf("%v", this.SourceName) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`Partition:` + fmt.Sprintf("%v", this.Partition) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8410,6 +11074,7 @@ func (this *PipelineSpec) String() string {
 		`&PipelineSpec{`,
 		`Steps:` + repeatedStringForSteps + `,`,
 		`DeletionDelay:` + strings.Replace(fmt.Sprintf("%v", this.DeletionDelay), "Duration", "v11.Duration", 1) + `,`,
+		`Suspended:` + fmt.Sprintf("%v", this.Suspended) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8424,12 +11089,79 @@ func (this *PipelineStatus) String() string {
 		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForConditions += "}"
+	keysForSuspendedReplicas := make([]string, 0, len(this.SuspendedReplicas))
+	for k := range this.SuspendedReplicas {
+		keysForSuspendedReplicas = append(keysForSuspendedReplicas, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSuspendedReplicas)
+	mapStringForSuspendedReplicas := "map[string]uint32{"
+	for _, k := range keysForSuspendedReplicas {
+		mapStringForSuspendedReplicas += fmt.Sprintf("%v: %v,", k, this.SuspendedReplicas[k])
+	}
+	mapStringForSuspendedReplicas += "}"
 	s := strings.Join([]string{
 		`&PipelineStatus{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`LastUpdated:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdated), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`SuspendedReplicas:` + mapStringForSuspendedReplicas + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *RateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&RateLimit{`,
+		`MessagesPerSecond:` + fmt.Sprintf("%v", this.MessagesPerSecond) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *RedisStore) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&RedisStore{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`DB:` + fmt.Sprintf("%v", this.DB) + `,`,
+		`KeyPrefix:` + fmt.Sprintf("%v", this.KeyPrefix) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *Replay) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&Replay{`,
+		`Errors:` + fmt.Sprintf("%v", this.Errors) + `,`,
+		`Since:` + strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v11.Time", 1) + `,`,
+		`Until:` + strings.Replace(fmt.Sprintf("%v", this.Until), "Time", "v11.Time", 1) + `,`,
+		`MessagesPerSecond:` + fmt.Sprintf("%v", this.MessagesPerSecond) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *RollingFile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&RollingFile{`,
+		`MaxSize:` + strings.Replace(fmt.Sprintf("%v", this.MaxSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`MaxAge:` + strings.Replace(fmt.Sprintf("%v", this.MaxAge), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8551,6 +11283,47 @@ func (this *Scale) String() string {
 	return s
 }
 
+func (this *SchemaRegistry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&SchemaRegistry{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`UserSecret:` + strings.Replace(fmt.Sprintf("%v", this.UserSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *SchemaRegistrySink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&SchemaRegistrySink{`,
+		`SchemaRegistry:` + strings.Replace(strings.Replace(this.SchemaRegistry.String(), "SchemaRegistry", "SchemaRegistry", 1), `&`, ``, 1) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`SchemaType:` + fmt.Sprintf("%v", this.SchemaType) + `,`,
+		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *SessionWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&SessionWindow{`,
+		`Gap:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Gap), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func (this *Sidecar) String() string {
 	if this == nil {
 		return "nil"
@@ -8579,6 +11352,24 @@ func (this *Sink) String() string {
 		`Volume:` + strings.Replace(this.Volume.String(), "VolumeSink", "VolumeSink", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamSink", "JetStreamSink", 1) + `,`,
 		`DeadLetterQueue:` + fmt.Sprintf("%v", this.DeadLetterQueue) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`Default:` + fmt.Sprintf("%v", this.Default) + `,`,
+		`DeadLetterQueueFormat:` + fmt.Sprintf("%v", this.DeadLetterQueueFormat) + `,`,
+		`Retry:` + strings.Replace(this.Retry.String(), "Backoff", "Backoff", 1) + `,`,
+		`CircuitBreaker:` + strings.Replace(this.CircuitBreaker.String(), "CircuitBreaker", "CircuitBreaker", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *SlidingWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&SlidingWindow{`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`Slide:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Slide), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8600,6 +11391,9 @@ func (this *Source) String() string {
 		`S3:` + strings.Replace(this.S3.String(), "S3Source", "S3Source", 1) + `,`,
 		`Volume:` + strings.Replace(this.Volume.String(), "VolumeSource", "VolumeSource", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamSource", "JetStreamSource", 1) + `,`,
+		`Replay:` + strings.Replace(this.Replay.String(), "Replay", "Replay", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RateLimit", "RateLimit", 1) + `,`,
+		`MaxConcurrency:` + fmt.Sprintf("%v", this.MaxConcurrency) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8703,6 +11497,10 @@ func (this *StepSpec) String() string {
 		`Expand:` + strings.Replace(this.Expand.String(), "Expand", "Expand", 1) + `,`,
 		`Dedupe:` + strings.Replace(this.Dedupe.String(), "Dedupe", "Dedupe", 1) + `,`,
 		`Sidecar:` + strings.Replace(strings.Replace(this.Sidecar.String(), "Sidecar", "Sidecar", 1), `&`, ``, 1) + `,`,
+		`SinkFailurePolicy:` + fmt.Sprintf("%v", this.SinkFailurePolicy) + `,`,
+		`Window:` + strings.Replace(this.Window.String(), "Window", "Window", 1) + `,`,
+		`Join:` + strings.Replace(this.Join.String(), "Join", "Join", 1) + `,`,
+		`Suspend:` + fmt.Sprintf("%v", this.Suspend) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8752,6 +11550,18 @@ func (this *TLS) String() string {
 	return s
 }
 
+func (this *TumblingWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&TumblingWindow{`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func (this *VolumeSink) String() string {
 	if this == nil {
 		return "nil"
@@ -8759,6 +11569,8 @@ func (this *VolumeSink) String() string {
 	s := strings.Join([]string{
 		`&VolumeSink{`,
 		`AbstractVolumeSource:` + strings.Replace(strings.Replace(this.AbstractVolumeSource.String(), "AbstractVolumeSource", "AbstractVolumeSource", 1), `&`, ``, 1) + `,`,
+		`FileName:` + fmt.Sprintf("%v", this.FileName) + `,`,
+		`Rolling:` + strings.Replace(this.Rolling.String(), "RollingFile", "RollingFile", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8779,6 +11591,26 @@ func (this *VolumeSource) String() string {
 	return s
 }
 
+func (this *Window) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{
+		`&Window{`,
+		`AbstractStep:` + strings.Replace(strings.Replace(this.AbstractStep.String(), "AbstractStep", "AbstractStep", 1), `&`, ``, 1) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`EventTime:` + fmt.Sprintf("%v", this.EventTime) + `,`,
+		`Tumbling:` + strings.Replace(this.Tumbling.String(), "TumblingWindow", "TumblingWindow", 1) + `,`,
+		`Sliding:` + strings.Replace(this.Sliding.String(), "SlidingWindow", "SlidingWindow", 1) + `,`,
+		`Session:` + strings.Replace(this.Session.String(), "SessionWindow", "SessionWindow", 1) + `,`,
+		`AllowedLateness:` + strings.Replace(fmt.Sprintf("%v", this.AllowedLateness), "Duration", "v11.Duration", 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Storage:` + strings.Replace(this.Storage.String(), "Storage", "Storage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -10380,7 +13212,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Cat) Unmarshal(dAtA []byte) error {
+func (m *BatchMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10403,15 +13235,15 @@ func (m *Cat) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cat: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10438,98 +13270,15 @@ func (m *Cat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *Code) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Code: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Code: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10539,55 +13288,25 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = Runtime(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10611,7 +13330,7 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Container) Unmarshal(dAtA []byte) error {
+func (m *BatchOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10634,17 +13353,17 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10654,27 +13373,29 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10701,52 +13422,160 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.In == nil {
-				m.In = &Interface{}
-			}
-			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BatchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10756,27 +13585,29 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10804,11 +13635,11 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10835,14 +13666,14 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outputs = append(m.Outputs, BatchOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10869,124 +13700,103 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *Cron) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Cron: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cron: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Layout = string(dAtA[iNdEx:postIndex])
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11010,7 +13820,7 @@ func (m *Cron) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *DBDataSource) Unmarshal(dAtA []byte) error {
+func (m *Cat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11033,47 +13843,15 @@ func (m *DBDataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DBDataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DBDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11100,10 +13878,7 @@ func (m *DBDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &DBDataSourceFrom{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11129,7 +13904,7 @@ func (m *DBDataSource) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *DBDataSourceFrom) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11152,15 +13927,34 @@ func (m *DBDataSourceFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DBDataSourceFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DBDataSourceFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
+			}
+			m.FailureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11187,13 +13981,32 @@ func (m *DBDataSourceFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeyRef == nil {
-				m.SecretKeyRef = &v1.SecretKeySelector{}
+			if m.OpenDuration == nil {
+				m.OpenDuration = &v11.Duration{}
 			}
-			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfOpenProbes", wireType)
+			}
+			m.HalfOpenProbes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalfOpenProbes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11216,7 +14029,7 @@ func (m *DBDataSourceFrom) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *DBSink) Unmarshal(dAtA []byte) error {
+func (m *Code) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11239,17 +14052,17 @@ func (m *DBSink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DBSink: wiretype end group for non-group")
+			return fmt.Errorf("proto: Code: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DBSink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Code: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11259,30 +14072,29 @@ func (m *DBSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11292,30 +14104,60 @@ func (m *DBSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = append(m.Actions, SQLAction{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Runtime = Runtime(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -11334,7 +14176,7 @@ func (m *DBSink) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *DBSource) Unmarshal(dAtA []byte) error {
+func (m *Container) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11357,15 +14199,47 @@ func (m *DBSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DBSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Container: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DBSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11392,15 +14266,18 @@ func (m *DBSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.In == nil {
+				m.In = &Interface{}
+			}
+			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11410,27 +14287,29 @@ func (m *DBSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetColumn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11458,13 +14337,13 @@ func (m *DBSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OffsetColumn = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11474,28 +14353,27 @@ func (m *DBSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PollInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11522,15 +14400,16 @@ func (m *DBSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommitInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitSchema", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11540,12 +14419,25 @@ func (m *DBSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InitSchema = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11568,7 +14460,7 @@ func (m *DBSource) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Database) Unmarshal(dAtA []byte) error {
+func (m *Cron) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11591,15 +14483,15 @@ func (m *Database) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Database: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cron: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Database: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cron: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11627,13 +14519,13 @@ func (m *Database) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Driver = string(dAtA[iNdEx:postIndex])
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11643,27 +14535,23 @@ func (m *Database) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DataSource == nil {
-				m.DataSource = &DBDataSource{}
-			}
-			if err := m.DataSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Layout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11687,7 +14575,7 @@ func (m *Database) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Dedupe) Unmarshal(dAtA []byte) error {
+func (m *DBDataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11710,48 +14598,15 @@ func (m *Dedupe) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dedupe: wiretype end group for non-group")
+			return fmt.Errorf("proto: DBDataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dedupe: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DBDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11779,11 +14634,11 @@ func (m *Dedupe) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11810,7 +14665,10 @@ func (m *Dedupe) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ValueFrom == nil {
+				m.ValueFrom = &DBDataSourceFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11836,7 +14694,7 @@ func (m *Dedupe) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Expand) Unmarshal(dAtA []byte) error {
+func (m *DBDataSourceFrom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11859,15 +14717,15 @@ func (m *Expand) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Expand: wiretype end group for non-group")
+			return fmt.Errorf("proto: DBDataSourceFrom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Expand: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DBDataSourceFrom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11894,7 +14752,10 @@ func (m *Expand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11920,7 +14781,7 @@ func (m *Expand) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Filter) Unmarshal(dAtA []byte) error {
+func (m *DBSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11943,15 +14804,15 @@ func (m *Filter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
+			return fmt.Errorf("proto: DBSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DBSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11978,15 +14839,15 @@ func (m *Filter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11996,23 +14857,25 @@ func (m *Filter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Actions = append(m.Actions, SQLAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12036,7 +14899,7 @@ func (m *Filter) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Flatten) Unmarshal(dAtA []byte) error {
+func (m *DBSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12059,15 +14922,15 @@ func (m *Flatten) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Flatten: wiretype end group for non-group")
+			return fmt.Errorf("proto: DBSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Flatten: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DBSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12094,64 +14957,13 @@ func (m *Flatten) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetPodSpecReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12179,11 +14991,11 @@ func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cluster = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetColumn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12211,32 +15023,13 @@ func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
+			m.OffsetColumn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
-			}
-			m.Replica = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Replica |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollInterval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12246,29 +15039,30 @@ func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageFormat = string(dAtA[iNdEx:postIndex])
+			if err := m.PollInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunnerImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInterval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12278,29 +15072,30 @@ func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunnerImage = string(dAtA[iNdEx:postIndex])
+			if err := m.CommitInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitSchema", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12310,48 +15105,68 @@ func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.InitSchema = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Database) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.UpdateInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdateInterval |= time.Duration(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Database: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Database: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12361,28 +15176,27 @@ func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StepStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Driver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sidecar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12409,113 +15223,18 @@ func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sidecar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DataSource == nil {
+				m.DataSource = &DBDataSource{}
+			}
+			if err := m.DataSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
-			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hostname = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subdomain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subdomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
@@ -12533,7 +15252,7 @@ func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Git) Unmarshal(dAtA []byte) error {
+func (m *DeadLetter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12556,15 +15275,15 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Git: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeadLetter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Git: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12592,11 +15311,11 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StepName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12624,11 +15343,11 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.StepName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12656,13 +15375,13 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.SourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var stringLen uint64
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12672,27 +15391,14 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Attempts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstFailureTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12719,46 +15425,13 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FirstFailureTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12785,16 +15458,13 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastFailureTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12821,18 +15491,15 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12842,48 +15509,26 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12906,7 +15551,7 @@ func (m *Git) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Group) Unmarshal(dAtA []byte) error {
+func (m *Dedupe) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12929,17 +15574,17 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dedupe: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dedupe: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12949,27 +15594,28 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndOfGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12997,13 +15643,13 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndOfGroup = string(dAtA[iNdEx:postIndex])
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13013,27 +15659,28 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = GroupFormat(dAtA[iNdEx:postIndex])
+			if err := m.MaxSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13060,120 +15707,18 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Storage == nil {
-				m.Storage = &Storage{}
+			if m.TTL == nil {
+				m.TTL = &v11.Duration{}
 			}
-			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *HTTP) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13183,59 +15728,31 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Storage == nil {
+				m.Storage = &Storage{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13262,10 +15779,10 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &HTTPHeaderSource{}
+			if m.Redis == nil {
+				m.Redis = &RedisStore{}
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13291,7 +15808,7 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
+func (m *Expand) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13314,15 +15831,15 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Expand: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Expand: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13349,7 +15866,7 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13375,7 +15892,7 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *HTTPSink) Unmarshal(dAtA []byte) error {
+func (m *Filter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13398,17 +15915,17 @@ func (m *HTTPSink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPSink: wiretype end group for non-group")
+			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPSink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13418,29 +15935,30 @@ func (m *HTTPSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13450,46 +15968,24 @@ func (m *HTTPSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, HTTPHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureSkipVerify = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13512,7 +16008,7 @@ func (m *HTTPSink) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+func (m *Flatten) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13535,17 +16031,17 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Flatten: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Flatten: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13555,23 +16051,24 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13595,7 +16092,7 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Interface) Unmarshal(dAtA []byte) error {
+func (m *GRPC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13618,73 +16115,17 @@ func (m *Interface) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Interface: wiretype end group for non-group")
+			return fmt.Errorf("proto: GRPC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Interface: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GRPC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FIFO", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FIFO = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTP{}
-			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
@@ -13702,7 +16143,7 @@ func (m *Interface) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *JetStream) Unmarshal(dAtA []byte) error {
+func (m *GetPodSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13725,15 +16166,15 @@ func (m *JetStream) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStream: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPodSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStream: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13761,11 +16202,11 @@ func (m *JetStream) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATSURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13793,11 +16234,30 @@ func (m *JetStream) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NATSURL = string(dAtA[iNdEx:postIndex])
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+			}
+			m.Replica = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replica |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13825,13 +16285,13 @@ func (m *JetStream) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.ImageFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunnerImage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13841,84 +16301,29 @@ func (m *JetStream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NATSAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RunnerImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *JetStreamSink) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamSink: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamSink: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13928,79 +16333,46 @@ func (m *JetStreamSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateInterval |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StepStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14027,66 +16399,48 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StepStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecar", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *Kafka) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Sidecar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Kafka: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Kafka: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14096,27 +16450,29 @@ func (m *Kafka) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14144,13 +16500,13 @@ func (m *Kafka) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KafkaConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdomain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14160,24 +16516,23 @@ func (m *Kafka) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KafkaConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Subdomain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14201,7 +16556,7 @@ func (m *Kafka) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *KafkaConfig) Unmarshal(dAtA []byte) error {
+func (m *Git) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14224,15 +16579,15 @@ func (m *KafkaConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Git: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Git: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14260,13 +16615,13 @@ func (m *KafkaConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NET", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14276,33 +16631,29 @@ func (m *KafkaConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NET == nil {
-				m.NET = &KafkaNET{}
-			}
-			if err := m.NET.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessageBytes", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.MaxMessageBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14312,67 +16663,29 @@ func (m *KafkaConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMessageBytes |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *KafkaNET) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaNET: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaNET: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14382,31 +16695,27 @@ func (m *KafkaNET) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14433,120 +16742,46 @@ func (m *KafkaNET) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SASL == nil {
-				m.SASL = &SASL{}
-			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *KafkaSink) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Async", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Async = bool(v != 0)
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14573,16 +16808,16 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BatchSize == nil {
-				m.BatchSize = &resource.Quantity{}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.BatchSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Linger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14609,48 +16844,16 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Linger == nil {
-				m.Linger = &v11.Duration{}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Linger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressionType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CompressionType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14677,16 +16880,16 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Acks == nil {
-				m.Acks = &intstr.IntOrString{}
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Acks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableIdempotence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14703,62 +16906,7 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableIdempotence = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageTimeout", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MessageTimeout == nil {
-				m.MessageTimeout = &v11.Duration{}
-			}
-			if err := m.MessageTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflight", wireType)
-			}
-			m.MaxInflight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxInflight |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14781,7 +16929,7 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *KafkaSource) Unmarshal(dAtA []byte) error {
+func (m *Group) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14804,17 +16952,17 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14824,28 +16972,27 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndOfGroup", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14873,13 +17020,13 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartOffset = KafkaOffset(dAtA[iNdEx:postIndex])
+			m.EndOfGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FetchMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14889,31 +17036,27 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FetchMin == nil {
-				m.FetchMin = &resource.Quantity{}
-			}
-			if err := m.FetchMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Format = GroupFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FetchWaitMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14940,18 +17083,37 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FetchWaitMax == nil {
-				m.FetchWaitMax = &v11.Duration{}
+			if m.Storage == nil {
+				m.Storage = &Storage{}
 			}
-			if err := m.FetchWaitMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14961,23 +17123,63 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupID = string(dAtA[iNdEx:postIndex])
+			if m.MaxAge == nil {
+				m.MaxAge = &v11.Duration{}
+			}
+			if err := m.MaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IdleTimeout == nil {
+				m.IdleTimeout = &v11.Duration{}
+			}
+			if err := m.IdleTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15001,7 +17203,7 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Log) Unmarshal(dAtA []byte) error {
+func (m *HTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15024,17 +17226,17 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Truncate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
-			var v uint64
+			m.MaxBatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15044,12 +17246,47 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Truncate = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchWait", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxBatchWait == nil {
+				m.MaxBatchWait = &v11.Duration{}
+			}
+			if err := m.MaxBatchWait.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15072,7 +17309,7 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Map) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15095,17 +17332,17 @@ func (m *Map) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Map: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Map: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15115,28 +17352,27 @@ func (m *Map) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15164,7 +17400,43 @@ func (m *Map) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15188,7 +17460,7 @@ func (m *Map) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Meta) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15211,17 +17483,17 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Meta: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Meta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15231,75 +17503,25 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
-			}
-			m.Time = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15322,7 +17544,7 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *HTTPSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15345,17 +17567,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15365,7 +17587,5181 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipVerify = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudEvents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CloudEvents = CloudEventsMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudEvents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CloudEvents = CloudEventsMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Interface) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Interface: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Interface: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FIFO", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FIFO = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTP{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GRPC == nil {
+				m.GRPC = &GRPC{}
+			}
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *JetStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NATSURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NATSURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &NATSAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *JetStreamSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Join) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Join: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Join: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = &v11.Duration{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = JoinType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Storage == nil {
+				m.Storage = &Storage{}
+			}
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *JoinSide) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinSide: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinSide: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Kafka) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Kafka: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Kafka: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KafkaConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KafkaConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *KafkaConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NET", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NET == nil {
+				m.NET = &KafkaNET{}
+			}
+			if err := m.NET.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessageBytes", wireType)
+			}
+			m.MaxMessageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessageBytes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *KafkaNET) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaNET: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaNET: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *KafkaSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Async", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Async = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchSize == nil {
+				m.BatchSize = &resource.Quantity{}
+			}
+			if err := m.BatchSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Linger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Linger == nil {
+				m.Linger = &v11.Duration{}
+			}
+			if err := m.Linger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acks == nil {
+				m.Acks = &intstr.IntOrString{}
+			}
+			if err := m.Acks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableIdempotence", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableIdempotence = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageTimeout == nil {
+				m.MessageTimeout = &v11.Duration{}
+			}
+			if err := m.MessageTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflight", wireType)
+			}
+			m.MaxInflight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInflight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transactional = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaRegistry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchemaRegistry == nil {
+				m.SchemaRegistry = &SchemaRegistrySink{}
+			}
+			if err := m.SchemaRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *KafkaSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartOffset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartOffset = KafkaOffset(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FetchMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FetchMin == nil {
+				m.FetchMin = &resource.Quantity{}
+			}
+			if err := m.FetchMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FetchWaitMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FetchWaitMax == nil {
+				m.FetchWaitMax = &v11.Duration{}
+			}
+			if err := m.FetchWaitMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaRegistry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchemaRegistry == nil {
+				m.SchemaRegistry = &SchemaRegistry{}
+			}
+			if err := m.SchemaRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyOrdered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyOrdered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Log) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncate", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncate = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Map) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Map: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Map: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Meta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Meta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Meta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *NATSAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NATSAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NATSAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PipelineList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Pipeline{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, StepSpec{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletionDelay == nil {
+				m.DeletionDelay = &v11.Duration{}
+			}
+			if err := m.DeletionDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = PipelinePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, v11.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedReplicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SuspendedReplicas == nil {
+				m.SuspendedReplicas = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SuspendedReplicas[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerSecond", wireType)
+			}
+			m.MessagesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesPerSecond |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
+			}
+			m.Burst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Burst |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RedisStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedisStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedisStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+			}
+			m.DB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DB |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Replay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Replay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Replay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = &v11.Time{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Until == nil {
+				m.Until = &v11.Time{}
+			}
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerSecond", wireType)
+			}
+			m.MessagesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesPerSecond |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RollingFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollingFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollingFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSize == nil {
+				m.MaxSize = &resource.Quantity{}
+			}
+			if err := m.MaxSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxAge == nil {
+				m.MaxAge = &v11.Duration{}
+			}
+			if err := m.MaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *S3) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &AWSCredentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Endpoint == nil {
+				m.Endpoint = &AWSEndpoint{}
+			}
+			if err := m.Endpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *S3Sink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3Sink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3Sink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *S3Source) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3Source: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3Source: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollPeriod == nil {
+				m.PollPeriod = &v11.Duration{}
+			}
+			if err := m.PollPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SASL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mechanism = SASLMechanism(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserSecret == nil {
+				m.UserSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15380,107 +22776,67 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SQLAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQLAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQLAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQLStatement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15507,103 +22863,81 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if err := m.SQLStatement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnRecordNotFound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OnRecordNotFound == nil {
+				m.OnRecordNotFound = &SQLStatement{}
+			}
+			if err := m.OnRecordNotFound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OnError == nil {
+				m.OnError = &SQLStatement{}
+			}
+			if err := m.OnError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15627,7 +22961,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *NATSAuth) Unmarshal(dAtA []byte) error {
+func (m *SQLStatement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15650,17 +22984,17 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NATSAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLStatement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLStatement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15670,27 +23004,55 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
+			m.SQL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15714,7 +23076,7 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
+func (m *STAN) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15737,17 +23099,17 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+			return fmt.Errorf("proto: STAN: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: STAN: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15757,30 +23119,29 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15790,30 +23151,29 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NATSURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15823,81 +23183,61 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NATSURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *PipelineList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15907,28 +23247,27 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubjectPrefix = SubjectPrefix(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15955,67 +23294,18 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Pipeline{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Auth == nil {
+				m.Auth = &NATSAuth{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NATSMonitoringURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16025,31 +23315,29 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, StepSpec{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NATSMonitoringURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletionDelay", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflight", wireType)
 			}
-			var msglen int
+			m.MaxInflight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16059,28 +23347,11 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxInflight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DeletionDelay == nil {
-				m.DeletionDelay = &v11.Duration{}
-			}
-			if err := m.DeletionDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16103,7 +23374,7 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
+func (m *Scale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16126,15 +23397,15 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Scale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Scale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredReplicas", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16162,11 +23433,11 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = PipelinePhase(dAtA[iNdEx:postIndex])
+			m.DesiredReplicas = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeekDelay", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16194,47 +23465,13 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.PeekDelay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conditions = append(m.Conditions, v11.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingDelay", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16244,24 +23481,23 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScalingDelay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16285,7 +23521,7 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *S3) Unmarshal(dAtA []byte) error {
+func (m *SchemaRegistry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16308,15 +23544,15 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaRegistry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16344,75 +23580,11 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Region = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16439,16 +23611,16 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credentials == nil {
-				m.Credentials = &AWSCredentials{}
-			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UserSecret == nil {
+				m.UserSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16475,10 +23647,10 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Endpoint == nil {
-				m.Endpoint = &AWSEndpoint{}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Endpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16504,7 +23676,7 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *S3Sink) Unmarshal(dAtA []byte) error {
+func (m *SchemaRegistrySink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16527,15 +23699,15 @@ func (m *S3Sink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Sink: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaRegistrySink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Sink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaRegistrySink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaRegistry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16562,66 +23734,15 @@ func (m *S3Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchemaRegistry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *S3Source) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: S3Source: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Source: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16631,33 +23752,29 @@ func (m *S3Source) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollPeriod == nil {
-				m.PollPeriod = &v11.Duration{}
-			}
-			if err := m.PollPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16667,30 +23784,29 @@ func (m *S3Source) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SchemaType = SchemaType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.Concurrency = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16700,11 +23816,24 @@ func (m *S3Source) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Concurrency |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16727,7 +23856,7 @@ func (m *S3Source) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *SASL) Unmarshal(dAtA []byte) error {
+func (m *SessionWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16750,17 +23879,17 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16770,63 +23899,79 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mechanism = SASLMechanism(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Gap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserSecret == nil {
-				m.UserSecret = &v1.SecretKeySelector{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Sidecar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sidecar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sidecar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16853,10 +23998,7 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16882,7 +24024,7 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *SQLAction) Unmarshal(dAtA []byte) error {
+func (m *Sink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16905,15 +24047,47 @@ func (m *SQLAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SQLAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SQLAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SQLStatement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field STAN", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16940,13 +24114,16 @@ func (m *SQLAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SQLStatement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.STAN == nil {
+				m.STAN = &STAN{}
+			}
+			if err := m.STAN.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnRecordNotFound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16973,16 +24150,16 @@ func (m *SQLAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OnRecordNotFound == nil {
-				m.OnRecordNotFound = &SQLStatement{}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaSink{}
 			}
-			if err := m.OnRecordNotFound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17009,69 +24186,18 @@ func (m *SQLAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OnError == nil {
-				m.OnError = &SQLStatement{}
+			if m.Log == nil {
+				m.Log = &Log{}
 			}
-			if err := m.OnError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *SQLStatement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SQLStatement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SQLStatement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17081,29 +24207,33 @@ func (m *SQLStatement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SQL = string(dAtA[iNdEx:postIndex])
+			if m.HTTP == nil {
+				m.HTTP = &HTTPSink{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17113,80 +24243,33 @@ func (m *SQLStatement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *STAN) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.S3 == nil {
+				m.S3 = &S3Sink{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: STAN: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: STAN: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17196,29 +24279,33 @@ func (m *STAN) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.DB == nil {
+				m.DB = &DBSink{}
+			}
+			if err := m.DB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17228,29 +24315,33 @@ func (m *STAN) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			if m.Volume == nil {
+				m.Volume = &VolumeSink{}
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATSURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17260,27 +24351,51 @@ func (m *STAN) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NATSURL = string(dAtA[iNdEx:postIndex])
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamSink{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterQueue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadLetterQueue = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17308,11 +24423,31 @@ func (m *STAN) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Default = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubjectPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterQueueFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17340,11 +24475,11 @@ func (m *STAN) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubjectPrefix = SubjectPrefix(dAtA[iNdEx:postIndex])
+			m.DeadLetterQueueFormat = DeadLetterQueueFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17371,18 +24506,18 @@ func (m *STAN) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NATSAuth{}
+			if m.Retry == nil {
+				m.Retry = &Backoff{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATSMonitoringURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17392,43 +24527,28 @@ func (m *STAN) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NATSMonitoringURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflight", wireType)
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
 			}
-			m.MaxInflight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxInflight |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17451,7 +24571,7 @@ func (m *STAN) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Scale) Unmarshal(dAtA []byte) error {
+func (m *SlidingWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17474,17 +24594,17 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Scale: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlidingWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Scale: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlidingWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredReplicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17494,29 +24614,30 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredReplicas = string(dAtA[iNdEx:postIndex])
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeekDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slide", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17526,55 +24647,24 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeekDelay = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScalingDelay", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Slide.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ScalingDelay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17598,7 +24688,7 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Sidecar) Unmarshal(dAtA []byte) error {
+func (m *Source) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17621,15 +24711,47 @@ func (m *Sidecar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sidecar: wiretype end group for non-group")
+			return fmt.Errorf("proto: Source: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sidecar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Source: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17656,66 +24778,54 @@ func (m *Sidecar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Cron == nil {
+				m.Cron = &Cron{}
+			}
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field STAN", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *Sink) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.STAN == nil {
+				m.STAN = &STAN{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Sink: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sink: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.STAN.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17725,27 +24835,31 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Kafka == nil {
+				m.Kafka = &KafkaSource{}
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field STAN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17772,16 +24886,16 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.STAN == nil {
-				m.STAN = &STAN{}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPSource{}
 			}
-			if err := m.STAN.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17808,16 +24922,16 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kafka == nil {
-				m.Kafka = &KafkaSink{}
+			if m.DB == nil {
+				m.DB = &DBSource{}
 			}
-			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17844,16 +24958,13 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Log == nil {
-				m.Log = &Log{}
-			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17880,16 +24991,16 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPSink{}
+			if m.S3 == nil {
+				m.S3 = &S3Source{}
 			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17916,16 +25027,16 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.S3 == nil {
-				m.S3 = &S3Sink{}
+			if m.Volume == nil {
+				m.Volume = &VolumeSource{}
 			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17952,16 +25063,16 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DB == nil {
-				m.DB = &DBSink{}
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamSource{}
 			}
-			if err := m.DB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17988,16 +25099,16 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Volume == nil {
-				m.Volume = &VolumeSink{}
+			if m.Replay == nil {
+				m.Replay = &Replay{}
 			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18024,18 +25135,18 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamSink{}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
 			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrency", wireType)
 			}
-			var v int
+			m.MaxConcurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18045,12 +25156,11 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxConcurrency |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeadLetterQueue = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18073,7 +25183,7 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Source) Unmarshal(dAtA []byte) error {
+func (m *Step) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18096,17 +25206,17 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Source: wiretype end group for non-group")
+			return fmt.Errorf("proto: Step: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Source: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Step: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18116,27 +25226,28 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18163,16 +25274,130 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cron == nil {
-				m.Cron = &Cron{}
-			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field STAN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StepList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StepList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StepList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18196,19 +25421,68 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Step{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StepSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.STAN == nil {
-				m.STAN = &STAN{}
-			}
-			if err := m.STAN.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StepSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StepSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18235,16 +25509,16 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kafka == nil {
-				m.Kafka = &KafkaSource{}
+			if m.Container == nil {
+				m.Container = &Container{}
 			}
-			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18271,16 +25545,14 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPSource{}
-			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, Source{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sinks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18307,18 +25579,16 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DB == nil {
-				m.DB = &DBSource{}
-			}
-			if err := m.DB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sinks = append(m.Sinks, Sink{})
+			if err := m.Sinks[len(m.Sinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18328,30 +25598,29 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RestartPolicy = k8s_io_api_core_v1.RestartPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18361,31 +25630,27 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.S3 == nil {
-				m.S3 = &S3Source{}
-			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18412,16 +25677,16 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Volume == nil {
-				m.Volume = &VolumeSource{}
+			if m.Code == nil {
+				m.Code = &Code{}
 			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Code.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18448,67 +25713,16 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamSource{}
+			if m.Filter == nil {
+				m.Filter = &Filter{}
 			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *Step) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Step: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Step: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18535,15 +25749,18 @@ func (m *Step) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Map == nil {
+				m.Map = &Map{}
+			}
+			if err := m.Map.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminator", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18553,28 +25770,15 @@ func (m *Step) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			m.Terminator = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18601,64 +25805,16 @@ func (m *Step) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *StepList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Group == nil {
+				m.Group = &Group{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StepList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StepList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18685,13 +25841,16 @@ func (m *StepList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Git == nil {
+				m.Git = &Git{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18718,65 +25877,46 @@ func (m *StepList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Step{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *StepSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StepSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StepSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cat", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18803,16 +25943,16 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &Container{}
+			if m.Cat == nil {
+				m.Cat = &Cat{}
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18839,14 +25979,16 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, Source{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18873,16 +26015,109 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sinks = append(m.Sinks, Sink{})
-			if err := m.Sinks[len(m.Sinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18892,29 +26127,33 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RestartPolicy = k8s_io_api_core_v1.RestartPolicy(dAtA[iNdEx:postIndex])
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18924,27 +26163,29 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18971,16 +26212,33 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Code == nil {
-				m.Code = &Code{}
-			}
-			if err := m.Code.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19007,16 +26265,13 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &Filter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flatten", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19043,36 +26298,16 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Map == nil {
-				m.Map = &Map{}
+			if m.Flatten == nil {
+				m.Flatten = &Flatten{}
 			}
-			if err := m.Map.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Flatten.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminator", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Terminator = bool(v != 0)
-		case 11:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expand", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19099,16 +26334,16 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Group == nil {
-				m.Group = &Group{}
+			if m.Expand == nil {
+				m.Expand = &Expand{}
 			}
-			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Expand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dedupe", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19135,16 +26370,16 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &Git{}
+			if m.Dedupe == nil {
+				m.Dedupe = &Dedupe{}
 			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dedupe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecar", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19171,14 +26406,13 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sidecar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkFailurePolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19206,11 +26440,11 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.SinkFailurePolicy = SinkFailurePolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19237,16 +26471,16 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cat == nil {
-				m.Cat = &Cat{}
+			if m.Window == nil {
+				m.Window = &Window{}
 			}
-			if err := m.Cat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19273,18 +26507,18 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
+			if m.Join == nil {
+				m.Join = &Join{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Join.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19294,124 +26528,100 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Suspend = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StepStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StepStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StepStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.NodeSelector[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = StepPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19421,31 +26631,46 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 19:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScaledAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19472,16 +26697,15 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastScaledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19491,31 +26715,29 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
-			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Replicas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19525,16 +26747,80 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Replicas |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 24:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Storage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Storage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Storage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19544,30 +26830,29 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flatten", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19577,31 +26862,78 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flatten == nil {
-				m.Flatten = &Flatten{}
-			}
-			if err := m.Flatten.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 26:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *TLS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19628,16 +26960,16 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expand == nil {
-				m.Expand = &Expand{}
+			if m.CACertSecret == nil {
+				m.CACertSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Expand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CACertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dedupe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19664,16 +26996,16 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Dedupe == nil {
-				m.Dedupe = &Dedupe{}
+			if m.CertSecret == nil {
+				m.CertSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Dedupe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sidecar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19700,7 +27032,10 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sidecar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.KeySecret == nil {
+				m.KeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19726,7 +27061,7 @@ func (m *StepSpec) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *StepStatus) Unmarshal(dAtA []byte) error {
+func (m *TumblingWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19749,17 +27084,17 @@ func (m *StepStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StepStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: TumblingWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StepStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TumblingWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19769,78 +27104,79 @@ func (m *StepStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = StepPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *VolumeSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.Replicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Replicas |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScaledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractVolumeSource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19867,13 +27203,13 @@ func (m *StepStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastScaledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractVolumeSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19901,13 +27237,13 @@ func (m *StepStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.FileName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rolling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19917,23 +27253,27 @@ func (m *StepStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Rolling == nil {
+				m.Rolling = &RollingFile{}
+			}
+			if err := m.Rolling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19957,7 +27297,7 @@ func (m *StepStatus) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Storage) Unmarshal(dAtA []byte) error {
+func (m *VolumeSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19980,17 +27320,17 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Storage: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Storage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20000,29 +27340,85 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.PollPeriod == nil {
+				m.PollPeriod = &v11.Duration{}
+			}
+			if err := m.PollPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractVolumeSource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractVolumeSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20032,24 +27428,12 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ReadOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20072,7 +27456,7 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *TLS) Unmarshal(dAtA []byte) error {
+func (m *Window) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20095,15 +27479,15 @@ func (m *TLS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TLS: wiretype end group for non-group")
+			return fmt.Errorf("proto: Window: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TLS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Window: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CACertSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractStep", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20130,18 +27514,15 @@ func (m *TLS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CACertSecret == nil {
-				m.CACertSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.CACertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20151,33 +27532,29 @@ func (m *TLS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CertSecret == nil {
-				m.CertSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.CertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20187,82 +27564,63 @@ func (m *TLS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KeySecret == nil {
-				m.KeySecret = &v1.SecretKeySelector{}
+			m.EventTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tumbling", wireType)
 			}
-			if err := m.KeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *VolumeSink) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Tumbling == nil {
+				m.Tumbling = &TumblingWindow{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Tumbling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VolumeSink: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VolumeSink: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractVolumeSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sliding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20289,64 +27647,16 @@ func (m *VolumeSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractVolumeSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Sliding == nil {
+				m.Sliding = &SlidingWindow{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Sliding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *VolumeSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VolumeSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VolumeSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20373,18 +27683,18 @@ func (m *VolumeSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollPeriod == nil {
-				m.PollPeriod = &v11.Duration{}
+			if m.Session == nil {
+				m.Session = &SessionWindow{}
 			}
-			if err := m.PollPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
 			}
-			m.Concurrency = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20394,16 +27704,33 @@ func (m *VolumeSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Concurrency |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowedLateness == nil {
+				m.AllowedLateness = &v11.Duration{}
+			}
+			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractVolumeSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20413,30 +27740,29 @@ func (m *VolumeSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractVolumeSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Format = GroupFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20446,12 +27772,28 @@ func (m *VolumeSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnly = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Storage == nil {
+				m.Storage = &Storage{}
+			}
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
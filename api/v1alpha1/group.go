@@ -1,7 +1,11 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type Storage struct {
@@ -14,12 +18,34 @@ type Group struct {
 	EndOfGroup string      `json:"endOfGroup" protobuf:"bytes,2,opt,name=endOfGroup"`
 	Format     GroupFormat `json:"format,omitempty" protobuf:"bytes,3,opt,name=format,casttype=GroupFormat"`
 	Storage    *Storage    `json:"storage,omitempty" protobuf:"bytes,4,opt,name=storage"`
+	// MaxSize is the maximum number of messages in a group, when reached the group is sent onwards. Zero means no limit.
+	MaxSize uint32 `json:"maxSize,omitempty" protobuf:"varint,5,opt,name=maxSize"`
+	// MaxAge is the maximum time since the first message was added to a group, when reached the group is sent onwards.
+	// Zero means no limit.
+	MaxAge *metav1.Duration `json:"maxAge,omitempty" protobuf:"bytes,6,opt,name=maxAge"`
+	// IdleTimeout is the maximum time since the last message was added to a group, when reached the group is sent
+	// onwards. Zero means no limit.
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty" protobuf:"bytes,7,opt,name=idleTimeout"`
+}
+
+func (g *Group) GetMaxAge() time.Duration {
+	if g.MaxAge == nil {
+		return 0
+	}
+	return g.MaxAge.Duration
+}
+
+func (g *Group) GetIdleTimeout() time.Duration {
+	if g.IdleTimeout == nil {
+		return 0
+	}
+	return g.IdleTimeout.Duration
 }
 
 func (g *Group) getContainer(req getContainerReq) corev1.Container {
 	builder := containerBuilder{}.
 		init(req).
-		args("group", g.Key, g.EndOfGroup, string(g.Format))
+		args("group", g.Key, g.EndOfGroup, string(g.Format), fmt.Sprint(g.MaxSize), g.GetMaxAge().String(), g.GetIdleTimeout().String())
 	if g.Storage != nil {
 		builder = builder.appendVolumeMounts(corev1.VolumeMount{
 			Name:      g.Storage.Name,
@@ -28,5 +54,6 @@ func (g *Group) getContainer(req getContainerReq) corev1.Container {
 		})
 	}
 	return builder.
+		enablePrometheus().
 		build()
 }
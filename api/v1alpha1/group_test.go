@@ -2,9 +2,11 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestGroup_getContainer(t *testing.T) {
@@ -18,6 +20,17 @@ func TestGroup_getContainer(t *testing.T) {
 		},
 	}
 	c := x.getContainer(getContainerReq{})
-	assert.Equal(t, []string{"group", "my-key", "my-eog", "my-fmt"}, c.Args)
+	assert.Equal(t, []string{"group", "my-key", "my-eog", "my-fmt", "0", "0s", "0s"}, c.Args)
 	assert.Contains(t, c.VolumeMounts, corev1.VolumeMount{Name: "my-storage", MountPath: "/var/run/argo-dataflow/groups", SubPath: "my-sub-path"})
+	t.Run("Flush", func(t *testing.T) {
+		x := Group{
+			Key:         "my-key",
+			EndOfGroup:  "my-eog",
+			MaxSize:     10,
+			MaxAge:      &metav1.Duration{Duration: time.Minute},
+			IdleTimeout: &metav1.Duration{Duration: time.Second},
+		}
+		c := x.getContainer(getContainerReq{})
+		assert.Equal(t, []string{"group", "my-key", "my-eog", "", "10", "1m0s", "1s"}, c.Args)
+	})
 }
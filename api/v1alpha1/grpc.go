@@ -0,0 +1,5 @@
+package v1alpha1
+
+// GRPC sends messages to the main container over a gRPC stream on the Unix socket PathMainSocket, see
+// sdks/golang/processor/processor.proto. It allows many outputs for each message.
+type GRPC struct{}
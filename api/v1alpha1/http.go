@@ -1,3 +1,26 @@
 package v1alpha1
 
-type HTTP struct{}
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type HTTP struct {
+	// MaxBatchSize is the maximum number of messages to send to the main container in a single request. If greater
+	// than one, messages are POSTed as a JSON array to "/messages/batch" rather than one at a time to "/messages".
+	MaxBatchSize uint32 `json:"maxBatchSize,omitempty" protobuf:"varint,1,opt,name=maxBatchSize"`
+	// MaxBatchWait is the maximum time to wait for a batch to fill before sending it, defaults to 10ms.
+	MaxBatchWait *metav1.Duration `json:"maxBatchWait,omitempty" protobuf:"bytes,2,opt,name=maxBatchWait"`
+}
+
+func (in *HTTP) IsBatch() bool {
+	return in.MaxBatchSize > 1
+}
+
+func (in *HTTP) GetMaxBatchWait() time.Duration {
+	if in.MaxBatchWait == nil {
+		return 10 * time.Millisecond
+	}
+	return in.MaxBatchWait.Duration
+}
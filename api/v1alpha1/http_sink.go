@@ -4,4 +4,7 @@ type HTTPSink struct {
 	URL                string       `json:"url" protobuf:"bytes,1,opt,name=url"`
 	Headers            []HTTPHeader `json:"headers,omitempty" protobuf:"bytes,2,rep,name=headers"`
 	InsecureSkipVerify bool         `json:"insecureSkipVerify,omitempty" protobuf:"varint,3,opt,name=insecureSkipVerify"`
+	// CloudEvents, if set, sends messages as CloudEvents in this mode. The message's meta-data is the event's `id`,
+	// `source` and `time`, and its headers are the event's other attributes.
+	CloudEvents CloudEventsMode `json:"cloudEvents,omitempty" protobuf:"bytes,4,opt,name=cloudEvents,casttype=CloudEventsMode"`
 }
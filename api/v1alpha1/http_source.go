@@ -6,6 +6,9 @@ import (
 
 type HTTPSource struct {
 	ServiceName string `json:"serviceName,omitempty" protobuf:"bytes,1,opt,name=serviceName"` // the service name to create, defaults to `${pipelineName}-${stepName}`.
+	// CloudEvents, if set, means messages are CloudEvents. Both binary and structured mode events are accepted. The
+	// event's `id`, `source` and `time` are the message's meta-data, and its other attributes are the message's headers.
+	CloudEvents CloudEventsMode `json:"cloudEvents,omitempty" protobuf:"bytes,2,opt,name=cloudEvents,casttype=CloudEventsMode"`
 }
 
 func (in HTTPSource) GenURN(cluster, namespace string) string {
@@ -0,0 +1,20 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHTTP_IsBatch(t *testing.T) {
+	assert.False(t, (&HTTP{}).IsBatch())
+	assert.False(t, (&HTTP{MaxBatchSize: 1}).IsBatch())
+	assert.True(t, (&HTTP{MaxBatchSize: 2}).IsBatch())
+}
+
+func TestHTTP_GetMaxBatchWait(t *testing.T) {
+	assert.Equal(t, 10*time.Millisecond, (&HTTP{}).GetMaxBatchWait())
+	assert.Equal(t, time.Second, (&HTTP{MaxBatchWait: &metav1.Duration{Duration: time.Second}}).GetMaxBatchWait())
+}
@@ -3,6 +3,7 @@ package v1alpha1
 type Interface struct {
 	FIFO bool  `json:"fifo,omitempty" protobuf:"varint,1,opt,name=fifo"`
 	HTTP *HTTP `json:"http,omitempty" protobuf:"bytes,2,opt,name=http"`
+	GRPC *GRPC `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
 }
 
 var DefaultInterface = &Interface{HTTP: &HTTP{}}
@@ -0,0 +1,54 @@
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type JoinSide struct {
+	// Source is the name of the step's source that this side's messages come from.
+	Source string `json:"source" protobuf:"bytes,1,opt,name=source"`
+	// Key is an expression that returns the join key of the message, messages with equal keys are joined.
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+}
+
+type Join struct {
+	AbstractStep `json:",inline" protobuf:"bytes,1,opt,name=abstractStep"`
+	Left         JoinSide `json:"left" protobuf:"bytes,2,opt,name=left"`
+	Right        JoinSide `json:"right" protobuf:"bytes,3,opt,name=right"`
+	// Window is how long each message is kept waiting for a match from the other side.
+	// +kubebuilder:default="1m"
+	Window *metav1.Duration `json:"window,omitempty" protobuf:"bytes,4,opt,name=window"`
+	// +kubebuilder:default=Inner
+	Type JoinType `json:"type,omitempty" protobuf:"bytes,5,opt,name=type,casttype=JoinType"`
+	// Template is an optional expression that returns the joined message, it can use `left` and `right` (which is nil
+	// for an unmatched left message). If omitted, the joined message is `{"left": "...", "right": "..."}`.
+	Template string   `json:"template,omitempty" protobuf:"bytes,6,opt,name=template"`
+	Storage  *Storage `json:"storage,omitempty" protobuf:"bytes,7,opt,name=storage"`
+}
+
+func (j *Join) GetWindow() time.Duration {
+	if j.Window == nil {
+		return time.Minute
+	}
+	return j.Window.Duration
+}
+
+func (j *Join) getContainer(req getContainerReq) corev1.Container {
+	builder := containerBuilder{}.
+		init(req).
+		args("join", j.Left.Source, j.Left.Key, j.Right.Source, j.Right.Key, j.GetWindow().String(), string(j.Type), j.Template)
+	if j.Storage != nil {
+		builder = builder.appendVolumeMounts(corev1.VolumeMount{
+			Name:      j.Storage.Name,
+			MountPath: PathJoins,
+			SubPath:   j.Storage.SubPath,
+		})
+	}
+	return builder.
+		enablePrometheus().
+		resources(j.Resources).
+		build()
+}
@@ -0,0 +1,27 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestJoin_getContainer(t *testing.T) {
+	x := Join{
+		Left:     JoinSide{Source: "orders", Key: "my-left-key"},
+		Right:    JoinSide{Source: "payments", Key: "my-right-key"},
+		Window:   &metav1.Duration{Duration: 5 * time.Minute},
+		Type:     JoinTypeLeftOuter,
+		Template: "my-template",
+		Storage: &Storage{
+			Name:    "my-storage",
+			SubPath: "my-sub-path",
+		},
+	}
+	c := x.getContainer(getContainerReq{})
+	assert.Equal(t, []string{"join", "orders", "my-left-key", "payments", "my-right-key", "5m0s", "LeftOuter", "my-template"}, c.Args)
+	assert.Contains(t, c.VolumeMounts, corev1.VolumeMount{Name: "my-storage", MountPath: "/var/run/argo-dataflow/joins", SubPath: "my-sub-path"})
+}
@@ -0,0 +1,9 @@
+package v1alpha1
+
+// +kubebuilder:validation:Enum=Inner;LeftOuter
+type JoinType string
+
+const (
+	JoinTypeInner     JoinType = "Inner"     // only emit messages that have a match on the other side
+	JoinTypeLeftOuter JoinType = "LeftOuter" // also emit left messages that expire without a match
+)
@@ -34,7 +34,9 @@ type Kafka struct {
 	// +kubebuilder:default=default
 	Name        string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	KafkaConfig `json:",inline" protobuf:"bytes,4,opt,name=kafkaConfig"`
-	Topic       string `json:"topic" protobuf:"bytes,3,opt,name=topic"`
+	// Topic is required for sinks. For sources, it maybe a regular expression starting with "^", and you can specify more
+	// topics using `topics`.
+	Topic string `json:"topic,omitempty" protobuf:"bytes,3,opt,name=topic"`
 }
 
 func (in Kafka) GenURN(cluster, namespace string) string {
@@ -1,6 +1,19 @@
 package v1alpha1
 
-// +kubebuilder:validation:Enum=First;Last
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KafkaOffset is where to start consuming a partition from, if the consumer group does not have a committed offset for
+// it. It is one of:
+//
+// * "First" or "Last".
+// * An RFC3339 timestamp, e.g. "2021-10-01T12:00:00Z", to start from the first message at or after that time.
+// * A comma-separated list of offsets, e.g. "0:100,1:250", or offsets for a single topic, e.g. "orders:0:100".
+// Partitions that are not listed start from the last message.
 type KafkaOffset string
 
 func (k KafkaOffset) GetAutoOffsetReset() string {
@@ -11,3 +24,39 @@ func (k KafkaOffset) GetAutoOffsetReset() string {
 		return "latest"
 	}
 }
+
+// GetTime returns the time to start from, if the offset is a timestamp.
+func (k KafkaOffset) GetTime() (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, string(k))
+	return t, err == nil
+}
+
+// GetPartitionOffsets returns the offsets to start from keyed by "{partition}" or "{topic}:{partition}", or nil if the
+// offset is not a list of offsets.
+func (k KafkaOffset) GetPartitionOffsets() (map[string]int64, error) {
+	if _, ok := k.GetTime(); ok {
+		return nil, nil
+	}
+	switch k {
+	case "", "First", "Last":
+		return nil, nil
+	}
+	offsets := make(map[string]int64)
+	for _, item := range strings.Split(string(k), ",") {
+		i := strings.LastIndex(item, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid start offset %q, expected First, Last, an RFC3339 timestamp, or a list of partition offsets", k)
+		}
+		key := strings.TrimSpace(item[:i])
+		partition := key[strings.LastIndex(key, ":")+1:]
+		if _, err := strconv.ParseInt(partition, 10, 32); err != nil {
+			return nil, fmt.Errorf("invalid partition %q in start offset %q: %w", partition, k, err)
+		}
+		offset, err := strconv.ParseInt(strings.TrimSpace(item[i+1:]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offset in start offset %q: %w", k, err)
+		}
+		offsets[key] = offset
+	}
+	return offsets, nil
+}
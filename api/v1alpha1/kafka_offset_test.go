@@ -14,3 +14,28 @@ func TestKafkaOffset_GetAutoOffsetReset(t *testing.T) {
 		assert.Equal(t, "latest", KafkaOffset("Last").GetAutoOffsetReset())
 	})
 }
+
+func TestKafkaOffset_GetTime(t *testing.T) {
+	_, ok := KafkaOffset("Last").GetTime()
+	assert.False(t, ok)
+	v, ok := KafkaOffset("2021-10-01T12:00:00Z").GetTime()
+	assert.True(t, ok)
+	assert.Equal(t, int64(1633089600), v.Unix())
+}
+
+func TestKafkaOffset_GetPartitionOffsets(t *testing.T) {
+	for _, k := range []KafkaOffset{"", "First", "Last", "2021-10-01T12:00:00Z"} {
+		v, err := k.GetPartitionOffsets()
+		assert.NoError(t, err)
+		assert.Nil(t, v, k)
+	}
+	v, err := KafkaOffset("0:100, 1:250,orders:2:3").GetPartitionOffsets()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"0": 100, "1": 250, "orders:2": 3}, v)
+	_, err = KafkaOffset("Foo").GetPartitionOffsets()
+	assert.EqualError(t, err, `invalid start offset "Foo", expected First, Last, an RFC3339 timestamp, or a list of partition offsets`)
+	_, err = KafkaOffset("a:1").GetPartitionOffsets()
+	assert.Error(t, err)
+	_, err = KafkaOffset("0:a").GetPartitionOffsets()
+	assert.Error(t, err)
+}
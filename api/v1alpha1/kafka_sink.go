@@ -23,6 +23,20 @@ type KafkaSink struct {
 	// The maximum number of messages to be in-flight when async.
 	// +kubebuilder:default=20
 	MaxInflight uint32 `json:"maxInflight,omitempty" protobuf:"varint,9,opt,name=maxInflight"`
+	// Key is an optional expression that returns the message key, as a string or bytes, e.g. `object(msg).userId`.
+	// Messages with the same key are written to the same partition, so their order is preserved.
+	Key string `json:"key,omitempty" protobuf:"bytes,10,opt,name=key"`
+	// Partition is an optional expression that returns the partition to write the message to, as a number. If omitted,
+	// the partition is chosen by the key, or at random.
+	Partition string `json:"partition,omitempty" protobuf:"bytes,11,opt,name=partition"`
+	// Headers is a map of header names to expressions that return the header value, as a string or bytes.
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,12,rep,name=headers"`
+	// Transactional writes each message in a transaction. If the message came from a Kafka source, the source's offset
+	// is committed in the same transaction, so consumers using the "read_committed" isolation level see each message
+	// exactly-once. It cannot be used with async.
+	Transactional bool `json:"transactional,omitempty" protobuf:"varint,13,opt,name=transactional"`
+	// SchemaRegistry is used to encode JSON messages as Avro, Protobuf or JSON Schema, framed with the schema ID.
+	SchemaRegistry *SchemaRegistrySink `json:"schemaRegistry,omitempty" protobuf:"bytes,14,opt,name=schemaRegistry"`
 }
 
 func (m *KafkaSink) GetBatchSize() int {
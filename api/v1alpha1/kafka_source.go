@@ -1,6 +1,9 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -15,6 +18,31 @@ type KafkaSource struct {
 	FetchWaitMax *metav1.Duration `json:"fetchWaitMax,omitempty" protobuf:"bytes,4,opt,name=fetchWaitMax"`
 	// GroupID is the consumer group ID. If not specified, a unique deterministic group ID is generated.
 	GroupID string `json:"groupId,omitempty" protobuf:"bytes,5,opt,name=groupId"`
+	// Topics are more topics to consume, as well as `topic`. Each topic maybe a regular expression starting with "^",
+	// e.g. "^orders\..*".
+	Topics []string `json:"topics,omitempty" protobuf:"bytes,6,rep,name=topics"`
+	// SchemaRegistry is used to decode Avro, Protobuf and JSON Schema messages to JSON. Messages that are not framed
+	// with a schema ID are not changed.
+	SchemaRegistry *SchemaRegistry `json:"schemaRegistry,omitempty" protobuf:"bytes,7,opt,name=schemaRegistry"`
+	// Concurrency is the maximum number of messages from each partition that are processed at once. Offsets are only
+	// committed up to the highest message for which all earlier messages have been processed.
+	// +kubebuilder:default=1
+	Concurrency uint32 `json:"concurrency,omitempty" protobuf:"varint,8,opt,name=concurrency"`
+	// KeyOrdered, if true, processes messages with the same key in order, when concurrency is greater than one.
+	KeyOrdered bool `json:"keyOrdered,omitempty" protobuf:"varint,9,opt,name=keyOrdered"`
+}
+
+// GetTopics returns all the topics, and topic regular expressions, to subscribe to.
+func (m *KafkaSource) GetTopics() []string {
+	var topics []string
+	if m.Topic != "" {
+		topics = append(topics, m.Topic)
+	}
+	return append(topics, m.Topics...)
+}
+
+func (m *KafkaSource) GenURN(cluster, namespace string) string {
+	return fmt.Sprintf("urn:dataflow:kafka:%s:%s", m.Brokers[0], strings.Join(m.GetTopics(), ","))
 }
 
 func (m *KafkaSource) GetAutoOffsetReset() string {
@@ -29,6 +57,13 @@ func (m *KafkaSource) GetFetchWaitMaxMs() int {
 	return int(m.FetchWaitMax.Milliseconds())
 }
 
+func (m *KafkaSource) GetConcurrency() int {
+	if m.Concurrency > 0 {
+		return int(m.Concurrency)
+	}
+	return 1
+}
+
 func (m *KafkaSource) GetGroupID(defaultGroupID string) string {
 	if m.GroupID != "" {
 		return m.GroupID
@@ -35,3 +35,21 @@ func TestKafkaSource_GetGroupID(t *testing.T) {
 		assert.Equal(t, "bar", s.GetGroupID("foo"))
 	})
 }
+
+func TestKafkaSource_GetTopics(t *testing.T) {
+	assert.Empty(t, (&KafkaSource{}).GetTopics())
+	s := &KafkaSource{Kafka: Kafka{Topic: "foo"}, Topics: []string{"bar", "^baz.*"}}
+	assert.Equal(t, []string{"foo", "bar", "^baz.*"}, s.GetTopics())
+}
+
+func TestKafkaSource_GenURN(t *testing.T) {
+	s := &KafkaSource{Kafka: Kafka{KafkaConfig: KafkaConfig{Brokers: []string{"my-broker"}}, Topic: "foo"}}
+	assert.Equal(t, "urn:dataflow:kafka:my-broker:foo", s.GenURN("", ""))
+	s.Topics = []string{"^bar.*"}
+	assert.Equal(t, "urn:dataflow:kafka:my-broker:foo,^bar.*", s.GenURN("", ""))
+}
+
+func TestKafkaSource_GetConcurrency(t *testing.T) {
+	assert.Equal(t, 1, (&KafkaSource{}).GetConcurrency())
+	assert.Equal(t, 4, (&KafkaSource{Concurrency: 4}).GetConcurrency())
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -21,29 +23,44 @@ var (
 	// Optional.
 	// https://github.com/cloudevents/spec/blob/master/spec.md#time
 	MetaTime = "dataflow-time"
+	// MetaSourceName is the name of the step's source that the message came from, e.g. so a join can tell its inputs apart.
+	// Optional.
+	MetaSourceName = "dataflow-source-name"
+	// MetaTopic is the topic the message was read from, for sources that have topics (i.e. Kafka).
+	// Optional.
+	MetaTopic = "dataflow-topic"
+	// MetaPartition is the partition of the topic the message was read from.
+	// Optional.
+	MetaPartition = "dataflow-partition"
+	// MetaHeaders are the user's headers for the message, e.g. a tenant ID, or a correlation ID. They are carried through
+	// the pipeline with the message.
+	// Optional.
+	MetaHeaders = "dataflow-headers"
+	// MetaHeaderPrefix is the prefix of the HTTP headers that carry the user's headers, e.g. "dataflow-header-tenant-id".
+	// HTTP header names are case-insensitive, so header keys are lower-case.
+	MetaHeaderPrefix = "dataflow-header-"
 )
 
 type Meta struct {
 	Source string `json:"source" protobuf:"bytes,1,opt,name=source"`
 	ID     string `json:"id" protobuf:"bytes,2,opt,name=id"`
 	// UnixTime
-	Time int64 `json:"time,omitempty" protobuf:"varint,3,opt,name=time"`
+	Time       int64  `json:"time,omitempty" protobuf:"varint,3,opt,name=time"`
+	SourceName string `json:"sourceName,omitempty" protobuf:"bytes,4,opt,name=sourceName"`
+	Topic      string `json:"topic,omitempty" protobuf:"bytes,5,opt,name=topic"`
+	Partition  int32  `json:"partition,omitempty" protobuf:"varint,6,opt,name=partition"`
+	// Headers are the user's headers, as key/value pairs.
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,7,rep,name=headers"`
 }
 
 func ContextWithMeta(ctx context.Context, m Meta) context.Context {
-	return context.WithValue(
-		context.WithValue(
-			context.WithValue(
-				ctx,
-				MetaSource,
-				m.Source,
-			),
-			MetaID,
-			m.ID,
-		),
-		MetaTime,
-		m.Time,
-	)
+	ctx = context.WithValue(ctx, MetaSource, m.Source)
+	ctx = context.WithValue(ctx, MetaID, m.ID)
+	ctx = context.WithValue(ctx, MetaTime, m.Time)
+	ctx = context.WithValue(ctx, MetaSourceName, m.SourceName)
+	ctx = context.WithValue(ctx, MetaTopic, m.Topic)
+	ctx = context.WithValue(ctx, MetaHeaders, m.Headers)
+	return context.WithValue(ctx, MetaPartition, m.Partition)
 }
 
 func MetaFromContext(ctx context.Context) (Meta, error) {
@@ -59,13 +76,49 @@ func MetaFromContext(ctx context.Context) (Meta, error) {
 	if !ok {
 		return Meta{}, fmt.Errorf("failed to get time from context")
 	}
+	sourceName, _ := ctx.Value(MetaSourceName).(string)
+	topic, _ := ctx.Value(MetaTopic).(string)
+	partition, _ := ctx.Value(MetaPartition).(int32)
+	headers, _ := ctx.Value(MetaHeaders).(map[string]string)
 	return Meta{
-		Source: source,
-		ID:     id,
-		Time:   t,
+		Source:     source,
+		ID:         id,
+		Time:       t,
+		SourceName: sourceName,
+		Topic:      topic,
+		Partition:  partition,
+		Headers:    headers,
 	}, nil
 }
 
+// SetHeader sets one of the user's headers on the message in the context, so it is sent with the message's outputs.
+func SetHeader(ctx context.Context, key, value string) error {
+	headers, ok := ctx.Value(MetaHeaders).(map[string]string)
+	if !ok || headers == nil {
+		return fmt.Errorf("failed to get headers from context")
+	}
+	headers[strings.ToLower(key)] = value
+	return nil
+}
+
+// HeadersInject adds the user's headers to the HTTP headers.
+func HeadersInject(headers map[string]string, h http.Header) {
+	for k, v := range headers {
+		h.Set(MetaHeaderPrefix+k, v)
+	}
+}
+
+// HeadersExtract returns the user's headers from the HTTP headers, this is never nil.
+func HeadersExtract(h http.Header) map[string]string {
+	headers := map[string]string{}
+	for k := range h {
+		if key := strings.ToLower(k); strings.HasPrefix(key, MetaHeaderPrefix) {
+			headers[strings.TrimPrefix(key, MetaHeaderPrefix)] = h.Get(k)
+		}
+	}
+	return headers
+}
+
 func MetaInject(ctx context.Context, h http.Header) error {
 	m, err := MetaFromContext(ctx)
 	if err != nil {
@@ -74,16 +127,29 @@ func MetaInject(ctx context.Context, h http.Header) error {
 	h.Add(MetaSource, m.Source)
 	h.Add(MetaID, m.ID)
 	h.Add(MetaTime, time.Unix(m.Time, 0).Format(time.RFC3339))
+	if m.SourceName != "" {
+		h.Add(MetaSourceName, m.SourceName)
+	}
+	if m.Topic != "" {
+		h.Add(MetaTopic, m.Topic)
+		h.Add(MetaPartition, strconv.Itoa(int(m.Partition)))
+	}
+	HeadersInject(m.Headers, h)
 	return nil
 }
 
 func MetaExtract(ctx context.Context, h http.Header) context.Context {
 	t, _ := time.Parse(time.RFC3339, h.Get(MetaTime))
+	partition, _ := strconv.Atoi(h.Get(MetaPartition))
 	return ContextWithMeta(ctx,
 		Meta{
-			Source: h.Get(MetaSource),
-			ID:     h.Get(MetaID),
-			Time:   t.Unix(),
+			Source:     h.Get(MetaSource),
+			ID:         h.Get(MetaID),
+			Time:       t.Unix(),
+			SourceName: h.Get(MetaSourceName),
+			Topic:      h.Get(MetaTopic),
+			Partition:  int32(partition),
+			Headers:    HeadersExtract(h),
 		},
 	)
 }
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,4 +16,46 @@ func TestContextWithMeta(t *testing.T) {
 	assert.Equal(t, "my-source", m.Source)
 	assert.Equal(t, "my-id", m.ID)
 	assert.Equal(t, timestamp, m.Time)
+	assert.Empty(t, m.SourceName)
+	t.Run("SourceName", func(t *testing.T) {
+		ctx := ContextWithMeta(context.Background(), Meta{Source: "my-source", ID: "my-id", SourceName: "my-source-name"})
+		h := http.Header{}
+		assert.NoError(t, MetaInject(ctx, h))
+		assert.Equal(t, "my-source-name", h.Get(MetaSourceName))
+		m, err := MetaFromContext(MetaExtract(context.Background(), h))
+		assert.NoError(t, err)
+		assert.Equal(t, "my-source-name", m.SourceName)
+	})
+	t.Run("Topic", func(t *testing.T) {
+		ctx := ContextWithMeta(context.Background(), Meta{Source: "my-source", ID: "my-id", Topic: "my-topic", Partition: 2})
+		h := http.Header{}
+		assert.NoError(t, MetaInject(ctx, h))
+		assert.Equal(t, "my-topic", h.Get(MetaTopic))
+		assert.Equal(t, "2", h.Get(MetaPartition))
+		m, err := MetaFromContext(MetaExtract(context.Background(), h))
+		assert.NoError(t, err)
+		assert.Equal(t, "my-topic", m.Topic)
+		assert.Equal(t, int32(2), m.Partition)
+	})
+	t.Run("Headers", func(t *testing.T) {
+		ctx := ContextWithMeta(context.Background(), Meta{Source: "my-source", ID: "my-id", Headers: map[string]string{"tenant-id": "my-tenant"}})
+		h := http.Header{}
+		assert.NoError(t, MetaInject(ctx, h))
+		assert.Equal(t, "my-tenant", h.Get("dataflow-header-tenant-id"))
+		ctx = MetaExtract(context.Background(), h)
+		assert.NoError(t, SetHeader(ctx, "Correlation-ID", "my-correlation-id"))
+		m, err := MetaFromContext(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"tenant-id": "my-tenant", "correlation-id": "my-correlation-id"}, m.Headers)
+	})
+}
+
+func TestSetHeader(t *testing.T) {
+	err := SetHeader(ContextWithMeta(context.Background(), Meta{}), "foo", "bar")
+	assert.EqualError(t, err, "failed to get headers from context")
+}
+
+func TestHeadersExtract(t *testing.T) {
+	assert.Empty(t, HeadersExtract(http.Header{"Content-Type": {"text/plain"}}))
+	assert.Equal(t, map[string]string{"foo": "bar"}, HeadersExtract(http.Header{"Dataflow-Header-Foo": {"bar"}}))
 }
@@ -1,6 +1,6 @@
 package v1alpha1
 
-// +kubebuilder:validation:Enum="";Pending;Running;Succeeded;Failed
+// +kubebuilder:validation:Enum="";Pending;Running;Suspended;Succeeded;Failed
 type PipelinePhase string
 
 func (p PipelinePhase) Completed() bool {
@@ -11,6 +11,7 @@ const (
 	PipelineUnknown   PipelinePhase = ""
 	PipelinePending   PipelinePhase = "Pending"
 	PipelineRunning   PipelinePhase = "Running"
+	PipelineSuspended PipelinePhase = "Suspended"
 	PipelineSucceeded PipelinePhase = "Succeeded"
 	PipelineFailed    PipelinePhase = "Failed"
 )
@@ -10,6 +10,8 @@ type PipelineSpec struct {
 	Steps []StepSpec `json:"steps,omitempty" protobuf:"bytes,1,rep,name=steps"`
 	// +kubebuilder:default="72h"
 	DeletionDelay *metav1.Duration `json:"deletionDelay,omitempty" protobuf:"bytes,2,opt,name=deletionDelay"`
+	// Suspended scales all the steps to zero. Their replicas are restored when it is unset.
+	Suspended bool `json:"suspended,omitempty" protobuf:"varint,3,opt,name=suspended"`
 }
 
 func (in *PipelineSpec) HasStep(name string) bool {
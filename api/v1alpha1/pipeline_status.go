@@ -7,4 +7,6 @@ type PipelineStatus struct {
 	Message     string             `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 	Conditions  []metav1.Condition `json:"conditions,omitempty" protobuf:"bytes,3,rep,name=conditions"`
 	LastUpdated metav1.Time        `json:"lastUpdated,omitempty" protobuf:"bytes,4,opt,name=lastUpdated"`
+	// SuspendedReplicas is each step's replicas before the pipeline was suspended, keyed by step name.
+	SuspendedReplicas map[string]uint32 `json:"suspendedReplicas,omitempty" protobuf:"bytes,5,rep,name=suspendedReplicas"`
 }
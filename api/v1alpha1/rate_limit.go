@@ -0,0 +1,18 @@
+package v1alpha1
+
+// RateLimit limits the rate a source's messages are processed at.
+type RateLimit struct {
+	// MessagesPerSecond is the average rate messages are processed at.
+	// +kubebuilder:validation:Minimum=1
+	MessagesPerSecond uint32 `json:"messagesPerSecond" protobuf:"varint,1,opt,name=messagesPerSecond"`
+	// Burst is the number of messages that may be processed at once, above the average rate.
+	// +kubebuilder:default=1
+	Burst uint32 `json:"burst,omitempty" protobuf:"varint,2,opt,name=burst"`
+}
+
+func (in RateLimit) GetBurst() int {
+	if in.Burst > 0 {
+		return int(in.Burst)
+	}
+	return 1
+}
@@ -0,0 +1,32 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Replay reads messages a DLQ sink sent in the Envelope format, and processes the original message, with its original
+// meta-data, as if it came from its original source. Use it to replay failed messages once the cause has been fixed.
+type Replay struct {
+	// Errors is a regular expression, only messages whose error matches it are replayed, e.g. "timeout". If omitted,
+	// all messages are replayed.
+	Errors string `json:"errors,omitempty" protobuf:"bytes,1,opt,name=errors"`
+	// Since, if set, only messages that last failed at, or after, this time are replayed.
+	Since *metav1.Time `json:"since,omitempty" protobuf:"bytes,2,opt,name=since"`
+	// Until, if set, only messages that last failed before this time are replayed.
+	Until *metav1.Time `json:"until,omitempty" protobuf:"bytes,3,opt,name=until"`
+	// MessagesPerSecond is the maximum rate messages are replayed at. If zero, messages are replayed as fast as
+	// possible.
+	MessagesPerSecond uint32 `json:"messagesPerSecond,omitempty" protobuf:"varint,4,opt,name=messagesPerSecond"`
+}
+
+// Match returns true if the dead letter should be replayed. It does not check the errors expression.
+func (in Replay) Match(d DeadLetter) bool {
+	t := d.LastFailureTime.Time
+	if in.Since != nil && t.Before(in.Since.Time) {
+		return false
+	}
+	if in.Until != nil && !t.Before(in.Until.Time) {
+		return false
+	}
+	return true
+}
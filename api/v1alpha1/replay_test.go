@@ -0,0 +1,21 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReplay_Match(t *testing.T) {
+	at := func(sec int64) DeadLetter { return DeadLetter{LastFailureTime: metav1.NewTime(time.Unix(sec, 0))} }
+	since := metav1.NewTime(time.Unix(10, 0))
+	until := metav1.NewTime(time.Unix(20, 0))
+	assert.True(t, Replay{}.Match(at(1)))
+	x := Replay{Since: &since, Until: &until}
+	assert.False(t, x.Match(at(9)))
+	assert.True(t, x.Match(at(10)))
+	assert.True(t, x.Match(at(19)))
+	assert.False(t, x.Match(at(20)))
+}
@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SchemaRegistry is a Confluent compatible schema registry.
+type SchemaRegistry struct {
+	// URL of the registry, e.g. "http://schema-registry:8081".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// UserSecret is the user for basic-auth.
+	UserSecret *corev1.SecretKeySelector `json:"userSecret,omitempty" protobuf:"bytes,2,opt,name=userSecret"`
+	// PasswordSecret is the password for basic-auth.
+	PasswordSecret *corev1.SecretKeySelector `json:"passwordSecret,omitempty" protobuf:"bytes,3,opt,name=passwordSecret"`
+}
+
+type SchemaRegistrySink struct {
+	SchemaRegistry `json:",inline" protobuf:"bytes,1,opt,name=schemaRegistry"`
+	// Subject to register the schema with, defaults to "{topic}-value".
+	Subject string `json:"subject,omitempty" protobuf:"bytes,2,opt,name=subject"`
+	// +kubebuilder:default=AVRO
+	SchemaType SchemaType `json:"schemaType,omitempty" protobuf:"bytes,3,opt,name=schemaType,casttype=SchemaType"`
+	// Schema is registered with the subject, and used to encode messages. If omitted, the latest version of the
+	// subject's schema is used.
+	Schema string `json:"schema,omitempty" protobuf:"bytes,4,opt,name=schema"`
+}
+
+func (m SchemaRegistrySink) GetSubject(topic string) string {
+	if m.Subject != "" {
+		return m.Subject
+	}
+	return fmt.Sprintf("%s-value", topic)
+}
+
+func (m SchemaRegistrySink) GetSchemaType() SchemaType {
+	if m.SchemaType != "" {
+		return m.SchemaType
+	}
+	return SchemaTypeAvro
+}
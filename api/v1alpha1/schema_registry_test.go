@@ -0,0 +1,17 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaRegistrySink_GetSubject(t *testing.T) {
+	assert.Equal(t, "my-topic-value", SchemaRegistrySink{}.GetSubject("my-topic"))
+	assert.Equal(t, "my-subject", SchemaRegistrySink{Subject: "my-subject"}.GetSubject("my-topic"))
+}
+
+func TestSchemaRegistrySink_GetSchemaType(t *testing.T) {
+	assert.Equal(t, SchemaTypeAvro, SchemaRegistrySink{}.GetSchemaType())
+	assert.Equal(t, SchemaTypeProtobuf, SchemaRegistrySink{SchemaType: SchemaTypeProtobuf}.GetSchemaType())
+}
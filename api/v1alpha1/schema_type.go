@@ -0,0 +1,10 @@
+package v1alpha1
+
+// +kubebuilder:validation:Enum=AVRO;PROTOBUF;JSON
+type SchemaType string
+
+const (
+	SchemaTypeAvro     SchemaType = "AVRO"
+	SchemaTypeProtobuf SchemaType = "PROTOBUF"
+	SchemaTypeJSON     SchemaType = "JSON" // JSON Schema
+)
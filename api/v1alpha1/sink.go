@@ -12,4 +12,27 @@ type Sink struct {
 	Volume          *VolumeSink    `json:"volume,omitempty" protobuf:"bytes,8,opt,name=volume"`
 	JetStream       *JetStreamSink `json:"jetstream,omitempty" protobuf:"bytes,9,opt,name=jetstream"`
 	DeadLetterQueue bool           `json:"deadLetterQueue,omitempty" protobuf:"varint,10,opt,name=deadLetterQueue"`
+	// When is an optional expression, using the same environment as a filter, that must return true for messages to
+	// be sent to this sink. If omitted, all messages are sent to this sink.
+	When string `json:"when,omitempty" protobuf:"bytes,11,opt,name=when"`
+	// Default sinks are only sent messages that do not match the `when` expression of any other sink.
+	Default bool `json:"default,omitempty" protobuf:"varint,12,opt,name=default"`
+	// DeadLetterQueueFormat is how failed messages are sent to a DLQ sink, either `Raw`, with the failure in the
+	// message's headers, or `Envelope`, as JSON. Defaults to `Raw` for sinks that write headers (HTTP, Kafka and NATS
+	// JetStream), and `Envelope` for other sinks.
+	DeadLetterQueueFormat DeadLetterQueueFormat `json:"deadLetterQueueFormat,omitempty" protobuf:"bytes,13,opt,name=deadLetterQueueFormat,casttype=DeadLetterQueueFormat"`
+	// Retry, if set, retries sending a message to this sink, before the message is failed and retried by the source.
+	Retry *Backoff `json:"retry,omitempty" protobuf:"bytes,14,opt,name=retry"`
+	// CircuitBreaker, if set, stops sending messages to this sink, and pauses sources, while it is failing.
+	CircuitBreaker *CircuitBreaker `json:"circuitBreaker,omitempty" protobuf:"bytes,15,opt,name=circuitBreaker"`
+}
+
+func (in Sink) GetDeadLetterQueueFormat() DeadLetterQueueFormat {
+	if in.DeadLetterQueueFormat != "" {
+		return in.DeadLetterQueueFormat
+	}
+	if in.HTTP != nil || in.Kafka != nil || in.JetStream != nil {
+		return DeadLetterQueueFormatRaw
+	}
+	return DeadLetterQueueFormatEnvelope
 }
@@ -0,0 +1,10 @@
+package v1alpha1
+
+// +kubebuilder:validation:Enum=AllOrNothing;BestEffort;RetryFailedOnly
+type SinkFailurePolicy string
+
+const (
+	SinkFailurePolicyAllOrNothing    SinkFailurePolicy = "AllOrNothing"    // if any sink fails, the message is retried against all sinks
+	SinkFailurePolicyBestEffort      SinkFailurePolicy = "BestEffort"      // sink failures are counted, but the message is never retried
+	SinkFailurePolicyRetryFailedOnly SinkFailurePolicy = "RetryFailedOnly" // if any sink fails, the message is retried against only the failed sinks
+)
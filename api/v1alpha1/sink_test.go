@@ -0,0 +1,15 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSink_GetDeadLetterQueueFormat(t *testing.T) {
+	assert.Equal(t, DeadLetterQueueFormatEnvelope, Sink{Log: &Log{}}.GetDeadLetterQueueFormat())
+	assert.Equal(t, DeadLetterQueueFormatRaw, Sink{Kafka: &KafkaSink{}}.GetDeadLetterQueueFormat())
+	assert.Equal(t, DeadLetterQueueFormatRaw, Sink{HTTP: &HTTPSink{}}.GetDeadLetterQueueFormat())
+	assert.Equal(t, DeadLetterQueueFormatRaw, Sink{JetStream: &JetStreamSink{}}.GetDeadLetterQueueFormat())
+	assert.Equal(t, DeadLetterQueueFormatEnvelope, Sink{Kafka: &KafkaSink{}, DeadLetterQueueFormat: DeadLetterQueueFormatEnvelope}.GetDeadLetterQueueFormat())
+}
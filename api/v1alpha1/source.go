@@ -17,6 +17,14 @@ type Source struct {
 	JetStream *JetStreamSource `json:"jetstream,omitempty" protobuf:"bytes,10,opt,name=jetstream"`
 	// +kubebuilder:default={duration: "100ms", steps: 20, factorPercentage: 200, jitterPercentage: 10}
 	Retry Backoff `json:"retry,omitempty" protobuf:"bytes,7,opt,name=retry"`
+	// Replay, if set, means the source reads dead letters from a DLQ, and replays the original messages. Only Kafka,
+	// NATS JetStream, S3 and HTTP sources can replay.
+	Replay *Replay `json:"replay,omitempty" protobuf:"bytes,11,opt,name=replay"`
+	// RateLimit, if set, limits the rate messages are processed at, e.g. to pace calls to a rate-limited API.
+	RateLimit *RateLimit `json:"rateLimit,omitempty" protobuf:"bytes,12,opt,name=rateLimit"`
+	// MaxConcurrency, if set, is the maximum number of messages from this source that are processed at once, by each
+	// replica.
+	MaxConcurrency uint32 `json:"maxConcurrency,omitempty" protobuf:"varint,13,opt,name=maxConcurrency"`
 }
 
 func (s Source) get() urner {
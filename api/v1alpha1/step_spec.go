@@ -16,8 +16,10 @@ type StepSpec struct {
 	Flatten   *Flatten   `json:"flatten,omitempty" protobuf:"bytes,25,opt,name=flatten"`
 	Git       *Git       `json:"git,omitempty" protobuf:"bytes,12,opt,name=git"`
 	Group     *Group     `json:"group,omitempty" protobuf:"bytes,11,opt,name=group"`
+	Join      *Join      `json:"join,omitempty" protobuf:"bytes,31,opt,name=join"`
 	Code      *Code      `json:"code,omitempty" protobuf:"bytes,7,opt,name=code"`
 	Map       *Map       `json:"map,omitempty" protobuf:"bytes,9,opt,name=map"`
+	Window    *Window    `json:"window,omitempty" protobuf:"bytes,30,opt,name=window"`
 
 	// +kubebuilder:default=1
 	Replicas uint32 `json:"replicas,omitempty" protobuf:"varint,23,opt,name=replicas"`
@@ -29,9 +31,15 @@ type StepSpec struct {
 	// +patchStrategy=merge
 	// +patchMergeKey=name
 	Sinks []Sink `json:"sinks,omitempty" protobuf:"bytes,4,rep,name=sinks"`
+	// SinkFailurePolicy is what to do when a message cannot be written to some, but not all, sinks.
+	// +kubebuilder:default=AllOrNothing
+	SinkFailurePolicy SinkFailurePolicy `json:"sinkFailurePolicy,omitempty" protobuf:"bytes,29,opt,name=sinkFailurePolicy,casttype=SinkFailurePolicy"`
 	// +kubebuilder:default=OnFailure
 	RestartPolicy corev1.RestartPolicy `json:"restartPolicy,omitempty" protobuf:"bytes,5,opt,name=restartPolicy,casttype=k8s.io/api/core/v1.RestartPolicy"`
 	Terminator    bool                 `json:"terminator,omitempty" protobuf:"varint,10,opt,name=terminator"` // if this step terminates, terminate all steps in the pipeline
+	// Suspend, if true, pauses all the step's sources, without deleting its pods, so messages being processed are not
+	// lost.
+	Suspend bool `json:"suspend,omitempty" protobuf:"varint,32,opt,name=suspend"`
 	// +patchStrategy=merge
 	// +patchMergeKey=name
 	Volumes []corev1.Volume `json:"volumes,omitempty" protobuf:"bytes,13,rep,name=volumes"`
@@ -76,17 +84,32 @@ func (in StepSpec) getType() containerSupplier {
 		return x
 	} else if x := in.Group; x != nil {
 		return x
+	} else if x := in.Join; x != nil {
+		return x
 	} else if x := in.Code; x != nil {
 		return x
 	} else if x := in.Map; x != nil {
 		return x
+	} else if x := in.Window; x != nil {
+		return x
 	} else {
 		panic("invalid step spec")
 	}
 }
 
+// GetReplicas returns the number of replicas to run. A join step only ever has one replica, because each replica buffers
+// its own messages, so messages with equal keys that arrived at different replicas would never be joined.
+func (in StepSpec) GetReplicas() int {
+	if in.Join != nil && in.Replicas > 1 {
+		return 1
+	}
+	return int(in.Replicas)
+}
+
+// WithOutReplicas returns the spec without the fields that do not change the pod, i.e. replicas and suspend.
 func (in StepSpec) WithOutReplicas() StepSpec {
 	x := *in.DeepCopy()
 	x.Replicas = 0
+	x.Suspend = false
 	return x
 }
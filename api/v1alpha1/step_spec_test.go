@@ -7,7 +7,15 @@ import (
 )
 
 func TestStepSpec_WithOutReplicas(t *testing.T) {
-	in := StepSpec{Replicas: 1, Name: "foo"}.WithOutReplicas()
+	in := StepSpec{Replicas: 1, Suspend: true, Name: "foo"}.WithOutReplicas()
 	assert.Zero(t, in.Replicas)
+	assert.False(t, in.Suspend)
 	assert.Equal(t, "foo", in.Name)
 }
+
+func TestStepSpec_GetReplicas(t *testing.T) {
+	assert.Equal(t, 0, StepSpec{}.GetReplicas())
+	assert.Equal(t, 2, StepSpec{Replicas: 2}.GetReplicas())
+	assert.Equal(t, 0, StepSpec{Join: &Join{}}.GetReplicas())
+	assert.Equal(t, 1, StepSpec{Join: &Join{}, Replicas: 2}.GetReplicas())
+}
@@ -48,8 +48,9 @@ type Step struct {
 
 func (in Step) GetPodSpec(req GetPodSpecReq) corev1.PodSpec {
 	const (
-		varVolumeName = "var-run-argo-dataflow"
-		sshVolumeName = "ssh"
+		varVolumeName     = "var-run-argo-dataflow"
+		sshVolumeName     = "ssh"
+		podInfoVolumeName = "podinfo"
 	)
 	volumes := []corev1.Volume{
 		{
@@ -67,6 +68,16 @@ func (in Step) GetPodSpec(req GetPodSpecReq) corev1.PodSpec {
 				},
 			},
 		},
+		{
+			Name: podInfoVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				DownwardAPI: &corev1.DownwardAPIVolumeSource{
+					Items: []corev1.DownwardAPIVolumeFile{
+						{Path: "annotations", FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations"}},
+					},
+				},
+			},
+		},
 	}
 	volumeMounts := []corev1.VolumeMount{{Name: varVolumeName, MountPath: PathVarRun}}
 	for _, source := range in.Spec.Sources {
@@ -96,6 +107,12 @@ func (in Step) GetPodSpec(req GetPodSpecReq) corev1.PodSpec {
 			})
 		}
 	}
+	// copied, so that appending does not change the other containers' mounts
+	sidecarVolumeMounts := append(append([]corev1.VolumeMount{}, volumeMounts...), corev1.VolumeMount{
+		Name:      podInfoVolumeName,
+		ReadOnly:  true,
+		MountPath: PathPodInfo,
+	})
 	step, _ := json.Marshal(in.withoutManagedFields())
 	envVars := []corev1.EnvVar{
 		{Name: EnvCluster, Value: req.Cluster},
@@ -170,7 +187,7 @@ func (in Step) GetPodSpec(req GetPodSpecReq) corev1.PodSpec {
 				ImagePullPolicy: req.PullPolicy,
 				Args:            []string{"sidecar"},
 				Env:             envVars,
-				VolumeMounts:    volumeMounts,
+				VolumeMounts:    sidecarVolumeMounts,
 				Resources:       req.Sidecar.Resources,
 				Ports: []corev1.ContainerPort{
 					{ContainerPort: 3570},
@@ -80,7 +80,11 @@ func TestStep_GetPodSpec(t *testing.T) {
 								},
 								Resources:       standardResources,
 								SecurityContext: dropAll,
-								VolumeMounts:    mounts,
+								VolumeMounts: append(mounts, corev1.VolumeMount{
+									Name:      "podinfo",
+									ReadOnly:  true,
+									MountPath: "/var/run/argo-dataflow/podinfo",
+								}),
 							},
 							{
 								Args:            []string{"cat"},
@@ -132,6 +136,15 @@ func TestStep_GetPodSpec(t *testing.T) {
 										DefaultMode: pointer.Int32Ptr(0o644),
 									},
 								},
+							}, {
+								Name: "podinfo",
+								VolumeSource: corev1.VolumeSource{
+									DownwardAPI: &corev1.DownwardAPIVolumeSource{
+										Items: []corev1.DownwardAPIVolumeFile{
+											{Path: "annotations", FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations"}},
+										},
+									},
+								},
 							},
 						},
 					},
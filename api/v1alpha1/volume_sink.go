@@ -1,5 +1,47 @@
 package v1alpha1
 
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type VolumeSink struct {
 	AbstractVolumeSource `json:",inline" protobuf:"bytes,1,opt,name=abstractVolumeSource"`
+	// FileName is an expression that returns the name of the file to write, relative to the root of the volume.
+	// If Rolling is specified, it is evaluated for the first message written to each file.
+	// +kubebuilder:default="ctx.id"
+	FileName string `json:"fileName,omitempty" protobuf:"bytes,2,opt,name=fileName"`
+	// Rolling appends messages, separated by a new-line, to a file that is rolled-over when it reaches
+	// a maximum size or age, rather than writing one file per message.
+	Rolling *RollingFile `json:"rolling,omitempty" protobuf:"bytes,3,opt,name=rolling"`
+}
+
+func (in *VolumeSink) GetFileName() string {
+	if in.FileName == "" {
+		return "ctx.id"
+	}
+	return in.FileName
+}
+
+type RollingFile struct {
+	// +kubebuilder:default="10Mi"
+	MaxSize *resource.Quantity `json:"maxSize,omitempty" protobuf:"bytes,1,opt,name=maxSize"`
+	// +kubebuilder:default="1m"
+	MaxAge *metav1.Duration `json:"maxAge,omitempty" protobuf:"bytes,2,opt,name=maxAge"`
+}
+
+func (in *RollingFile) GetMaxSize() int64 {
+	if in.MaxSize == nil {
+		return 10 * 1024 * 1024
+	}
+	return in.MaxSize.Value()
+}
+
+func (in *RollingFile) GetMaxAge() time.Duration {
+	if in.MaxAge == nil {
+		return time.Minute
+	}
+	return in.MaxAge.Duration
 }
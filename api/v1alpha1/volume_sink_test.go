@@ -0,0 +1,26 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVolumeSink_GetFileName(t *testing.T) {
+	assert.Equal(t, "ctx.id", (&VolumeSink{}).GetFileName())
+	assert.Equal(t, `"foo"`, (&VolumeSink{FileName: `"foo"`}).GetFileName())
+}
+
+func TestRollingFile_GetMaxSize(t *testing.T) {
+	assert.Equal(t, int64(10*1024*1024), (&RollingFile{}).GetMaxSize())
+	v := resource.MustParse("1Ki")
+	assert.Equal(t, int64(1024), (&RollingFile{MaxSize: &v}).GetMaxSize())
+}
+
+func TestRollingFile_GetMaxAge(t *testing.T) {
+	assert.Equal(t, time.Minute, (&RollingFile{}).GetMaxAge())
+	assert.Equal(t, time.Second, (&RollingFile{MaxAge: &metav1.Duration{Duration: time.Second}}).GetMaxAge())
+}
@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type TumblingWindow struct {
+	// Duration is the length of each window, windows do not overlap.
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,1,opt,name=duration"`
+}
+
+type SlidingWindow struct {
+	// Duration is the length of each window.
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,1,opt,name=duration"`
+	// Slide is how often a new window starts, windows overlap if this is less than the duration.
+	Slide metav1.Duration `json:"slide" protobuf:"bytes,2,opt,name=slide"`
+}
+
+type SessionWindow struct {
+	// Gap is the period of inactivity after which a session window closes.
+	Gap metav1.Duration `json:"gap" protobuf:"bytes,1,opt,name=gap"`
+}
+
+type Window struct {
+	AbstractStep `json:",inline" protobuf:"bytes,1,opt,name=abstractStep"`
+	// Key is an expression that returns the key of the message, messages with different keys are windowed separately.
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+	// EventTime is an optional expression that returns the event time of the message, either an RFC3339 string or Unix
+	// seconds. If omitted, the message's meta-data time is used.
+	EventTime string          `json:"eventTime,omitempty" protobuf:"bytes,3,opt,name=eventTime"`
+	Tumbling  *TumblingWindow `json:"tumbling,omitempty" protobuf:"bytes,4,opt,name=tumbling"`
+	Sliding   *SlidingWindow  `json:"sliding,omitempty" protobuf:"bytes,5,opt,name=sliding"`
+	Session   *SessionWindow  `json:"session,omitempty" protobuf:"bytes,6,opt,name=session"`
+	// AllowedLateness is how long after the watermark passes the end of a window it is kept open for late messages.
+	// +kubebuilder:default="0s"
+	AllowedLateness *metav1.Duration `json:"allowedLateness,omitempty" protobuf:"bytes,7,opt,name=allowedLateness"`
+	// +kubebuilder:default=JSONStringArray
+	Format  GroupFormat `json:"format,omitempty" protobuf:"bytes,8,opt,name=format,casttype=GroupFormat"`
+	Storage *Storage    `json:"storage,omitempty" protobuf:"bytes,9,opt,name=storage"`
+}
+
+func (w *Window) GetAllowedLateness() time.Duration {
+	if w.AllowedLateness == nil {
+		return 0
+	}
+	return w.AllowedLateness.Duration
+}
+
+// getKind returns the kind of window, and its duration and slide, or its gap (session)
+func (w *Window) getKind() (string, time.Duration, time.Duration) {
+	if x := w.Tumbling; x != nil {
+		return "tumbling", x.Duration.Duration, x.Duration.Duration
+	} else if x := w.Sliding; x != nil {
+		return "sliding", x.Duration.Duration, x.Slide.Duration
+	} else if x := w.Session; x != nil {
+		return "session", x.Gap.Duration, 0
+	}
+	return "", 0, 0
+}
+
+func (w *Window) getContainer(req getContainerReq) corev1.Container {
+	kind, a, b := w.getKind()
+	builder := containerBuilder{}.
+		init(req).
+		args("window", w.Key, w.EventTime, kind, a.String(), b.String(), w.GetAllowedLateness().String(), string(w.Format))
+	if w.Storage != nil {
+		builder = builder.appendVolumeMounts(corev1.VolumeMount{
+			Name:      w.Storage.Name,
+			MountPath: PathWindows,
+			SubPath:   w.Storage.SubPath,
+		})
+	}
+	return builder.
+		enablePrometheus().
+		resources(w.Resources).
+		build()
+}
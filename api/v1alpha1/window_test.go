@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWindow_getContainer(t *testing.T) {
+	t.Run("Tumbling", func(t *testing.T) {
+		x := Window{
+			Key:      "my-key",
+			Tumbling: &TumblingWindow{Duration: metav1.Duration{Duration: time.Minute}},
+			Format:   GroupFormatJSONStringArray,
+			Storage: &Storage{
+				Name:    "my-storage",
+				SubPath: "my-sub-path",
+			},
+		}
+		c := x.getContainer(getContainerReq{})
+		assert.Equal(t, []string{"window", "my-key", "", "tumbling", "1m0s", "1m0s", "0s", "JSONStringArray"}, c.Args)
+		assert.Contains(t, c.VolumeMounts, corev1.VolumeMount{Name: "my-storage", MountPath: "/var/run/argo-dataflow/windows", SubPath: "my-sub-path"})
+	})
+	t.Run("Sliding", func(t *testing.T) {
+		x := Window{
+			Key:             "my-key",
+			EventTime:       "my-event-time",
+			Sliding:         &SlidingWindow{Duration: metav1.Duration{Duration: time.Minute}, Slide: metav1.Duration{Duration: 10 * time.Second}},
+			AllowedLateness: &metav1.Duration{Duration: 5 * time.Second},
+		}
+		c := x.getContainer(getContainerReq{})
+		assert.Equal(t, []string{"window", "my-key", "my-event-time", "sliding", "1m0s", "10s", "5s", ""}, c.Args)
+	})
+	t.Run("Session", func(t *testing.T) {
+		x := Window{Key: "my-key", Session: &SessionWindow{Gap: metav1.Duration{Duration: time.Minute}}}
+		c := x.getContainer(getContainerReq{})
+		assert.Equal(t, []string{"window", "my-key", "", "session", "1m0s", "0s", "0s", ""}, c.Args)
+	})
+}
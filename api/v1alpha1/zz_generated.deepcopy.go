@@ -262,6 +262,88 @@ func (in *Backoff) DeepCopy() *Backoff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchMessage) DeepCopyInto(out *BatchMessage) {
+	*out = *in
+	in.Meta.DeepCopyInto(&out.Meta)
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchMessage.
+func (in *BatchMessage) DeepCopy() *BatchMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchOutput) DeepCopyInto(out *BatchOutput) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchOutput.
+func (in *BatchOutput) DeepCopy() *BatchOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchResult) DeepCopyInto(out *BatchResult) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]BatchOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchResult.
+func (in *BatchResult) DeepCopy() *BatchResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cat) DeepCopyInto(out *Cat) {
 	*out = *in
@@ -278,6 +360,26 @@ func (in *Cat) DeepCopy() *Cat {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
+	*out = *in
+	if in.OpenDuration != nil {
+		in, out := &in.OpenDuration, &out.OpenDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreaker.
+func (in *CircuitBreaker) DeepCopy() *CircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Code) DeepCopyInto(out *Code) {
 	*out = *in
@@ -454,11 +556,49 @@ func (in *Database) DeepCopy() *Database {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetter) DeepCopyInto(out *DeadLetter) {
+	*out = *in
+	in.FirstFailureTime.DeepCopyInto(&out.FirstFailureTime)
+	in.LastFailureTime.DeepCopyInto(&out.LastFailureTime)
+	in.Meta.DeepCopyInto(&out.Meta)
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetter.
+func (in *DeadLetter) DeepCopy() *DeadLetter {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Dedupe) DeepCopyInto(out *Dedupe) {
 	*out = *in
 	in.AbstractStep.DeepCopyInto(&out.AbstractStep)
 	out.MaxSize = in.MaxSize.DeepCopy()
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(Storage)
+		**out = **in
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisStore)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dedupe.
@@ -519,6 +659,21 @@ func (in *Flatten) DeepCopy() *Flatten {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPC) DeepCopyInto(out *GRPC) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPC.
+func (in *GRPC) DeepCopy() *GRPC {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GetPodSpecReq) DeepCopyInto(out *GetPodSpecReq) {
 	*out = *in
@@ -591,6 +746,16 @@ func (in *Group) DeepCopyInto(out *Group) {
 		*out = new(Storage)
 		**out = **in
 	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Group.
@@ -606,6 +771,11 @@ func (in *Group) DeepCopy() *Group {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTP) DeepCopyInto(out *HTTP) {
 	*out = *in
+	if in.MaxBatchWait != nil {
+		in, out := &in.MaxBatchWait, &out.MaxBatchWait
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP.
@@ -697,6 +867,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPC)
 		**out = **in
 	}
 }
@@ -763,6 +938,49 @@ func (in *JetStreamSource) DeepCopy() *JetStreamSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Join) DeepCopyInto(out *Join) {
+	*out = *in
+	in.AbstractStep.DeepCopyInto(&out.AbstractStep)
+	out.Left = in.Left
+	out.Right = in.Right
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(Storage)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Join.
+func (in *Join) DeepCopy() *Join {
+	if in == nil {
+		return nil
+	}
+	out := new(Join)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JoinSide) DeepCopyInto(out *JoinSide) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JoinSide.
+func (in *JoinSide) DeepCopy() *JoinSide {
+	if in == nil {
+		return nil
+	}
+	out := new(JoinSide)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kafka) DeepCopyInto(out *Kafka) {
 	*out = *in
@@ -853,6 +1071,18 @@ func (in *KafkaSink) DeepCopyInto(out *KafkaSink) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SchemaRegistry != nil {
+		in, out := &in.SchemaRegistry, &out.SchemaRegistry
+		*out = new(SchemaRegistrySink)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSink.
@@ -879,6 +1109,16 @@ func (in *KafkaSource) DeepCopyInto(out *KafkaSource) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SchemaRegistry != nil {
+		in, out := &in.SchemaRegistry, &out.SchemaRegistry
+		*out = new(SchemaRegistry)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSource.
@@ -930,6 +1170,13 @@ func (in *Map) DeepCopy() *Map {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Meta) DeepCopyInto(out *Meta) {
 	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Meta.
@@ -1088,6 +1335,13 @@ func (in *PipelineStatus) DeepCopyInto(out *PipelineStatus) {
 		}
 	}
 	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	if in.SuspendedReplicas != nil {
+		in, out := &in.SuspendedReplicas, &out.SuspendedReplicas
+		*out = make(map[string]uint32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineStatus.
@@ -1100,6 +1354,89 @@ func (in *PipelineStatus) DeepCopy() *PipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisStore) DeepCopyInto(out *RedisStore) {
+	*out = *in
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStore.
+func (in *RedisStore) DeepCopy() *RedisStore {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Replay) DeepCopyInto(out *Replay) {
+	*out = *in
+	if in.Since != nil {
+		in, out := &in.Since, &out.Since
+		*out = (*in).DeepCopy()
+	}
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Replay.
+func (in *Replay) DeepCopy() *Replay {
+	if in == nil {
+		return nil
+	}
+	out := new(Replay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingFile) DeepCopyInto(out *RollingFile) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingFile.
+func (in *RollingFile) DeepCopy() *RollingFile {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3) DeepCopyInto(out *S3) {
 	*out = *in
@@ -1268,6 +1605,63 @@ func (in *Scale) DeepCopy() *Scale {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaRegistry) DeepCopyInto(out *SchemaRegistry) {
+	*out = *in
+	if in.UserSecret != nil {
+		in, out := &in.UserSecret, &out.UserSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaRegistry.
+func (in *SchemaRegistry) DeepCopy() *SchemaRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaRegistrySink) DeepCopyInto(out *SchemaRegistrySink) {
+	*out = *in
+	in.SchemaRegistry.DeepCopyInto(&out.SchemaRegistry)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaRegistrySink.
+func (in *SchemaRegistrySink) DeepCopy() *SchemaRegistrySink {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaRegistrySink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionWindow) DeepCopyInto(out *SessionWindow) {
+	*out = *in
+	out.Gap = in.Gap
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionWindow.
+func (in *SessionWindow) DeepCopy() *SessionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sidecar) DeepCopyInto(out *Sidecar) {
 	*out = *in
@@ -1327,6 +1721,16 @@ func (in *Sink) DeepCopyInto(out *Sink) {
 		*out = new(JetStreamSink)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sink.
@@ -1339,6 +1743,23 @@ func (in *Sink) DeepCopy() *Sink {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlidingWindow) DeepCopyInto(out *SlidingWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	out.Slide = in.Slide
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlidingWindow.
+func (in *SlidingWindow) DeepCopy() *SlidingWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(SlidingWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Source) DeepCopyInto(out *Source) {
 	*out = *in
@@ -1383,6 +1804,16 @@ func (in *Source) DeepCopyInto(out *Source) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Retry.DeepCopyInto(&out.Retry)
+	if in.Replay != nil {
+		in, out := &in.Replay, &out.Replay
+		*out = new(Replay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Source.
@@ -1518,6 +1949,11 @@ func (in *StepSpec) DeepCopyInto(out *StepSpec) {
 		*out = new(Group)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Join != nil {
+		in, out := &in.Join, &out.Join
+		*out = new(Join)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Code != nil {
 		in, out := &in.Code, &out.Code
 		*out = new(Code)
@@ -1528,6 +1964,11 @@ func (in *StepSpec) DeepCopyInto(out *StepSpec) {
 		*out = new(Map)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(Window)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Scale = in.Scale
 	if in.Sources != nil {
 		in, out := &in.Sources, &out.Sources
@@ -1653,10 +2094,31 @@ func (in *TLS) DeepCopy() *TLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TumblingWindow) DeepCopyInto(out *TumblingWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TumblingWindow.
+func (in *TumblingWindow) DeepCopy() *TumblingWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TumblingWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSink) DeepCopyInto(out *VolumeSink) {
 	*out = *in
 	in.AbstractVolumeSource.DeepCopyInto(&out.AbstractVolumeSource)
+	if in.Rolling != nil {
+		in, out := &in.Rolling, &out.Rolling
+		*out = new(RollingFile)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSink.
@@ -1689,3 +2151,44 @@ func (in *VolumeSource) DeepCopy() *VolumeSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Window) DeepCopyInto(out *Window) {
+	*out = *in
+	in.AbstractStep.DeepCopyInto(&out.AbstractStep)
+	if in.Tumbling != nil {
+		in, out := &in.Tumbling, &out.Tumbling
+		*out = new(TumblingWindow)
+		**out = **in
+	}
+	if in.Sliding != nil {
+		in, out := &in.Sliding, &out.Sliding
+		*out = new(SlidingWindow)
+		**out = **in
+	}
+	if in.Session != nil {
+		in, out := &in.Session, &out.Session
+		*out = new(SessionWindow)
+		**out = **in
+	}
+	if in.AllowedLateness != nil {
+		in, out := &in.AllowedLateness, &out.AllowedLateness
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(Storage)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Window.
+func (in *Window) DeepCopy() *Window {
+	if in == nil {
+		return nil
+	}
+	out := new(Window)
+	in.DeepCopyInto(out)
+	return out
+}
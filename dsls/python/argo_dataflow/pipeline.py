@@ -10,7 +10,10 @@ VERSION = 'v1alpha1'
 GROUP = 'dataflow.argoproj.io'
 
 DEFAULT_RUNTIME = 'python3-9'
+DEDUPE_VOLUME_NAME = 'dedupe'
 GROUPS_VOLUME_NAME = 'groups'
+WINDOWS_VOLUME_NAME = 'windows'
+JOINS_VOLUME_NAME = 'joins'
 USER = getpass.getuser()
 
 
@@ -30,6 +33,7 @@ class PipelineBuilder:
         self._namespace = None
         self._annotations = {}
         self._steps = []
+        self._suspended = False
         self.owner(USER)
 
     def annotate(self, name, value):
@@ -50,6 +54,10 @@ class PipelineBuilder:
         self._steps.append(step)
         return self
 
+    def suspended(self):
+        self._suspended = True
+        return self
+
     def dump(self):
         m = {
             'name': self._name,
@@ -59,13 +67,16 @@ class PipelineBuilder:
             m['namespace'] = self._namespace
         if self._resourceVersion:
             m['resourceVersion'] = self._resourceVersion
+        spec = {
+            'steps': [x.dump() for x in self._steps]
+        }
+        if self._suspended:
+            spec['suspended'] = True
         return {
             'apiVersion': 'dataflow.argoproj.io/v1alpha1',
             'kind': 'Pipeline',
             'metadata': m,
-            'spec': {
-                'steps': [x.dump() for x in self._steps]
-            }
+            'spec': spec
         }
 
     def yaml(self):
@@ -141,11 +152,12 @@ class LogSink(Sink):
 
 
 class HTTPSink(Sink):
-    def __init__(self, url, name=None, insecureSkipVerify=None, headers=None):
+    def __init__(self, url, name=None, insecureSkipVerify=None, headers=None, cloudEvents=None):
         super().__init__(name)
         self._insecureSkipVerify = insecureSkipVerify
         self._url = url
         self._headers = headers
+        self._cloudEvents = cloudEvents
 
     def dump(self):
         x = super().dump()
@@ -154,15 +166,23 @@ class HTTPSink(Sink):
             h['headers'] = self._headers
         if self._insecureSkipVerify:
             h['insecureSkipVerify'] = self._insecureSkipVerify
+        if self._cloudEvents:
+            h['cloudEvents'] = self._cloudEvents
         x['http'] = h
         return x
 
 
 class KafkaSink(Sink):
     def __init__(self, subject, name=None, a_sync=False, batchSize=None, linger=None, compressionType=None, acks=None,
-                 enableIdempotence=None, messageTimeout=None, maxInflight=None):
+                 enableIdempotence=None, messageTimeout=None, maxInflight=None, key=None, partition=None, headers=None,
+                 transactional=False, schemaRegistry=None):
         super().__init__(name)
         self._subject = subject
+        self._schemaRegistry = schemaRegistry
+        self._key = key
+        self._partition = partition
+        self._headers = headers
+        self._transactional = transactional
         self._a_sync = a_sync
         self._batchSize = batchSize
         self._linger = linger
@@ -191,6 +211,16 @@ class KafkaSink(Sink):
             y['messageTimeout'] = self._messageTimeout
         if self._maxInflight:
             y['maxInflight'] = self._maxInflight
+        if self._key:
+            y['key'] = self._key
+        if self._partition:
+            y['partition'] = self._partition
+        if self._headers:
+            y['headers'] = self._headers
+        if self._transactional:
+            y['transactional'] = True
+        if self._schemaRegistry:
+            y['schemaRegistry'] = self._schemaRegistry
         x['kafka'] = y
         return x
 
@@ -225,6 +255,7 @@ class Step:
         self._scale = None
         self._volumes = volumes or []
         self._terminator = terminator
+        self._suspend = False
         self._annotations = []
         self._sidecarResources = sidecarResource
 
@@ -232,15 +263,18 @@ class Step:
         self._sinks.append(LogSink(name=name))
         return self
 
-    def http(self, url, name=None, insecureSkipVerify=None, headers=None):
+    def http(self, url, name=None, insecureSkipVerify=None, headers=None, cloudEvents=None):
         self._sinks.append(HTTPSink(
-            url, name=name, insecureSkipVerify=insecureSkipVerify, headers=headers))
+            url, name=name, insecureSkipVerify=insecureSkipVerify, headers=headers, cloudEvents=cloudEvents))
         return self
 
     def kafka(self, subject, name=None, a_sync=False, batchSize=None, linger=None, compressionType=None, acks=None,
-              enableIdempotence=None, messageTimeout=None, maxInflight=None):
+              enableIdempotence=None, messageTimeout=None, maxInflight=None, key=None, partition=None, headers=None,
+              transactional=False, schemaRegistry=None):
         self._sinks.append(KafkaSink(subject, name=name, a_sync=a_sync, batchSize=batchSize, linger=linger,
-                                     compressionType=compressionType, acks=acks, enableIdempotence=enableIdempotence, messageTimeout=messageTimeout, maxInflight=maxInflight))
+                                     compressionType=compressionType, acks=acks, enableIdempotence=enableIdempotence, messageTimeout=messageTimeout, maxInflight=maxInflight,
+                                     key=key, partition=partition, headers=headers, transactional=transactional,
+                                     schemaRegistry=schemaRegistry))
         return self
 
     def scale(self, desiredReplicas, scalingDelay=None, peekDelay=None):
@@ -265,6 +299,10 @@ class Step:
         self._terminator = True
         return self
 
+    def suspend(self):
+        self._suspend = True
+        return self
+
     def annotations(self, annotations):
         self._annotations = annotations
         return self
@@ -287,6 +325,8 @@ class Step:
             y['volumes'] = self._volumes
         if self._terminator:
             y['terminator'] = True
+        if self._suspend:
+            y['suspend'] = True
         if self._annotations:
             # TODO - labels too please
             y['metadata'] = {
@@ -313,13 +353,18 @@ class ContainerStep(Step):
     def __init__(self, name=None, image=None, args=None, fifo=False, volumes=None, volumeMounts=None, sources=None,
                  sinks=None,
                  env=None, resources=None,
-                 terminator=False):
+                 terminator=False, maxBatchSize=None, maxBatchWait=None, grpc=False):
         super().__init__(name, sources=sources, sinks=sinks,
                          volumes=volumes, terminator=terminator)
         assert image
+        assert not (fifo and maxBatchSize)
+        assert not (grpc and (fifo or maxBatchSize))
         self._image = image
         self._args = args or []
         self._fifo = fifo
+        self._maxBatchSize = maxBatchSize
+        self._maxBatchWait = maxBatchWait
+        self._grpc = grpc
         self._volumeMounts = volumeMounts or []
         self._env = env
         self._resources = resources
@@ -333,6 +378,13 @@ class ContainerStep(Step):
             c['args'] = self._args
         if self._fifo:
             c['in'] = {'fifo': True}
+        if self._maxBatchSize:
+            h = {'maxBatchSize': self._maxBatchSize}
+            if self._maxBatchWait:
+                h['maxBatchWait'] = self._maxBatchWait
+            c['in'] = {'http': h}
+        if self._grpc:
+            c['in'] = {'grpc': {}}
         if len(self._volumeMounts) > 0:
             c['volumeMounts'] = self._volumeMounts
         if self._env:
@@ -345,12 +397,31 @@ class ContainerStep(Step):
 
 
 class DedupeStep(Step):
-    def __init__(self, name=None, sources=None, sinks=None):
-        super().__init__(name, sources=sources, sinks=sinks)
+    def __init__(self, name=None, uid=None, maxSize=None, ttl=None, storage=None, redis=None, sources=None,
+                 sinks=None):
+        super().__init__(name, sources=sources, sinks=sinks, volumes=storageVolumes(storage, DEDUPE_VOLUME_NAME))
+        self._uid = uid
+        self._maxSize = maxSize
+        self._ttl = ttl
+        self._storage = storage
+        self._redis = redis
 
     def dump(self):
         x = super().dump()
-        x['dedupe'] = {}
+        y = {}
+        if self._uid:
+            y['uid'] = self._uid
+        if self._maxSize:
+            y['maxSize'] = self._maxSize
+        if self._ttl:
+            y['ttl'] = self._ttl
+        if self._storage:
+            y['storage'] = {
+                'name': DEDUPE_VOLUME_NAME
+            }
+        if self._redis:
+            y['redis'] = self._redis
+        x['dedupe'] = y
         return x
 
 
@@ -408,15 +479,16 @@ class GitStep(Step):
         return x
 
 
-def storageVolumes(storage=None):
+def storageVolumes(storage=None, name=GROUPS_VOLUME_NAME):
     if storage:
-        storage['name'] = GROUPS_VOLUME_NAME
+        storage['name'] = name
         return [storage]
     return []
 
 
 class GroupStep(Step):
-    def __init__(self, name=None, key=None, format=None, endOfGroup=None, storage=None, sources=None, sinks=None):
+    def __init__(self, name=None, key=None, format=None, endOfGroup=None, storage=None, maxSize=None, maxAge=None,
+                 idleTimeout=None, sources=None, sinks=None):
         super().__init__(name, sources=sources, sinks=sinks, volumes=storageVolumes(storage))
         assert key
         assert format
@@ -425,6 +497,9 @@ class GroupStep(Step):
         self._format = format
         self._endOfGroup = endOfGroup
         self._storage = storage
+        self._maxSize = maxSize
+        self._maxAge = maxAge
+        self._idleTimeout = idleTimeout
 
     def dump(self):
         x = super().dump()
@@ -433,6 +508,12 @@ class GroupStep(Step):
             'format': self._format,
             'endOfGroup': self._endOfGroup,
         }
+        if self._maxSize:
+            y['maxSize'] = self._maxSize
+        if self._maxAge:
+            y['maxAge'] = self._maxAge
+        if self._idleTimeout:
+            y['idleTimeout'] = self._idleTimeout
         if self._storage:
             y['storage'] = {
                 'name': GROUPS_VOLUME_NAME
@@ -441,6 +522,82 @@ class GroupStep(Step):
         return x
 
 
+class WindowStep(Step):
+    def __init__(self, name=None, key=None, tumbling=None, sliding=None, session=None, eventTime=None,
+                 allowedLateness=None, format=None, storage=None, sources=None, sinks=None):
+        super().__init__(name, sources=sources, sinks=sinks, volumes=storageVolumes(storage, WINDOWS_VOLUME_NAME))
+        assert key
+        assert tumbling or sliding or session
+        self._key = key
+        self._tumbling = tumbling
+        self._sliding = sliding
+        self._session = session
+        self._eventTime = eventTime
+        self._allowedLateness = allowedLateness
+        self._format = format
+        self._storage = storage
+
+    def dump(self):
+        x = super().dump()
+        y = {
+            'key': self._key,
+        }
+        if self._tumbling:
+            y['tumbling'] = {'duration': self._tumbling}
+        if self._sliding:
+            y['sliding'] = self._sliding
+        if self._session:
+            y['session'] = {'gap': self._session}
+        if self._eventTime:
+            y['eventTime'] = self._eventTime
+        if self._allowedLateness:
+            y['allowedLateness'] = self._allowedLateness
+        if self._format:
+            y['format'] = self._format
+        if self._storage:
+            y['storage'] = {
+                'name': WINDOWS_VOLUME_NAME
+            }
+        x['window'] = y
+        return x
+
+
+class JoinStep(Step):
+    def __init__(self, name=None, left=None, leftKey=None, right=None, rightKey=None, window=None, type=None,
+                 template=None, storage=None, sinks=None):
+        super().__init__(name, sources=[left, right], sinks=sinks, volumes=storageVolumes(storage, JOINS_VOLUME_NAME))
+        # the sources must be named, so the messages from each side can be told apart
+        assert left and left._name
+        assert right and right._name
+        assert leftKey
+        assert rightKey
+        self._left = {'source': left._name, 'key': leftKey}
+        self._right = {'source': right._name, 'key': rightKey}
+        self._window = window
+        self._type = type
+        self._template = template
+        self._storage = storage
+
+    def dump(self):
+        x = super().dump()
+        y = {
+            'left': self._left,
+            'right': self._right,
+        }
+        if self._window:
+            y['window'] = self._window
+        if self._type:
+            y['type'] = self._type
+        if self._template:
+            y['template'] = self._template
+        if self._storage:
+            y['storage'] = {
+                'name': JOINS_VOLUME_NAME
+            }
+        x['join'] = y
+        return x
+
+
 class FlattenStep(Step):
     def __init__(self, name=None, sources=None, sinks=None):
         super().__init__(name, sources=sources, sinks=sinks)
@@ -493,9 +650,23 @@ class MapStep(Step):
 
 
 class Source:
-    def __init__(self, name=None, retry=None):
+    def __init__(self, name=None, retry=None, replay=None):
         self._name = name
         self._retry = retry
+        self._replay = replay
+        self._rateLimit = None
+        self._maxConcurrency = None
+
+    def rateLimit(self, messagesPerSecond, burst=None):
+        assert messagesPerSecond >= 1
+        self._rateLimit = {'messagesPerSecond': messagesPerSecond}
+        if burst:
+            self._rateLimit['burst'] = burst
+        return self
+
+    def maxConcurrency(self, maxConcurrency):
+        self._maxConcurrency = maxConcurrency
+        return self
 
     def dump(self):
         x = {}
@@ -503,6 +674,12 @@ class Source:
             x['name'] = self._name
         if self._retry:
             x['retry'] = self._retry
+        if self._replay is not None:
+            x['replay'] = self._replay
+        if self._rateLimit:
+            x['rateLimit'] = self._rateLimit
+        if self._maxConcurrency:
+            x['maxConcurrency'] = self._maxConcurrency
         return x
 
     def cat(self, name=None):
@@ -510,12 +687,13 @@ class Source:
 
     def container(self, name=None, image=None, args=None, fifo=False, volumes=None, volumeMounts=None, env=None,
                   resources=None,
-                  terminator=False):
+                  terminator=False, maxBatchSize=None, maxBatchWait=None, grpc=False):
         return ContainerStep(name, sources=[self], image=image, args=args, fifo=fifo, volumes=volumes,
-                             volumeMounts=volumeMounts, env=env, resources=resources, terminator=terminator)
+                             volumeMounts=volumeMounts, env=env, resources=resources, terminator=terminator,
+                             maxBatchSize=maxBatchSize, maxBatchWait=maxBatchWait, grpc=grpc)
 
-    def dedupe(self, name=None):
-        return DedupeStep(name, sources=[self])
+    def dedupe(self, name=None, uid=None, maxSize=None, ttl=None, storage=None, redis=None):
+        return DedupeStep(name, uid=uid, maxSize=maxSize, ttl=ttl, storage=storage, redis=redis, sources=[self])
 
     def expand(self, name=None):
         return ExpandStep(name, sources=[self])
@@ -526,8 +704,10 @@ class Source:
     def git(self, name=None, url=None, branch=None, path=None, image=None, env=None, command=None):
         return GitStep(name, url, branch, path, image, sources=[self], env=env, command=command)
 
-    def group(self, name=None, key=None, format=None, endOfGroup=None, storage=None):
-        return GroupStep(name, key, format, endOfGroup, storage, sources=[self])
+    def group(self, name=None, key=None, format=None, endOfGroup=None, storage=None, maxSize=None, maxAge=None,
+              idleTimeout=None):
+        return GroupStep(name, key, format, endOfGroup, storage, maxSize=maxSize, maxAge=maxAge,
+                         idleTimeout=idleTimeout, sources=[self])
 
     def flatten(self, name=None):
         return FlattenStep(name, sources=[self])
@@ -538,19 +718,25 @@ class Source:
     def map(self, name=None, expression=None):
         return MapStep(name, expression, sources=[self])
 
+    def window(self, name=None, key=None, tumbling=None, sliding=None, session=None, eventTime=None,
+               allowedLateness=None, format=None, storage=None):
+        return WindowStep(name, key, tumbling=tumbling, sliding=sliding, session=session, eventTime=eventTime,
+                          allowedLateness=allowedLateness, format=format, storage=storage, sources=[self])
+
 
 def cat(name=None):
     return CatStep(name)
 
 
 def container(name=None, image=None, args=None, fifo=False, volumes=None, volumeMounts=None, env=None, resources=None,
-              terminator=False):
+              terminator=False, maxBatchSize=None, maxBatchWait=None, grpc=False):
     return ContainerStep(name, terminator=terminator, image=image, args=args, fifo=fifo, volumes=volumes,
-                         volumeMounts=volumeMounts, env=env, resources=resources)
+                         volumeMounts=volumeMounts, env=env, resources=resources, maxBatchSize=maxBatchSize,
+                         maxBatchWait=maxBatchWait, grpc=grpc)
 
 
-def dedupe(name=None):
-    return DedupeStep(name)
+def dedupe(name=None, uid=None, maxSize=None, ttl=None, storage=None, redis=None):
+    return DedupeStep(name, uid=uid, maxSize=maxSize, ttl=ttl, storage=storage, redis=redis)
 
 
 def expand(name=None):
@@ -565,14 +751,20 @@ def git(name=None, url=None, branch=None, path=None, image=None, env=None, comma
     return GitStep(name, url, branch, path, image, env=env, command=command)
 
 
-def group(name=None, key=None, format=None, endOfGroup=None, storage=None):
-    return GroupStep(name, key, format, endOfGroup, storage)
+def group(name=None, key=None, format=None, endOfGroup=None, storage=None, maxSize=None, maxAge=None,
+          idleTimeout=None):
+    return GroupStep(name, key, format, endOfGroup, storage, maxSize=maxSize, maxAge=maxAge, idleTimeout=idleTimeout)
 
 
 def flatten(name=None):
     return FlattenStep(name)
 
 
+def join(name=None, left=None, leftKey=None, right=None, rightKey=None, window=None, type=None, template=None,
+         storage=None):
+    return JoinStep(name, left, leftKey, right, rightKey, window=window, type=type, template=template, storage=storage)
+
+
 def handler(name=None, handler=None, code=None, runtime=None):
     return CodeStep(name, handler, code, runtime)
 
@@ -581,6 +773,12 @@ def map(name=None, map=None):
     return MapStep(name, map)
 
 
+def window(name=None, key=None, tumbling=None, sliding=None, session=None, eventTime=None, allowedLateness=None,
+           format=None, storage=None):
+    return WindowStep(name, key, tumbling=tumbling, sliding=sliding, session=session, eventTime=eventTime,
+                      allowedLateness=allowedLateness, format=format, storage=storage)
+
+
 class CronSource(Source):
     def __init__(self, schedule=None, layout=None, name=None, retry=None):
         super().__init__(name=name, retry=retry)
@@ -598,32 +796,44 @@ class CronSource(Source):
 
 
 class HTTPSource(Source):
-    def __init__(self, name=None, retry=None, serviceName=None):
-        super().__init__(name=name, retry=retry)
+    def __init__(self, name=None, retry=None, serviceName=None, cloudEvents=None, replay=None):
+        super().__init__(name=name, retry=retry, replay=replay)
         self._serviceName = serviceName
+        self._cloudEvents = cloudEvents
 
     def dump(self):
         x = super().dump()
         h = {}
         if self._serviceName:
             h['serviceName'] = self._serviceName
+        if self._cloudEvents:
+            h['cloudEvents'] = self._cloudEvents
         x['http'] = h
         return x
 
 
 class KafkaSource(Source):
-    def __init__(self, topic, name=None, retry=None, startOffset=None, fetchMin=None, fetchWaitMax=None, groupId=None):
-        super().__init__(name=name, retry=retry)
-        assert topic
+    def __init__(self, topic, name=None, retry=None, startOffset=None, fetchMin=None, fetchWaitMax=None, groupId=None,
+                 topics=None, schemaRegistry=None, replay=None, concurrency=None, keyOrdered=False):
+        super().__init__(name=name, retry=retry, replay=replay)
+        assert topic or topics
         self._topic = topic
+        self._topics = topics
+        self._schemaRegistry = schemaRegistry
         self._startOffset = startOffset
         self._fetchMin = fetchMin
         self._fetchWaitMax = fetchWaitMax
         self._groupId = groupId
+        self._concurrency = concurrency
+        self._keyOrdered = keyOrdered
 
     def dump(self):
         x = super().dump()
-        y = {'topic': self._topic}
+        y = {}
+        if self._topic:
+            y['topic'] = self._topic
+        if self._topics:
+            y['topics'] = self._topics
         if self._startOffset:
             y["startOffset"] = self._startOffset
         if self._fetchMin:
@@ -632,6 +842,12 @@ class KafkaSource(Source):
             y["fetchWaitMax"] = self._fetchWaitMax
         if self._groupId:
             y["groupId"] = self._groupId
+        if self._schemaRegistry:
+            y["schemaRegistry"] = self._schemaRegistry
+        if self._concurrency:
+            y["concurrency"] = self._concurrency
+        if self._keyOrdered:
+            y["keyOrdered"] = True
         x['kafka'] = y
         return x
 
@@ -650,8 +866,8 @@ class STANSource(Source):
 
 
 class JetStreamSource(Source):
-    def __init__(self, subject, name=None, retry=None):
-        super().__init__(name=name, retry=retry)
+    def __init__(self, subject, name=None, retry=None, replay=None):
+        super().__init__(name=name, retry=retry, replay=replay)
         assert subject
         self._subject = subject
 
@@ -666,18 +882,20 @@ def cron(schedule=None, layout=None, name=None, retry=None):
     return CronSource(schedule, layout=layout, name=name, retry=retry)
 
 
-def http(name=None, retry=None, serviceName=None):
-    return HTTPSource(name=name, serviceName=serviceName, retry=retry)
+def http(name=None, retry=None, serviceName=None, cloudEvents=None, replay=None):
+    return HTTPSource(name=name, serviceName=serviceName, retry=retry, cloudEvents=cloudEvents, replay=replay)
 
 
-def kafka(topic=None, name=None, retry=None, startOffset=None, fetchMin=None, fetchWaitMax=None, groupId=None):
+def kafka(topic=None, name=None, retry=None, startOffset=None, fetchMin=None, fetchWaitMax=None, groupId=None,
+          topics=None, schemaRegistry=None, replay=None, concurrency=None, keyOrdered=False):
     return KafkaSource(topic, name=name, retry=retry, startOffset=startOffset, fetchMin=fetchMin,
-                       fetchWaitMax=fetchWaitMax, groupId=groupId)
+                       fetchWaitMax=fetchWaitMax, groupId=groupId, topics=topics, schemaRegistry=schemaRegistry,
+                       replay=replay, concurrency=concurrency, keyOrdered=keyOrdered)
 
 
 def stan(subject=None, name=None, retry=None):
     return STANSource(subject, name=name, retry=retry)
 
 
-def jetstream(subject=None, name=None, retry=None):
-    return JetStreamSource(subject, name, retry=retry)
+def jetstream(subject=None, name=None, retry=None, replay=None):
+    return JetStreamSource(subject, name, retry=retry, replay=replay)
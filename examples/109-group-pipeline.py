@@ -14,6 +14,12 @@ There are four mandatory fields:
 * `format` What format the grouped messages should be in.
 * `storage` Where to store messages ready to be forwarded.
 
+There are three optional fields, which send the group onwards even if the end of group is never seen:
+
+* `maxSize` The maximum number of messages in a group.
+* `maxAge` The maximum time since the first message was added to the group.
+* `idleTimeout` The maximum time since the last message was added to the group.
+
 [Learn about expressions](../docs/EXPRESSIONS.md)
 
 ### Storage
@@ -77,14 +77,33 @@ func (r *PipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 
 	log.Info("reconciling")
 
+	if pipeline.Spec.Suspended {
+		// we must record each step's replicas before we scale it to zero, otherwise they would be lost
+		if recorded, err := r.recordSuspendedReplicas(ctx, pipeline); err != nil || recorded {
+			return ctrl.Result{}, err
+		}
+	}
+
 	for _, step := range pipeline.Spec.Steps {
 		stepFullName := pipeline.Name + "-" + step.Name
 		matchLabels := map[string]string{dfv1.KeyPipelineName: pipeline.Name, dfv1.KeyStepName: step.Name}
+		suspendedReplicas, restoring := pipeline.Status.SuspendedReplicas[step.Name]
+		restoring = restoring && !pipeline.Spec.Suspended
+		if pipeline.Spec.Suspended {
+			step.Replicas = 0
+		} else if restoring {
+			step.Replicas = suspendedReplicas
+		}
+		annotations := map[string]string{}
+		if pipeline.Spec.Suspended {
+			annotations[dfv1.KeySuspended] = "true"
+		}
 		obj := &dfv1.Step{
 			ObjectMeta: metav1.ObjectMeta{
-				Namespace: pipeline.Namespace,
-				Name:      stepFullName,
-				Labels:    matchLabels,
+				Namespace:   pipeline.Namespace,
+				Name:        stepFullName,
+				Labels:      matchLabels,
+				Annotations: annotations,
 				OwnerReferences: []metav1.OwnerReference{
 					*metav1.NewControllerRef(pipeline.GetObjectMeta(), dfv1.PipelineGroupVersionKind),
 				},
@@ -97,12 +116,23 @@ func (r *PipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 				if err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), old); err != nil {
 					return ctrl.Result{}, err
 				}
-				step.Replicas = old.Spec.Replicas // copy this field as it should only be modified by `kubectl scale`, edited by the user
-				if notEqual, patch := util.NotEqual(step, old.Spec); notEqual {
-					log.Info("updating step due to changed spec", "patch", patch)
+				if !pipeline.Spec.Suspended && !restoring {
+					step.Replicas = old.Spec.Replicas // copy this field as it should only be modified by `kubectl scale`, edited by the user
+				}
+				notEqual, patch := util.NotEqual(step, old.Spec)
+				if notEqual || old.GetAnnotations()[dfv1.KeySuspended] != annotations[dfv1.KeySuspended] {
+					log.Info("updating step due to changed spec", "patch", patch, "suspended", pipeline.Spec.Suspended)
 					old.Spec = step
-					if err := r.Client.Update(ctx, old); util.IgnoreConflict(err) != nil { // ignore conflicts, we will be reconciling again shortly if this happens
-						return ctrl.Result{}, err
+					if pipeline.Spec.Suspended {
+						metav1.SetMetaDataAnnotation(&old.ObjectMeta, dfv1.KeySuspended, "true")
+					} else {
+						delete(old.Annotations, dfv1.KeySuspended)
+					}
+					if err := r.Client.Update(ctx, old); err != nil {
+						if restoring || !apierr.IsConflict(err) { // we must not forget the replicas, so try again rather than ignore the conflict
+							return ctrl.Result{}, err
+						}
+						// ignore conflicts, we will be reconciling again shortly if this happens
 					}
 				}
 			} else {
@@ -151,6 +181,12 @@ func (r *PipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 
 	if newStatus.Phase.Completed() {
 		terminate = false
+	} else if pipeline.Spec.Suspended {
+		newStatus.Phase = dfv1.PipelineSuspended
+	}
+
+	if !pipeline.Spec.Suspended {
+		newStatus.SuspendedReplicas = nil // every step's replicas have been restored
 	}
 
 	var ss []string
@@ -167,6 +203,9 @@ func (r *PipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	if terminate {
 		ss = append(ss, "terminating")
 	}
+	if pipeline.Spec.Suspended {
+		ss = append(ss, "suspended")
+	}
 
 	newStatus.Message = strings.Join(ss, ", ")
 
@@ -174,6 +213,7 @@ func (r *PipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		dfv1.ConditionRunning:     newStatus.Phase == dfv1.PipelineRunning,
 		dfv1.ConditionCompleted:   newStatus.Phase.Completed(),
 		dfv1.ConditionTerminating: terminate,
+		dfv1.ConditionSuspended:   newStatus.Phase == dfv1.PipelineSuspended,
 	} {
 		if ok {
 			meta.SetStatusCondition(&newStatus.Conditions, metav1.Condition{Type: c, Status: metav1.ConditionTrue, Reason: c})
@@ -213,6 +253,38 @@ func (r *PipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	return ctrl.Result{}, nil
 }
 
+// recordSuspendedReplicas records the replicas of each step that does not have them recorded, returning true if the
+// status was updated.
+func (r *PipelineReconciler) recordSuspendedReplicas(ctx context.Context, pipeline *dfv1.Pipeline) (bool, error) {
+	suspendedReplicas := map[string]uint32{}
+	for stepName, replicas := range pipeline.Status.SuspendedReplicas {
+		suspendedReplicas[stepName] = replicas
+	}
+	for _, step := range pipeline.Spec.Steps {
+		if _, ok := suspendedReplicas[step.Name]; ok {
+			continue
+		}
+		old := &dfv1.Step{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: pipeline.Namespace, Name: pipeline.Name + "-" + step.Name}, old); apierr.IsNotFound(err) {
+			suspendedReplicas[step.Name] = step.Replicas
+		} else if err != nil {
+			return false, err
+		} else {
+			suspendedReplicas[step.Name] = old.Spec.Replicas
+		}
+	}
+	if len(suspendedReplicas) == len(pipeline.Status.SuspendedReplicas) {
+		return false, nil
+	}
+	r.Log.Info("recording suspended replicas", "pipeline", pipeline.Namespace+"/"+pipeline.Name, "suspendedReplicas", suspendedReplicas)
+	pipeline.Status.SuspendedReplicas = suspendedReplicas
+	pipeline.Status.LastUpdated = metav1.Now()
+	if err := r.Status().Update(ctx, pipeline); util.IgnoreConflict(err) != nil { // conflict is ok, we will reconcile again soon
+		return false, fmt.Errorf("failed to update status: %w", err)
+	}
+	return true, nil
+}
+
 func (r *PipelineReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&dfv1.Pipeline{}).
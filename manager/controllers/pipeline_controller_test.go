@@ -55,4 +55,63 @@ var _ = Describe("Pipeline controller", func() {
 				Should(Succeed())
 		})
 	})
+
+	Context("When suspending pipeline", func() {
+		It("Should scale steps to zero, and then restore them", func() {
+			By("By creating a new Pipeline with two replicas")
+			ctx := context.Background()
+
+			p := &dfv1.Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-suspended-pipeline",
+					Namespace: Namespace,
+				},
+				Spec: dfv1.PipelineSpec{
+					Steps: []dfv1.StepSpec{
+						{
+							Name:     "my-step",
+							Replicas: 2,
+							Container: &dfv1.Container{
+								Image: "docker/whalesay",
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, p)).Should(Succeed())
+
+			replicas := func() (uint32, error) {
+				step := &dfv1.Step{}
+				err := k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: "my-suspended-pipeline-my-step"}, step)
+				return step.Spec.Replicas, err
+			}
+			Eventually(replicas).Should(Equal(uint32(2)))
+
+			// the status is updated concurrently, so retry on conflict
+			setSuspended := func(suspended bool) func() error {
+				return func() error {
+					if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(p), p); err != nil {
+						return err
+					}
+					p.Spec.Suspended = suspended
+					return k8sClient.Update(ctx, p)
+				}
+			}
+
+			By("By suspending the Pipeline")
+			Eventually(setSuspended(true)).Should(Succeed())
+			Eventually(replicas).Should(Equal(uint32(0)))
+			Eventually(func() (dfv1.PipelineStatus, error) {
+				err := k8sClient.Get(ctx, client.ObjectKeyFromObject(p), p)
+				return p.Status, err
+			}).Should(And(
+				HaveField("Phase", dfv1.PipelineSuspended),
+				HaveField("SuspendedReplicas", map[string]uint32{"my-step": 2}),
+			))
+
+			By("By resuming the Pipeline")
+			Eventually(setSuspended(false)).Should(Succeed())
+			Eventually(replicas).Should(Equal(uint32(2)))
+		})
+	})
 })
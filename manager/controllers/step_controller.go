@@ -61,7 +61,7 @@ type hash struct {
 
 // +kubebuilder:rbac:groups=dataflow.argoproj.io,resources=steps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=dataflow.argoproj.io,resources=steps/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups=,resources=pods,verbs=get;watch;list;create
+// +kubebuilder:rbac:groups=,resources=pods,verbs=get;watch;list;create;patch
 // +kubebuilder:rbac:groups=,resources=services,verbs=get;watch;list;create
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 func (r *StepReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -90,7 +90,7 @@ func (r *StepReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	log.Info("reconciling")
 
 	currentReplicas := int(step.Status.Replicas)
-	if step.Spec.Scale.DesiredReplicas != "" {
+	if step.Spec.Scale.DesiredReplicas != "" && step.GetAnnotations()[dfv1.KeySuspended] != "true" { // a suspended pipeline's steps must stay scaled to zero
 		if err := r.startMetricsCacheLoop(step); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to start metrics cache loop: %w", err)
 		}
@@ -122,7 +122,10 @@ func (r *StepReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		}
 	}
 
-	desiredReplicas := int(step.Spec.Replicas)
+	desiredReplicas := step.Spec.GetReplicas()
+	if desiredReplicas != int(step.Spec.Replicas) {
+		r.Recorder.Eventf(step, "Warning", "TooManyReplicas", "Step cannot have %d replicas, running %d", step.Spec.Replicas, desiredReplicas)
+	}
 
 	oldStatus := step.Status.DeepCopy()
 	if currentReplicas != desiredReplicas || step.Status.Selector == "" {
@@ -156,6 +159,7 @@ func (r *StepReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		_labels[dfv1.KeyPipelineName] = pipelineName
 		annotations[dfv1.KeyReplica] = strconv.Itoa(replica)
 		annotations[dfv1.KeyHash] = hash
+		annotations[dfv1.KeySuspend] = strconv.FormatBool(step.Spec.Suspend)
 		annotations[dfv1.KeyDefaultContainer] = dfv1.CtrMain
 		annotations[dfv1.KeyKillCmd(dfv1.CtrMain)] = util.MustJSON([]string{dfv1.PathKill, "1"})
 		annotations[dfv1.KeyKillCmd(dfv1.CtrSidecar)] = util.MustJSON([]string{dfv1.PathKill, "1"})
@@ -247,6 +251,14 @@ func (r *StepReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 				step.Status.Phase, step.Status.Reason, step.Status.Message = x.GetPhase(), x.GetReason(), x.GetMessage()
 			}
 		} else {
+			// the sidecar watches this annotation, so the pod does not need to be re-created
+			if suspend := strconv.FormatBool(step.Spec.Suspend); pod.GetAnnotations()[dfv1.KeySuspend] != suspend {
+				log.Info("patching pod", "podName", pod.Name, "suspend", suspend)
+				patch := util.MustJSON(map[string]interface{}{"metadata": map[string]interface{}{"annotations": map[string]string{dfv1.KeySuspend: suspend}}})
+				if err := r.Client.Patch(ctx, &pod, client.RawPatch(types.MergePatchType, []byte(patch))); client.IgnoreNotFound(err) != nil {
+					return ctrl.Result{}, fmt.Errorf("failed to patch pod %q: %w", pod.Name, err)
+				}
+			}
 			phase, reason, message := inferPhase(pod)
 			x := dfv1.MinStepPhaseMessage(dfv1.NewStepPhaseMessage(step.Status.Phase, step.Status.Reason, step.Status.Message), dfv1.NewStepPhaseMessage(phase, reason, message))
 			step.Status.Phase, step.Status.Reason, step.Status.Message = x.GetPhase(), x.GetReason(), x.GetMessage()
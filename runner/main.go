@@ -9,11 +9,16 @@ import (
 	"net/http/pprof"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strconv"
 	"syscall"
+	"time"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
 	_init "github.com/argoproj-labs/argo-dataflow/runner/init"
+	"github.com/argoproj-labs/argo-dataflow/runner/replay"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar"
+	"github.com/argoproj-labs/argo-dataflow/runner/tap"
 	"github.com/argoproj-labs/argo-dataflow/sdks/golang"
 	"github.com/argoproj-labs/argo-dataflow/shared/builtin"
 	"github.com/argoproj-labs/argo-dataflow/shared/builtin/cat"
@@ -22,7 +27,9 @@ import (
 	"github.com/argoproj-labs/argo-dataflow/shared/builtin/filter"
 	"github.com/argoproj-labs/argo-dataflow/shared/builtin/flatten"
 	"github.com/argoproj-labs/argo-dataflow/shared/builtin/group"
+	"github.com/argoproj-labs/argo-dataflow/shared/builtin/join"
 	_map "github.com/argoproj-labs/argo-dataflow/shared/builtin/map"
+	"github.com/argoproj-labs/argo-dataflow/shared/builtin/window"
 	"github.com/argoproj-labs/argo-dataflow/shared/debug"
 	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -64,7 +71,37 @@ func main() {
 			if err != nil {
 				return fmt.Errorf("failed to parse %q as resource quanity: %w", x, err)
 			}
-			p, err := dedupe.New(ctx, os.Args[2], maxSize)
+			ttl, err := time.ParseDuration(os.Args[4])
+			if err != nil {
+				return fmt.Errorf("failed to parse %q as duration: %w", os.Args[4], err)
+			}
+			var db dedupe.Store
+			switch os.Args[5] {
+			case "disk":
+				// each replica has its own file, as the storage volume maybe shared between replicas
+				if db, err = dedupe.NewDiskStore(filepath.Join(dfv1.PathDedupe, os.Getenv(dfv1.EnvReplica)+".db"), ttl); err != nil {
+					return err
+				}
+			case "redis":
+				n, err := strconv.Atoi(os.Args[7])
+				if err != nil {
+					return fmt.Errorf("failed to parse %q as int: %w", os.Args[7], err)
+				}
+				keyPrefix := os.Args[8]
+				if keyPrefix == "" {
+					step := dfv1.Step{}
+					sharedutil.MustUnJSON(os.Getenv(dfv1.EnvStep), &step)
+					keyPrefix = fmt.Sprintf("dataflow:dedupe:%s/%s:", step.Namespace, step.Name)
+				}
+				db = dedupe.NewRedisStore(os.Args[6], os.Getenv(dfv1.EnvRedisPassword), n, keyPrefix, ttl)
+			default:
+				int64MaxSize, ok := maxSize.AsInt64()
+				if !ok {
+					return fmt.Errorf("max size %v must be int64", maxSize)
+				}
+				db = dedupe.NewMemoryStore(int(int64MaxSize))
+			}
+			p, err := dedupe.New(ctx, os.Args[2], db)
 			if err != nil {
 				return err
 			}
@@ -81,21 +118,68 @@ func main() {
 		case "flatten":
 			return start(flatten.New())
 		case "group":
-			p, err := group.New(dfv1.PathGroups, os.Args[2], os.Args[3], dfv1.GroupFormat(os.Args[4]))
+			maxSize, err := strconv.Atoi(os.Args[5])
+			if err != nil {
+				return fmt.Errorf("failed to parse %q as int: %w", os.Args[5], err)
+			}
+			maxAge, err := time.ParseDuration(os.Args[6])
+			if err != nil {
+				return fmt.Errorf("failed to parse %q as duration: %w", os.Args[6], err)
+			}
+			idleTimeout, err := time.ParseDuration(os.Args[7])
+			if err != nil {
+				return fmt.Errorf("failed to parse %q as duration: %w", os.Args[7], err)
+			}
+			p, err := group.New(ctx, dfv1.PathGroups, os.Args[2], os.Args[3], dfv1.GroupFormat(os.Args[4]), maxSize, maxAge, idleTimeout, golang.SendMessage)
 			if err != nil {
 				return err
 			}
+			http.Handle("/metrics", promhttp.Handler())
 			return start(p)
 		case "init":
 			return _init.Exec(ctx)
+		case "join":
+			window, err := time.ParseDuration(os.Args[6])
+			if err != nil {
+				return fmt.Errorf("failed to parse %q as duration: %w", os.Args[6], err)
+			}
+			// each replica has its own buffers, as the storage volume maybe shared between replicas
+			dir := filepath.Join(dfv1.PathJoins, os.Getenv(dfv1.EnvReplica))
+			p, err := join.New(ctx, dir, os.Args[2], os.Args[3], os.Args[4], os.Args[5], window, dfv1.JoinType(os.Args[7]), os.Args[8], golang.SendMessage)
+			if err != nil {
+				return err
+			}
+			http.Handle("/metrics", promhttp.Handler())
+			return start(p)
 		case "map":
 			p, err := _map.New(os.Args[2])
 			if err != nil {
 				return err
 			}
 			return start(p)
+		case "replay":
+			return replay.Exec(ctx, os.Args[2:])
 		case "sidecar":
 			return sidecar.Exec(ctx)
+		case "tap":
+			return tap.Exec(ctx, os.Args[2:])
+		case "window":
+			var durations []time.Duration
+			for _, x := range os.Args[5:8] {
+				d, err := time.ParseDuration(x)
+				if err != nil {
+					return fmt.Errorf("failed to parse %q as duration: %w", x, err)
+				}
+				durations = append(durations, d)
+			}
+			// each replica has its own windows, as the storage volume maybe shared between replicas
+			dir := filepath.Join(dfv1.PathWindows, os.Getenv(dfv1.EnvReplica))
+			p, err := window.New(ctx, dir, os.Args[2], os.Args[3], os.Args[4], durations[0], durations[1], durations[2], dfv1.GroupFormat(os.Args[8]), golang.SendMessage)
+			if err != nil {
+				return err
+			}
+			http.Handle("/metrics", promhttp.Handler())
+			return start(p)
 		default:
 			return fmt.Errorf("unknown comand")
 		}
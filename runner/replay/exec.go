@@ -0,0 +1,138 @@
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var logger = sharedutil.NewLogger()
+
+// Progress is how many dead letters have been read, and what happened to them
+type Progress struct {
+	Read     int `json:"read"`
+	Replayed int `json:"replayed"`
+	Skipped  int `json:"skipped"`
+}
+
+// Exec is the "replay" command. It reads dead letters, one per line, and posts those that match to a HTTP source with
+// `replay` set, that replays them into its step.
+func Exec(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	url := flags.String("url", "", "the HTTP source's URL, e.g. http://my-pipeline-my-step/sources/default")
+	authorization := flags.String("authorization", os.Getenv("AUTHORIZATION"), "the HTTP source's authorization, defaults to $AUTHORIZATION")
+	file := flags.String("file", "-", "the file to read dead letters from, one per line, or - for stdin")
+	offset := flags.Int("offset", 0, "the number of dead letters to skip, e.g. to resume a replay that stopped")
+	errors := flags.String("errors", "", "a regular expression, only dead letters whose error matches are replayed")
+	since := flags.String("since", "", "only replay dead letters that last failed at, or after, this RFC3339 time")
+	until := flags.String("until", "", "only replay dead letters that last failed before this RFC3339 time")
+	insecureSkipVerify := flags.Bool("insecure-skip-verify", false, "do not verify the HTTP source's certificate, which is self-signed")
+	messagesPerSecond := flags.Uint("messages-per-second", 0, "the maximum rate to replay at, 0 is unlimited")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *url == "" {
+		return fmt.Errorf("--url is required")
+	}
+	x := dfv1.Replay{Errors: *errors, MessagesPerSecond: uint32(*messagesPerSecond)}
+	for _, y := range []struct {
+		v string
+		t **metav1.Time
+	}{{*since, &x.Since}, {*until, &x.Until}} {
+		if y.v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, y.v)
+		if err != nil {
+			return fmt.Errorf("failed to parse %q as RFC3339: %w", y.v, err)
+		}
+		*y.t = &metav1.Time{Time: t}
+	}
+	r, err := New(x)
+	if err != nil {
+		return err
+	}
+	in := os.Stdin
+	if *file != "-" {
+		if in, err = os.Open(*file); err != nil {
+			return err
+		}
+		defer func() { _ = in.Close() }()
+	}
+	client := &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: *insecureSkipVerify}},
+	}
+	post := func(ctx context.Context, msg []byte) error {
+		req, err := http.NewRequestWithContext(ctx, "POST", *url, bytes.NewBuffer(msg))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", *authorization)
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			return fmt.Errorf("failed to replay: %q %q", resp.Status, body)
+		}
+		return nil
+	}
+	p, err := run(ctx, in, *offset, r, post)
+	logger.Info("replay progress", "read", p.Read, "replayed", p.Replayed, "skipped", p.Skipped)
+	if err != nil {
+		return fmt.Errorf("failed to replay dead letter %d, use --offset=%d to resume: %w", p.Read, p.Read-1, err)
+	}
+	return nil
+}
+
+// run posts each dead letter that matches, after the first offset ones
+func run(ctx context.Context, in io.Reader, offset int, r *Replayer, post func(context.Context, []byte) error) (Progress, error) {
+	p := Progress{}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, 16*1024*1024) // dead letters may be large
+	for scanner.Scan() {
+		msg := scanner.Bytes()
+		if len(bytes.TrimSpace(msg)) == 0 {
+			continue
+		}
+		p.Read++
+		if p.Read <= offset {
+			p.Skipped++
+			continue
+		}
+		d, err := Decode(msg)
+		if err != nil {
+			return p, err
+		}
+		if !r.Match(d) {
+			p.Skipped++
+			continue
+		}
+		if err := r.Wait(ctx); err != nil {
+			return p, err
+		}
+		if err := post(ctx, msg); err != nil {
+			return p, err
+		}
+		p.Replayed++
+		if p.Replayed%100 == 0 {
+			logger.Info("replay progress", "read", p.Read, "replayed", p.Replayed, "skipped", p.Skipped)
+		}
+	}
+	return p, scanner.Err()
+}
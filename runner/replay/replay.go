@@ -0,0 +1,59 @@
+// Package replay replays dead letters, messages a DLQ sink sent in the Envelope format, once the cause of their failure
+// has been fixed.
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"golang.org/x/time/rate"
+)
+
+// Replayer filters and rate limits dead letters
+type Replayer struct {
+	x       dfv1.Replay
+	errors  *regexp.Regexp // nil if all errors are replayed
+	limiter *rate.Limiter
+}
+
+func New(x dfv1.Replay) (*Replayer, error) {
+	r := &Replayer{x: x, limiter: rate.NewLimiter(rate.Inf, 0)}
+	if x.Errors != "" {
+		var err error
+		if r.errors, err = regexp.Compile(x.Errors); err != nil {
+			return nil, fmt.Errorf("failed to compile errors %q: %w", x.Errors, err)
+		}
+	}
+	if x.MessagesPerSecond > 0 {
+		r.limiter = rate.NewLimiter(rate.Limit(x.MessagesPerSecond), 1)
+	}
+	return r, nil
+}
+
+// Decode returns the dead letter in a message
+func Decode(msg []byte) (dfv1.DeadLetter, error) {
+	d := dfv1.DeadLetter{}
+	if err := json.Unmarshal(msg, &d); err != nil {
+		return d, fmt.Errorf("failed to unmarshal dead letter: %w", err)
+	}
+	if d.Meta.ID == "" {
+		return d, fmt.Errorf("dead letter must have meta-data, is it in the Envelope format?")
+	}
+	return d, nil
+}
+
+// Match returns true if the dead letter should be replayed
+func (r *Replayer) Match(d dfv1.DeadLetter) bool {
+	if r.errors != nil && !r.errors.MatchString(d.Error) {
+		return false
+	}
+	return r.x.Match(d)
+}
+
+// Wait blocks until the next message may be replayed
+func (r *Replayer) Wait(ctx context.Context) error {
+	return r.limiter.Wait(ctx)
+}
@@ -0,0 +1,90 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deadLetter(t *testing.T, err string, sec int64) string {
+	data, marshalErr := json.Marshal(dfv1.DeadLetter{
+		Error:           err,
+		LastFailureTime: metav1.NewTime(time.Unix(sec, 0)),
+		Meta:            dfv1.Meta{Source: "my-source", ID: fmt.Sprintf("my-id-%d", sec)},
+		Data:            []byte("my-data"),
+	})
+	assert.NoError(t, marshalErr)
+	return string(data)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(dfv1.Replay{Errors: "("})
+	assert.Error(t, err)
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := Decode([]byte("my-data"))
+		assert.Error(t, err)
+	})
+	t.Run("Raw", func(t *testing.T) {
+		_, err := Decode([]byte(`{"foo": "bar"}`))
+		assert.EqualError(t, err, "dead letter must have meta-data, is it in the Envelope format?")
+	})
+	t.Run("Envelope", func(t *testing.T) {
+		d, err := Decode([]byte(deadLetter(t, "my-error", 1)))
+		assert.NoError(t, err)
+		assert.Equal(t, "my-error", d.Error)
+		assert.Equal(t, "my-id-1", d.Meta.ID)
+		assert.Equal(t, "my-data", string(d.Data))
+	})
+}
+
+func TestReplayer_Match(t *testing.T) {
+	since := metav1.NewTime(time.Unix(10, 0))
+	r, err := New(dfv1.Replay{Errors: "timeout", Since: &since})
+	assert.NoError(t, err)
+	assert.True(t, r.Match(dfv1.DeadLetter{Error: "i/o timeout", LastFailureTime: since}))
+	assert.False(t, r.Match(dfv1.DeadLetter{Error: "bad request", LastFailureTime: since}))
+	assert.False(t, r.Match(dfv1.DeadLetter{Error: "i/o timeout"}))
+}
+
+func Test_run(t *testing.T) {
+	in := strings.Join([]string{
+		deadLetter(t, "timeout", 1),
+		"",
+		deadLetter(t, "bad request", 2),
+		deadLetter(t, "timeout", 3),
+		deadLetter(t, "timeout", 4),
+	}, "\n")
+	r, err := New(dfv1.Replay{Errors: "timeout"})
+	assert.NoError(t, err)
+	t.Run("Offset", func(t *testing.T) {
+		var ids []string
+		p, err := run(context.Background(), strings.NewReader(in), 1, r, func(ctx context.Context, msg []byte) error {
+			d, err := Decode(msg)
+			ids = append(ids, d.Meta.ID)
+			return err
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, Progress{Read: 4, Replayed: 2, Skipped: 2}, p)
+		assert.Equal(t, []string{"my-id-3", "my-id-4"}, ids)
+	})
+	t.Run("Error", func(t *testing.T) {
+		p, err := run(context.Background(), strings.NewReader(in), 0, r, func(ctx context.Context, msg []byte) error {
+			if strings.Contains(string(msg), "my-id-3") {
+				return fmt.Errorf("failed")
+			}
+			return nil
+		})
+		assert.EqualError(t, err, "failed")
+		assert.Equal(t, Progress{Read: 3, Replayed: 1, Skipped: 1}, p)
+	})
+}
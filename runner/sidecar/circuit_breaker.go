@@ -0,0 +1,166 @@
+package sidecar
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+)
+
+var errCircuitOpen = errors.New("circuit breaker open")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitHalfOpen
+	circuitOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "closed"
+	case circuitHalfOpen:
+		return "half-open"
+	default:
+		return "open"
+	}
+}
+
+// circuitBreaker is a sink's circuit breaker, see dfv1.CircuitBreaker
+type circuitBreaker struct {
+	failureThreshold uint32
+	openDuration     time.Duration
+	halfOpenProbes   uint32
+	now              func() time.Time
+	onStateChange    func(circuitState)
+	mu               sync.Mutex
+	state            circuitState
+	failures         uint32 // consecutive failures, while closed
+	probes           uint32 // probes allowed, while half-open
+	successes        uint32 // successful probes, while half-open
+	openedAt         time.Time
+}
+
+func newCircuitBreaker(x dfv1.CircuitBreaker, onStateChange func(circuitState)) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: x.GetFailureThreshold(),
+		openDuration:     x.GetOpenDuration(),
+		halfOpenProbes:   x.GetHalfOpenProbes(),
+		now:              time.Now,
+		onStateChange:    onStateChange,
+	}
+}
+
+// must be called with the lock held
+func (b *circuitBreaker) setState(s circuitState) {
+	b.state, b.failures, b.probes, b.successes = s, 0, 0, 0
+	if s == circuitOpen {
+		b.openedAt = b.now()
+	}
+	b.onStateChange(s)
+}
+
+// must be called with the lock held, half-opens the breaker if it has been open long enough, and returns how long it
+// will remain open
+func (b *circuitBreaker) remaining() time.Duration {
+	if b.state != circuitOpen {
+		return 0
+	}
+	d := b.openDuration - b.now().Sub(b.openedAt)
+	if d <= 0 {
+		b.setState(circuitHalfOpen)
+		return 0
+	}
+	return d
+}
+
+// allow returns true if a message may be sent, while half-open, this uses up one of the probes
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remaining()
+	switch b.state {
+	case circuitClosed:
+		return true
+	case circuitHalfOpen:
+		if b.probes < b.halfOpenProbes {
+			b.probes++
+			return true
+		}
+	}
+	return false
+}
+
+// done records the result of sending a message that was allowed
+func (b *circuitBreaker) done(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case circuitClosed:
+		if err == nil {
+			b.failures = 0
+		} else if b.failures++; b.failures >= b.failureThreshold {
+			b.setState(circuitOpen)
+		}
+	case circuitHalfOpen:
+		if err != nil {
+			b.setState(circuitOpen)
+		} else if b.successes++; b.successes >= b.halfOpenProbes {
+			b.setState(circuitClosed)
+		}
+	}
+}
+
+func (b *circuitBreaker) isOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remaining()
+	return b.state == circuitOpen
+}
+
+// wait blocks until the breaker will allow a message to be sent
+func (b *circuitBreaker) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		d := b.remaining()
+		ready := b.state == circuitClosed || (b.state == circuitHalfOpen && b.probes < b.halfOpenProbes)
+		b.mu.Unlock()
+		if ready {
+			return nil
+		}
+		if d == 0 { // half-open, wait for the probes to complete
+			d = 100 * time.Millisecond
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d):
+		}
+	}
+}
+
+// circuitBreakers are the circuit breakers of the sinks that have one, keyed by sink name
+type circuitBreakers map[string]*circuitBreaker
+
+// wait blocks until no breakers are open, so sources are paused, rather than retrying messages that would fail
+func (bs circuitBreakers) wait(ctx context.Context) error {
+	for _, b := range bs {
+		if err := b.wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bs circuitBreakers) anyOpen() bool {
+	for _, b := range bs {
+		if b.isOpen() {
+			return true
+		}
+	}
+	return false
+}
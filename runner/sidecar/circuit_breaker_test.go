@@ -0,0 +1,79 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_circuitBreaker(t *testing.T) {
+	var states []circuitState
+	b := newCircuitBreaker(dfv1.CircuitBreaker{
+		FailureThreshold: 2,
+		OpenDuration:     &metav1.Duration{Duration: time.Minute},
+		HalfOpenProbes:   2,
+	}, func(s circuitState) { states = append(states, s) })
+	now := time.Unix(0, 0)
+	b.now = func() time.Time { return now }
+	failed := fmt.Errorf("failed")
+
+	t.Run("Closed", func(t *testing.T) {
+		assert.True(t, b.allow())
+		b.done(failed)
+		assert.True(t, b.allow())
+		b.done(nil) // resets the consecutive failures
+		assert.True(t, b.allow())
+		b.done(failed)
+		assert.False(t, b.isOpen())
+	})
+	t.Run("Open", func(t *testing.T) {
+		assert.True(t, b.allow())
+		b.done(failed)
+		assert.True(t, b.isOpen())
+		assert.False(t, b.allow())
+		now = now.Add(59 * time.Second)
+		assert.False(t, b.allow())
+	})
+	t.Run("HalfOpenFailed", func(t *testing.T) {
+		now = now.Add(time.Second)
+		assert.True(t, b.allow())
+		b.done(failed)
+		assert.True(t, b.isOpen())
+	})
+	t.Run("HalfOpenSucceeded", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		assert.True(t, b.allow())
+		assert.True(t, b.allow())
+		assert.False(t, b.allow(), "only two probes")
+		b.done(nil)
+		b.done(nil)
+		assert.True(t, b.allow())
+	})
+	assert.Equal(t, []circuitState{circuitOpen, circuitHalfOpen, circuitOpen, circuitHalfOpen, circuitClosed}, states)
+}
+
+func Test_circuitBreakers(t *testing.T) {
+	b := newCircuitBreaker(dfv1.CircuitBreaker{FailureThreshold: 1, OpenDuration: &metav1.Duration{Duration: 50 * time.Millisecond}}, func(circuitState) {})
+	bs := circuitBreakers{"my-sink": b}
+	assert.NoError(t, bs.wait(context.Background()))
+	assert.False(t, bs.anyOpen())
+	assert.True(t, b.allow())
+	b.done(fmt.Errorf("failed"))
+	assert.True(t, bs.anyOpen())
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.Error(t, bs.wait(ctx))
+	})
+	t.Run("HalfOpen", func(t *testing.T) {
+		start := time.Now()
+		assert.NoError(t, bs.wait(context.Background()))
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+		assert.False(t, bs.anyOpen())
+	})
+}
@@ -0,0 +1,27 @@
+package sidecar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+)
+
+// deadLetterMessage returns the context and message to send a dead letter to a DLQ sink in the format
+func deadLetterMessage(ctx context.Context, format dfv1.DeadLetterQueueFormat, d dfv1.DeadLetter) (context.Context, []byte, error) {
+	switch format {
+	case dfv1.DeadLetterQueueFormatRaw:
+		m := d.Meta
+		m.Headers = d.Headers()
+		return dfv1.ContextWithMeta(ctx, m), d.Data, nil
+	case dfv1.DeadLetterQueueFormatEnvelope:
+		data, err := json.Marshal(d)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal dead letter: %w", err)
+		}
+		return dfv1.ContextWithMeta(ctx, d.Meta), data, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown dead letter queue format %q", format)
+	}
+}
@@ -0,0 +1,54 @@
+package sidecar
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_deadLetterMessage(t *testing.T) {
+	d := dfv1.DeadLetter{
+		Error:            "my-error",
+		StepName:         "my-step",
+		SourceName:       "my-source-name",
+		Attempts:         2,
+		FirstFailureTime: metav1.NewTime(time.Unix(1, 0)),
+		LastFailureTime:  metav1.NewTime(time.Unix(2, 0)),
+		Meta:             dfv1.Meta{Source: "my-source", ID: "my-id", Time: 1, SourceName: "my-source-name"},
+		Data:             []byte("my-data"),
+	}
+	t.Run("Raw", func(t *testing.T) {
+		ctx, msg, err := deadLetterMessage(context.Background(), dfv1.DeadLetterQueueFormatRaw, d)
+		assert.NoError(t, err)
+		assert.Equal(t, "my-data", string(msg))
+		m, err := dfv1.MetaFromContext(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "my-id", m.ID)
+		assert.Equal(t, "my-error", m.Headers["dlq-error"])
+		assert.Equal(t, "2", m.Headers["dlq-attempts"])
+	})
+	t.Run("Envelope", func(t *testing.T) {
+		ctx, msg, err := deadLetterMessage(context.Background(), dfv1.DeadLetterQueueFormatEnvelope, d)
+		assert.NoError(t, err)
+		m, err := dfv1.MetaFromContext(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "my-id", m.ID)
+		assert.Empty(t, m.Headers)
+		x := dfv1.DeadLetter{}
+		assert.NoError(t, json.Unmarshal(msg, &x))
+		assert.Equal(t, d.Error, x.Error)
+		assert.Equal(t, d.Attempts, x.Attempts)
+		assert.True(t, d.FirstFailureTime.Equal(&x.FirstFailureTime))
+		assert.Equal(t, d.Meta, x.Meta)
+		assert.Equal(t, d.Data, x.Data)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, _, err := deadLetterMessage(context.Background(), "foo", d)
+		assert.EqualError(t, err, `unknown dead letter queue format "foo"`)
+	})
+}
@@ -85,6 +85,27 @@ func connectIn(ctx context.Context, sink func(context.Context, []byte) error) (f
 			logger.Info("not waiting for HTTP to be read, this maybe a generator step and so may never be ready")
 		}
 		addStopHook(waitUnready)
+		if in.HTTP.IsBatch() {
+			if err := validateBatching(step.Spec.Sources); err != nil {
+				return nil, err
+			}
+			logger.Info("batching messages", "maxBatchSize", in.HTTP.MaxBatchSize, "maxBatchWait", in.HTTP.GetMaxBatchWait().String())
+			b := newBatcher(int(in.HTTP.MaxBatchSize), in.HTTP.GetMaxBatchWait(), postBatch)
+			go b.run(ctx)
+			return func(ctx context.Context, data []byte) error {
+				span, ctx := opentracing.StartSpanFromContext(ctx, "messages")
+				defer span.Finish()
+				inFlight.Inc()
+				defer inFlight.Dec()
+				start := time.Now()
+				defer func() { messageTimeSeconds.Observe(time.Since(start).Seconds()) }()
+				outputs, err := b.process(ctx, data)
+				if err != nil {
+					return err
+				}
+				return sinkOutputs(ctx, outputs, sink)
+			}, nil
+		}
 		return func(ctx context.Context, data []byte) error {
 			span, ctx := opentracing.StartSpanFromContext(ctx, "messages")
 			defer span.Finish()
@@ -102,37 +123,68 @@ func connectIn(ctx context.Context, sink func(context.Context, []byte) error) (f
 			if err := dfv1.MetaInject(ctx, req.Header); err != nil {
 				return err
 			}
-			if resp, err := httpClient.Do(req); err != nil {
+			resp, err := httpClient.Do(req)
+			if err != nil {
 				return fmt.Errorf("failed to execute HTTP request: %w", err)
-			} else {
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode >= 300 {
 				body, _ := ioutil.ReadAll(resp.Body)
-				_ = resp.Body.Close()
-				if resp.StatusCode >= 300 {
-					return fmt.Errorf("HTTP request failed: %q %q", resp.Status, body)
-				}
-				if resp.StatusCode == 201 {
-					return sink(ctx, body)
+				return fmt.Errorf("HTTP request failed: %q %q", resp.Status, body)
+			}
+			if resp.StatusCode == 201 {
+				outputs, err := readOutputs(resp)
+				if err != nil {
+					return err
 				}
+				return sinkOutputs(ctx, outputs, sink)
 			}
+			_, _ = io.Copy(io.Discard, resp.Body)
 			return nil
 		}, nil
+	} else if in.GRPC != nil {
+		logger.Info("gRPC in interface configured")
+		conn, err := dialGRPC(ctx, dfv1.PathMainSocket)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to gRPC in interface: %w", err)
+		}
+		g := newGRPCIn(conn)
+		addStopHook(func(ctx context.Context) error {
+			logger.Info("closing gRPC stream")
+			if err := g.close(); err != nil {
+				return err
+			}
+			return conn.Close()
+		})
+		return func(ctx context.Context, data []byte) error {
+			span, ctx := opentracing.StartSpanFromContext(ctx, "messages")
+			defer span.Finish()
+			inFlight.Inc()
+			defer inFlight.Dec()
+			start := time.Now()
+			defer func() { messageTimeSeconds.Observe(time.Since(start).Seconds()) }()
+			outputs, err := g.process(ctx, data)
+			if err != nil {
+				return err
+			}
+			return sinkOutputs(ctx, outputs, sink)
+		}, nil
 	} else {
 		return nil, fmt.Errorf("in interface misconfigured")
 	}
 }
 
 func waitReady(ctx context.Context) error {
-	const ipcSockPath = "/var/run/argo-dataflow/main.sock"
 	for {
 		select {
 		case <-ctx.Done():
 			return fmt.Errorf("failed to wait for ready: %w", ctx.Err())
 		default:
-			if _, err := os.Stat(ipcSockPath); os.Getenv(dfv1.EnvUnixDomainSocket) != "false" && err == nil {
-				logger.Info("switching to Unix socket", "path", ipcSockPath)
+			if _, err := os.Stat(dfv1.PathMainSocket); os.Getenv(dfv1.EnvUnixDomainSocket) != "false" && err == nil {
+				logger.Info("switching to Unix socket", "path", dfv1.PathMainSocket)
 				dialer := &net.Dialer{}
 				httpTransport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
-					return dialer.DialContext(ctx, "unix", ipcSockPath)
+					return dialer.DialContext(ctx, "unix", dfv1.PathMainSocket)
 				}
 			}
 			logger.Info("waiting for HTTP in interface to be ready")
@@ -0,0 +1,192 @@
+package sidecar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/opentracing/opentracing-go"
+)
+
+// validateBatching returns an error unless a source processes messages concurrently. Batches are made from concurrent
+// messages, so otherwise each batch would have one message, and would only be sent after the max wait.
+func validateBatching(sources dfv1.Sources) error {
+	for _, s := range sources {
+		if s.HTTP != nil || (s.Kafka != nil && s.Kafka.GetConcurrency() > 1) {
+			return nil
+		}
+	}
+	return fmt.Errorf("batching requires an HTTP source, or a Kafka source with concurrency, as other sources process one message at a time")
+}
+
+// batcher collects messages from concurrent callers into batches, so the main container can process many messages in
+// a single request. Each caller waits for the result of its own message, so retries and the DLQ work per-message.
+type batcher struct {
+	maxSize int
+	maxWait time.Duration
+	items   chan *batchItem
+	send    func(ctx context.Context, msgs []dfv1.BatchMessage) ([]dfv1.BatchResult, error)
+}
+
+type batchItem struct {
+	ctx    context.Context
+	msg    dfv1.BatchMessage
+	result chan batchItemResult
+}
+
+type batchItemResult struct {
+	outputs []output
+	err     error
+}
+
+func newBatcher(maxSize int, maxWait time.Duration, send func(ctx context.Context, msgs []dfv1.BatchMessage) ([]dfv1.BatchResult, error)) *batcher {
+	return &batcher{
+		maxSize: maxSize,
+		maxWait: maxWait,
+		items:   make(chan *batchItem),
+		send:    send,
+	}
+}
+
+func (b *batcher) run(ctx context.Context) {
+	for {
+		var batch []*batchItem
+		select {
+		case <-ctx.Done():
+			return
+		case item := <-b.items:
+			batch = append(batch, item)
+		}
+		timer := time.NewTimer(b.maxWait)
+	collect:
+		for len(batch) < b.maxSize {
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				for _, item := range batch {
+					item.result <- batchItemResult{err: ctx.Err()}
+				}
+				return
+			case item := <-b.items:
+				batch = append(batch, item)
+			case <-timer.C:
+				break collect
+			}
+		}
+		timer.Stop()
+		// do not wait for the batch to be processed, so we can start collecting the next one
+		go b.flush(ctx, batch)
+	}
+}
+
+func (b *batcher) flush(ctx context.Context, batch []*batchItem) {
+	msgs := make([]dfv1.BatchMessage, len(batch))
+	// the batch's span follows from the span of each of its messages
+	var refs []opentracing.StartSpanOption
+	for i, item := range batch {
+		msgs[i] = item.msg
+		if span := opentracing.SpanFromContext(item.ctx); span != nil {
+			refs = append(refs, opentracing.FollowsFrom(span.Context()))
+		}
+	}
+	span := opentracing.StartSpan("messages/batch", refs...)
+	defer span.Finish()
+	results, err := b.send(opentracing.ContextWithSpan(ctx, span), msgs)
+	if err == nil && len(results) != len(batch) {
+		err = fmt.Errorf("expected %d results, got %d", len(batch), len(results))
+	}
+	for i, item := range batch {
+		if err != nil {
+			item.result <- batchItemResult{err: err}
+		} else if r := results[i]; r.Error != "" {
+			item.result <- batchItemResult{err: fmt.Errorf("failed to process message: %s", r.Error)}
+		} else {
+			item.result <- batchItemResult{outputs: batchOutputs(r)}
+		}
+	}
+}
+
+// batchOutputs returns the output messages of the result. Headers on the result apply to every message, headers on an
+// output only to that output's message.
+func batchOutputs(r dfv1.BatchResult) []output {
+	if len(r.Outputs) == 0 {
+		if r.Data == nil {
+			return nil
+		}
+		return []output{{data: r.Data, headers: lowerKeys(r.Headers)}}
+	}
+	outputs := make([]output, len(r.Outputs))
+	for i, o := range r.Outputs {
+		outputs[i] = output{data: o.Data, headers: mergeHeaders(lowerKeys(r.Headers), lowerKeys(o.Headers))}
+	}
+	return outputs
+}
+
+// lowerKeys returns the headers with lower-case keys, like headers read from HTTP headers
+func lowerKeys(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+	lower := make(map[string]string, len(headers))
+	for k, v := range headers {
+		lower[strings.ToLower(k)] = v
+	}
+	return lower
+}
+
+// process adds the message to the next batch, and returns its output messages, which are nil if there are none
+func (b *batcher) process(ctx context.Context, data []byte) ([]output, error) {
+	m, err := dfv1.MetaFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	item := &batchItem{ctx: ctx, msg: dfv1.BatchMessage{Meta: m, Data: data}, result: make(chan batchItemResult, 1)}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case b.items <- item:
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-item.result:
+		return r.outputs, r.err
+	}
+}
+
+func postBatch(ctx context.Context, msgs []dfv1.BatchMessage) ([]dfv1.BatchResult, error) {
+	data, err := json.Marshal(msgs)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", "http://127.0.0.1:8080/messages/batch", bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		if err := opentracing.GlobalTracer().Inject(span.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(req.Header)); err != nil {
+			return nil, fmt.Errorf("failed to inject tracing headers: %w", err)
+		}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("HTTP request failed: %q %q", resp.Status, body)
+	}
+	var results []dfv1.BatchResult
+	if err := json.Unmarshal(body, &results); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch results: %w", err)
+	}
+	return results, nil
+}
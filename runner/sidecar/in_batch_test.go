@@ -0,0 +1,107 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_batcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var mu sync.Mutex
+	var batches [][]dfv1.BatchMessage
+	b := newBatcher(3, time.Hour, func(ctx context.Context, msgs []dfv1.BatchMessage) ([]dfv1.BatchResult, error) {
+		assert.NotNil(t, opentracing.SpanFromContext(ctx), "the batch has a span")
+		mu.Lock()
+		batches = append(batches, msgs)
+		mu.Unlock()
+		results := make([]dfv1.BatchResult, len(msgs))
+		for i, m := range msgs {
+			switch string(m.Data) {
+			case "error":
+				results[i].Error = "my-error"
+			case "filter":
+			default:
+				results[i].Data = append([]byte("out-"), m.Data...)
+			}
+		}
+		return results, nil
+	})
+	go b.run(ctx)
+	type result struct {
+		outputs []output
+		err     error
+	}
+	results := make([]result, 3)
+	wg := sync.WaitGroup{}
+	for i, data := range []string{"foo", "error", "filter"} {
+		wg.Add(1)
+		go func(i int, data string) {
+			defer wg.Done()
+			ctx := dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: "my-source", ID: fmt.Sprint(i)})
+			out, err := b.process(ctx, []byte(data))
+			results[i] = result{out, err}
+		}(i, data)
+	}
+	wg.Wait()
+	if assert.Len(t, batches, 1, "the batch is sent when it is full") {
+		assert.Len(t, batches[0], 3)
+	}
+	assert.Equal(t, result{outputs: []output{{data: []byte("out-foo")}}}, results[0])
+	assert.EqualError(t, results[1].err, "failed to process message: my-error")
+	assert.Equal(t, result{}, results[2])
+}
+
+func Test_batcher_maxWait(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b := newBatcher(10, 10*time.Millisecond, func(ctx context.Context, msgs []dfv1.BatchMessage) ([]dfv1.BatchResult, error) {
+		return nil, fmt.Errorf("my-error")
+	})
+	go b.run(ctx)
+	_, err := b.process(dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: "my-source", ID: "1"}), []byte("foo"))
+	assert.EqualError(t, err, "my-error", "the batch is sent after max wait, and errors apply to every message")
+}
+
+func Test_batcher_wrongNumberOfResults(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b := newBatcher(10, time.Millisecond, func(ctx context.Context, msgs []dfv1.BatchMessage) ([]dfv1.BatchResult, error) {
+		return nil, nil
+	})
+	go b.run(ctx)
+	_, err := b.process(dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: "my-source", ID: "1"}), []byte("foo"))
+	assert.EqualError(t, err, "expected 1 results, got 0")
+}
+
+func Test_batchOutputs(t *testing.T) {
+	assert.Nil(t, batchOutputs(dfv1.BatchResult{}))
+	assert.Equal(t, []output{{data: []byte("foo"), headers: map[string]string{"tenant-id": "my-tenant"}}}, batchOutputs(dfv1.BatchResult{
+		Data:    []byte("foo"),
+		Headers: map[string]string{"Tenant-ID": "my-tenant"},
+	}))
+	assert.Equal(t, []output{
+		{data: []byte("foo"), headers: map[string]string{"tenant-id": "my-tenant"}},
+		{data: []byte("bar"), headers: map[string]string{"tenant-id": "my-other-tenant"}},
+	}, batchOutputs(dfv1.BatchResult{
+		Headers: map[string]string{"tenant-id": "my-tenant"},
+		Outputs: []dfv1.BatchOutput{
+			{Data: []byte("foo")},
+			{Data: []byte("bar"), Headers: map[string]string{"tenant-id": "my-other-tenant"}},
+		},
+	}))
+}
+
+func Test_validateBatching(t *testing.T) {
+	assert.NoError(t, validateBatching(dfv1.Sources{{HTTP: &dfv1.HTTPSource{}}}))
+	assert.NoError(t, validateBatching(dfv1.Sources{{Kafka: &dfv1.KafkaSource{Concurrency: 2}}}))
+	err := validateBatching(dfv1.Sources{{Kafka: &dfv1.KafkaSource{}}, {STAN: &dfv1.STAN{}}})
+	assert.EqualError(t, err, "batching requires an HTTP source, or a Kafka source with concurrency, as other sources process one message at a time")
+}
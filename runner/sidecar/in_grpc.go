@@ -0,0 +1,154 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/sdks/golang/processor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// grpcIn sends messages from concurrent callers over a single Process stream, and routes the outputs back to each
+// caller using the input's sequence number
+type grpcIn struct {
+	client processor.ProcessorClient
+	mu     sync.Mutex
+	seq    uint64
+	stream processor.Processor_ProcessClient // nil until the first message, or after the stream breaks
+	sendMu sync.Mutex                        // streams are not safe for concurrent sends
+	inputs map[uint64]*grpcInput
+}
+
+type grpcInput struct {
+	outputs   chan *processor.Output // closed if the stream breaks
+	err       error                  // why the stream broke
+	abandoned chan struct{}          // closed when the caller stops waiting
+}
+
+// dialGRPC waits for the main container to listen on the socket
+func dialGRPC(ctx context.Context, address string) (*grpc.ClientConn, error) {
+	logger.Info("waiting for gRPC in interface to be ready", "address", address)
+	return grpc.DialContext(ctx, "unix://"+address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+}
+
+func newGRPCIn(conn *grpc.ClientConn) *grpcIn {
+	return &grpcIn{client: processor.NewProcessorClient(conn), inputs: map[uint64]*grpcInput{}}
+}
+
+func (g *grpcIn) register() (processor.Processor_ProcessClient, uint64, *grpcInput, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.stream == nil {
+		// the stream outlives any single message, so it must not use the message's context
+		stream, err := g.client.Process(context.Background())
+		if err != nil {
+			return nil, 0, nil, fmt.Errorf("failed to open gRPC stream: %w", err)
+		}
+		g.stream = stream
+		go g.receive(stream)
+	}
+	g.seq++
+	in := &grpcInput{outputs: make(chan *processor.Output, 16), abandoned: make(chan struct{})}
+	g.inputs[g.seq] = in
+	return g.stream, g.seq, in, nil
+}
+
+func (g *grpcIn) unregister(seq uint64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if in, ok := g.inputs[seq]; ok {
+		close(in.abandoned)
+		delete(g.inputs, seq)
+	}
+}
+
+func (g *grpcIn) receive(stream processor.Processor_ProcessClient) {
+	for {
+		out, err := stream.Recv()
+		if err != nil {
+			logger.Info("gRPC stream closed", "err", err.Error())
+			g.mu.Lock()
+			if g.stream == stream {
+				g.stream = nil
+			}
+			for seq, in := range g.inputs {
+				in.err = err
+				close(in.outputs)
+				delete(g.inputs, seq)
+			}
+			g.mu.Unlock()
+			return
+		}
+		g.mu.Lock()
+		in, ok := g.inputs[out.Seq]
+		g.mu.Unlock()
+		if !ok {
+			continue // the caller has given up
+		}
+		select {
+		case in.outputs <- out:
+		case <-in.abandoned:
+		}
+	}
+}
+
+// process sends the message, and returns its outputs
+func (g *grpcIn) process(ctx context.Context, data []byte) ([]output, error) {
+	m, err := dfv1.MetaFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream, seq, in, err := g.register()
+	if err != nil {
+		return nil, err
+	}
+	defer g.unregister(seq)
+	g.sendMu.Lock()
+	err = stream.Send(&processor.Input{
+		Seq: seq,
+		Meta: &processor.Meta{
+			Source:     m.Source,
+			Id:         m.ID,
+			Time:       m.Time,
+			SourceName: m.SourceName,
+			Topic:      m.Topic,
+			Partition:  m.Partition,
+			Headers:    m.Headers,
+		},
+		Data: data,
+	})
+	g.sendMu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+	var outputs []output
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case out, ok := <-in.outputs:
+			if !ok {
+				return nil, fmt.Errorf("gRPC stream closed: %w", in.err)
+			}
+			if out.Error != "" {
+				return nil, fmt.Errorf("failed to process message: %s", out.Error)
+			}
+			if out.Done {
+				return outputs, nil
+			}
+			outputs = append(outputs, output{data: out.Data, headers: out.Headers})
+		}
+	}
+}
+
+func (g *grpcIn) close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.stream != nil {
+		return g.stream.CloseSend()
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/sdks/golang"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_grpcIn(t *testing.T) {
+	// not t.TempDir(), as the socket path must be short
+	dir, err := os.MkdirTemp("/tmp", "test")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	address := filepath.Join(dir, "main.sock")
+	listener, err := net.Listen("unix", address)
+	assert.NoError(t, err)
+	server := golang.NewGRPCServer(func(ctx context.Context, msg []byte) ([][]byte, error) {
+		m, err := golang.MetaFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch string(msg) {
+		case "error":
+			return nil, fmt.Errorf("my-error")
+		case "panic":
+			panic("my-panic")
+		case "headers":
+			if err := golang.SetHeader(ctx, "correlation-id", "my-correlation-id"); err != nil {
+				return nil, err
+			}
+			return [][]byte{msg}, nil
+		case "many":
+			return [][]byte{[]byte(m.ID + "-a"), []byte(m.ID + "-b")}, nil
+		default:
+			return nil, nil
+		}
+	})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := dialGRPC(ctx, address)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	g := newGRPCIn(conn)
+	process := func(id, msg string) ([][]byte, error) {
+		outputs, err := g.process(dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: "my-source", ID: id, Time: 1}), []byte(msg))
+		var data [][]byte
+		for _, out := range outputs {
+			data = append(data, out.data)
+		}
+		return data, err
+	}
+	t.Run("ManyOutputs", func(t *testing.T) {
+		outputs, err := process("1", "many")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte("1-a"), []byte("1-b")}, outputs)
+	})
+	t.Run("Headers", func(t *testing.T) {
+		outputs, err := g.process(dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: "my-source", ID: "1", Time: 1, Headers: map[string]string{"tenant-id": "my-tenant"}}), []byte("headers"))
+		assert.NoError(t, err)
+		if assert.Len(t, outputs, 1) {
+			assert.Equal(t, map[string]string{"tenant-id": "my-tenant", "correlation-id": "my-correlation-id"}, outputs[0].headers)
+		}
+	})
+	t.Run("NoOutputs", func(t *testing.T) {
+		outputs, err := process("2", "none")
+		assert.NoError(t, err)
+		assert.Empty(t, outputs)
+	})
+	t.Run("Error", func(t *testing.T) {
+		_, err := process("3", "error")
+		assert.EqualError(t, err, "failed to process message: my-error")
+		_, err = process("4", "panic")
+		assert.EqualError(t, err, "failed to process message: recovered from crash: my-panic")
+	})
+	t.Run("Concurrent", func(t *testing.T) {
+		wg := sync.WaitGroup{}
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				outputs, err := process(fmt.Sprint(i), "many")
+				assert.NoError(t, err)
+				assert.Equal(t, [][]byte{[]byte(fmt.Sprintf("%d-a", i)), []byte(fmt.Sprintf("%d-b", i))}, outputs)
+			}(i)
+		}
+		wg.Wait()
+	})
+	t.Run("StreamClosed", func(t *testing.T) {
+		server.Stop()
+		_, err := process("5", "many")
+		assert.Error(t, err)
+	})
+	assert.NoError(t, g.close())
+}
@@ -0,0 +1,93 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	sharedkafka "github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/kafka"
+)
+
+// output is a message returned by the main container
+type output struct {
+	data []byte
+	// headers set by the main container, that are added to the headers of the message it was made from
+	headers map[string]string
+}
+
+// readOutputs reads the output messages from a 201 response. A "multipart/mixed" response has a message per part,
+// any other response has a single message, the body. Headers on the response apply to every message, headers on a part
+// only to that part's message.
+func readOutputs(resp *http.Response) ([]output, error) {
+	headers := dfv1.HeadersExtract(resp.Header)
+	mediaType, params, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "multipart/mixed" {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return []output{{data: body, headers: headers}}, nil
+	}
+	r := multipart.NewReader(resp.Body, params["boundary"])
+	var outputs []output
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			return outputs, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read multipart response: %w", err)
+		}
+		data, err := ioutil.ReadAll(p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read multipart response: %w", err)
+		}
+		outputs = append(outputs, output{data: data, headers: mergeHeaders(headers, dfv1.HeadersExtract(http.Header(p.Header)))})
+	}
+}
+
+// sinkOutputs sends each of the outputs of a message to the sink. Each output inherits the message's meta-data, and
+// when there is more than one output, its ID is suffixed with its index, so it is unique.
+//
+// Only the last output carries the Kafka source's offsets, so a transactional sink commits them once every output has
+// been sent. Otherwise, if a later output failed, the message would not be re-processed, and that output would be lost.
+func sinkOutputs(ctx context.Context, outputs []output, sink func(context.Context, []byte) error) error {
+	m, err := dfv1.MetaFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	offsets := sharedkafka.OffsetsFromContext(ctx)
+	for i, out := range outputs {
+		ctx := ctx
+		if offsets != nil && i < len(outputs)-1 {
+			ctx = sharedkafka.ContextWithOffsets(ctx, nil)
+		}
+		x := m
+		if len(outputs) > 1 {
+			x.ID = fmt.Sprintf("%s/%d", m.ID, i)
+		}
+		if len(out.headers) > 0 {
+			x.Headers = mergeHeaders(m.Headers, out.headers)
+		}
+		if err := sink(dfv1.ContextWithMeta(ctx, x), out.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeHeaders returns a new map with the headers of a, overridden by those of b
+func mergeHeaders(a, b map[string]string) map[string]string {
+	headers := make(map[string]string, len(a)+len(b))
+	for k, v := range a {
+		headers[k] = v
+	}
+	for k, v := range b {
+		headers[k] = v
+	}
+	return headers
+}
@@ -0,0 +1,139 @@
+package sidecar
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"testing"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	sharedkafka "github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/kafka"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_readOutputs(t *testing.T) {
+	t.Run("Body", func(t *testing.T) {
+		outputs, err := readOutputs(&http.Response{
+			Header: http.Header{"Dataflow-Header-Tenant-Id": {"my-tenant"}},
+			Body:   ioutil.NopCloser(bytes.NewBufferString("foo")),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []output{{data: []byte("foo"), headers: map[string]string{"tenant-id": "my-tenant"}}}, outputs)
+	})
+	t.Run("Multipart", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w := multipart.NewWriter(buf)
+		for _, s := range []string{"foo", "", "bar"} {
+			h := textproto.MIMEHeader{}
+			if s == "bar" {
+				h.Set("dataflow-header-tenant-id", "my-other-tenant")
+			}
+			p, err := w.CreatePart(h)
+			assert.NoError(t, err)
+			_, err = p.Write([]byte(s))
+			assert.NoError(t, err)
+		}
+		assert.NoError(t, w.Close())
+		resp := &http.Response{
+			Header: http.Header{
+				"Content-Type":              {"multipart/mixed; boundary=" + w.Boundary()},
+				"Dataflow-Header-Tenant-Id": {"my-tenant"},
+			},
+			Body: ioutil.NopCloser(buf),
+		}
+		outputs, err := readOutputs(resp)
+		assert.NoError(t, err)
+		assert.Equal(t, []output{
+			{data: []byte("foo"), headers: map[string]string{"tenant-id": "my-tenant"}},
+			{data: []byte{}, headers: map[string]string{"tenant-id": "my-tenant"}},
+			{data: []byte("bar"), headers: map[string]string{"tenant-id": "my-other-tenant"}},
+		}, outputs)
+	})
+	t.Run("Malformed", func(t *testing.T) {
+		resp := &http.Response{
+			Header: http.Header{"Content-Type": {"multipart/mixed; boundary=foo"}},
+			Body:   ioutil.NopCloser(bytes.NewBufferString("bar")),
+		}
+		_, err := readOutputs(resp)
+		assert.Error(t, err)
+	})
+}
+
+func Test_sinkOutputs(t *testing.T) {
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "my-id", Time: 1, SourceName: "my-source-name", Headers: map[string]string{"tenant-id": "my-tenant"}})
+	var metas []dfv1.Meta
+	var sunk []string
+	sink := func(ctx context.Context, msg []byte) error {
+		m, err := dfv1.MetaFromContext(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "my-source", m.Source)
+		assert.Equal(t, "my-source-name", m.SourceName)
+		metas = append(metas, m)
+		sunk = append(sunk, string(msg))
+		return nil
+	}
+	t.Run("One", func(t *testing.T) {
+		metas, sunk = nil, nil
+		assert.NoError(t, sinkOutputs(ctx, []output{{data: []byte("foo")}}, sink))
+		if assert.Len(t, metas, 1) {
+			assert.Equal(t, "my-id", metas[0].ID)
+			assert.Equal(t, map[string]string{"tenant-id": "my-tenant"}, metas[0].Headers)
+		}
+		assert.Equal(t, []string{"foo"}, sunk)
+	})
+	t.Run("Many", func(t *testing.T) {
+		metas, sunk = nil, nil
+		assert.NoError(t, sinkOutputs(ctx, []output{{data: []byte("foo")}, {data: []byte("bar"), headers: map[string]string{"correlation-id": "my-correlation-id"}}}, sink))
+		if assert.Len(t, metas, 2) {
+			assert.Equal(t, "my-id/0", metas[0].ID)
+			assert.Equal(t, map[string]string{"tenant-id": "my-tenant"}, metas[0].Headers)
+			assert.Equal(t, "my-id/1", metas[1].ID)
+			assert.Equal(t, map[string]string{"tenant-id": "my-tenant", "correlation-id": "my-correlation-id"}, metas[1].Headers)
+		}
+		assert.Equal(t, []string{"foo", "bar"}, sunk)
+	})
+	t.Run("None", func(t *testing.T) {
+		metas, sunk = nil, nil
+		assert.NoError(t, sinkOutputs(ctx, nil, sink))
+		assert.Empty(t, sunk)
+	})
+	t.Run("Offsets", func(t *testing.T) {
+		offsets := sharedkafka.NewOffsets(nil, &kafka.Message{})
+		var got []*sharedkafka.Offsets
+		sink := func(ctx context.Context, msg []byte) error {
+			got = append(got, sharedkafka.OffsetsFromContext(ctx))
+			return nil
+		}
+		assert.NoError(t, sinkOutputs(sharedkafka.ContextWithOffsets(ctx, offsets), []output{{data: []byte("foo")}, {data: []byte("bar")}}, sink))
+		assert.Equal(t, []*sharedkafka.Offsets{nil, offsets}, got)
+	})
+	t.Run("RetryFailedOnly", func(t *testing.T) {
+		// like processWithRetry, the delivered sinks are shared between attempts
+		ctx := contextWithDeliveredSinks(ctx, newDeliveredSinks())
+		attempt := 0
+		var sent []string
+		sinkTo := func(ctx context.Context, sinkName string, msg []byte) error {
+			sent = append(sent, string(msg))
+			if string(msg) == "bar" && attempt == 1 {
+				return fmt.Errorf("failed")
+			}
+			return nil
+		}
+		sink := func(ctx context.Context, msg []byte) error {
+			return sinkAll(ctx, dfv1.SinkFailurePolicyRetryFailedOnly, []string{"my-sink"}, msg, sinkTo)
+		}
+		outputs := []output{{data: []byte("foo")}, {data: []byte("bar")}}
+		for attempt = 1; attempt <= 2; attempt++ {
+			if err := sinkOutputs(ctx, outputs, sink); err == nil {
+				break
+			}
+		}
+		assert.Equal(t, 2, attempt)
+		assert.Equal(t, []string{"foo", "bar", "bar"}, sent, "each output is retried until it is delivered to the sink")
+	})
+}
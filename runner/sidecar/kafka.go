@@ -2,14 +2,41 @@ package sidecar
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/schemaregistry"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// newDecodingProcess returns a process that decodes the message using the schema registry, before processing it
+func newDecodingProcess(client *schemaregistry.Client, process func(context.Context, []byte) error) func(context.Context, []byte) error {
+	return func(ctx context.Context, msg []byte) error {
+		value, err := client.Decode(ctx, msg)
+		if err != nil {
+			return fmt.Errorf("failed to decode message: %w", err)
+		}
+		return process(ctx, value)
+	}
+}
+
+// validateKafkaConcurrency returns an error if the Kafka source processes messages concurrently, and a sink is
+// transactional, because the transactions would commit the source's offsets out of order
+func validateKafkaConcurrency(s dfv1.Source, sinks []dfv1.Sink) error {
+	if s.Kafka == nil || s.Kafka.GetConcurrency() == 1 {
+		return nil
+	}
+	for _, x := range sinks {
+		if x.Kafka != nil && x.Kafka.Transactional {
+			return fmt.Errorf("transactional Kafka sink %q cannot be used with Kafka source %q with concurrency, as offsets would be committed out of order", x.Name, s.Name)
+		}
+	}
+	return nil
+}
+
 func kafkaFromSecret(k *dfv1.Kafka, secret *corev1.Secret) error {
 	k.Brokers = dfv1.StringsOr(k.Brokers, strings.Split(string(secret.Data["brokers"]), ","))
 
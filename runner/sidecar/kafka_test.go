@@ -2,9 +2,12 @@ package sidecar
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/schemaregistry"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -91,3 +94,46 @@ func Test_enrichKafka(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func Test_validateKafkaConcurrency(t *testing.T) {
+	transactional := []dfv1.Sink{{Name: "my-sink", Kafka: &dfv1.KafkaSink{Transactional: true}}}
+	assert.NoError(t, validateKafkaConcurrency(dfv1.Source{Name: "my-source", Kafka: &dfv1.KafkaSource{}}, transactional))
+	assert.NoError(t, validateKafkaConcurrency(dfv1.Source{Name: "my-source", Kafka: &dfv1.KafkaSource{Concurrency: 2}}, []dfv1.Sink{{Name: "my-sink", Kafka: &dfv1.KafkaSink{}}}))
+	assert.NoError(t, validateKafkaConcurrency(dfv1.Source{Name: "my-source", HTTP: &dfv1.HTTPSource{}}, transactional))
+	err := validateKafkaConcurrency(dfv1.Source{Name: "my-source", Kafka: &dfv1.KafkaSource{Concurrency: 2}}, transactional)
+	assert.EqualError(t, err, `transactional Kafka sink "my-sink" cannot be used with Kafka source "my-source" with concurrency, as offsets would be committed out of order`)
+}
+
+func Test_newDecodingProcess(t *testing.T) {
+	ctx := context.Background()
+	status := http.StatusInternalServerError
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	client, err := schemaregistry.New(ctx, nil, dfv1.SchemaRegistry{URL: srv.URL})
+	assert.NoError(t, err)
+	var processed []string
+	process := newDecodingProcess(client, func(ctx context.Context, msg []byte) error {
+		processed = append(processed, string(msg))
+		return nil
+	})
+	t.Run("NotFramed", func(t *testing.T) {
+		processed = nil
+		assert.NoError(t, process(ctx, []byte("foo")))
+		assert.Equal(t, []string{"foo"}, processed)
+	})
+	t.Run("RegistryError", func(t *testing.T) {
+		processed = nil
+		err := process(ctx, []byte{0, 0, 0, 0, 1, 'f', 'o', 'o'})
+		assert.EqualError(t, err, `failed to decode message: failed to GET /schemas/ids/1: "500 Internal Server Error" `)
+		assert.Empty(t, processed, "the error is returned, so the message is retried, and dead-lettered")
+	})
+	t.Run("UnknownSchema", func(t *testing.T) {
+		status = http.StatusNotFound
+		processed = nil
+		err := process(ctx, []byte{0, 0, 0, 0, 2, 'f', 'o', 'o'})
+		assert.EqualError(t, err, `failed to decode message: failed to GET /schemas/ids/2: "404 Not Found" `)
+		assert.Empty(t, processed)
+	})
+}
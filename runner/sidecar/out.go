@@ -51,9 +51,10 @@ func connectOutHTTP(sink func(context.Context, []byte) error) {
 			dfv1.ContextWithMeta(
 				ctx,
 				dfv1.Meta{
-					Source: fmt.Sprintf("urn:dataflow:pod:%s.pod.%s.%s:messages", pod, namespace, cluster),
-					ID:     id,
-					Time:   time.Now().Unix(),
+					Source:  fmt.Sprintf("urn:dataflow:pod:%s.pod.%s.%s:messages", pod, namespace, cluster),
+					ID:      id,
+					Time:    time.Now().Unix(),
+					Headers: dfv1.HeadersExtract(r.Header),
 				},
 			),
 			data,
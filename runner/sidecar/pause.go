@@ -0,0 +1,162 @@
+package sidecar
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/source"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// pausableSource pauses, and resumes, a source. It is paused if the step is suspended, or it was paused using its
+// pause endpoint. Sources that are source.Pausable stop receiving messages, and any messages received while paused are
+// held until the source is resumed.
+type pausableSource struct {
+	name        string
+	pausedGauge prometheus.Gauge
+	mu          sync.Mutex
+	source      source.Interface // nil until connected
+	byEndpoint  bool
+	bySuspend   bool
+	resumed     chan struct{} // closed while not paused
+}
+
+func newPausableSource(name string, pausedGauge prometheus.Gauge) *pausableSource {
+	resumed := make(chan struct{})
+	close(resumed)
+	return &pausableSource{name: name, pausedGauge: pausedGauge, resumed: resumed}
+}
+
+// must be called with the lock held
+func (p *pausableSource) paused() bool {
+	return p.byEndpoint || p.bySuspend
+}
+
+// update changes why the source is paused, and then pauses, or resumes, it if needed
+func (p *pausableSource) update(ctx context.Context, f func()) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	wasPaused := p.paused()
+	f()
+	switch paused := p.paused(); {
+	case paused && !wasPaused:
+		logger.Info("pausing source", "source", p.name)
+		p.resumed = make(chan struct{})
+		p.pausedGauge.Set(1)
+		if x, ok := p.source.(source.Pausable); ok {
+			return x.Pause(ctx)
+		}
+	case !paused && wasPaused:
+		logger.Info("resuming source", "source", p.name)
+		close(p.resumed)
+		p.pausedGauge.Set(0)
+		if x, ok := p.source.(source.Pausable); ok {
+			return x.Resume(ctx)
+		}
+	}
+	return nil
+}
+
+// connected records the connected source, and pauses it if needed
+func (p *pausableSource) connected(ctx context.Context, s source.Interface) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.source = s
+	if x, ok := s.(source.Pausable); ok && p.paused() {
+		logger.Info("pausing source", "source", p.name)
+		return x.Pause(ctx)
+	}
+	return nil
+}
+
+// process returns a function that holds each message until the source is not paused, and then processes it
+func (p *pausableSource) process(process func(context.Context, []byte) error) func(context.Context, []byte) error {
+	return func(ctx context.Context, msg []byte) error {
+		p.mu.Lock()
+		resumed := p.resumed
+		p.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("could not send message: %w", ctx.Err())
+		case <-resumed:
+			return process(ctx, msg)
+		}
+	}
+}
+
+// handler returns the handler for the source's pause, or resume, endpoint
+func (p *pausableSource) handler(authorization string, pause bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != authorization {
+			w.WriteHeader(403)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.WriteHeader(405)
+			return
+		}
+		if err := p.update(r.Context(), func() { p.byEndpoint = pause }); err != nil {
+			logger.Error(err, "failed to pause or resume source", "source", p.name, "pause", pause)
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+		w.WriteHeader(204)
+	}
+}
+
+// stepSuspended returns true if the pod's annotations, written by the Downward API, say the step is suspended
+func stepSuspended() (bool, error) {
+	f, err := os.Open(filepath.Join(dfv1.PathPodInfo, "annotations"))
+	if os.IsNotExist(err) { // e.g. the pod was created by an earlier version
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer func() { _ = f.Close() }()
+	return suspendedAnnotation(f)
+}
+
+// suspendedAnnotation returns true if the annotations, in the Downward API's format, say the step is suspended
+func suspendedAnnotation(r io.Reader) (bool, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// each line is key="value", with the value quoted
+		parts := strings.SplitN(scanner.Text(), "=", 2)
+		if len(parts) == 2 && parts[0] == dfv1.KeySuspend {
+			v, err := strconv.Unquote(parts[1])
+			if err != nil {
+				return false, fmt.Errorf("failed to unquote %q: %w", parts[1], err)
+			}
+			return v == "true", nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+// watchSuspend pauses, or resumes, all the sources when the step is suspended, or resumed
+func watchSuspend(ctx context.Context, sources []*pausableSource) {
+	wait.JitterUntilWithContext(ctx, func(ctx context.Context) {
+		suspended, err := stepSuspended()
+		if err != nil {
+			logger.Error(err, "failed to determine if step is suspended")
+			return
+		}
+		for _, p := range sources {
+			if err := p.update(ctx, func() { p.bySuspend = suspended }); err != nil {
+				logger.Error(err, "failed to suspend or resume source", "source", p.name, "suspended", suspended)
+			}
+		}
+	}, 3*time.Second, 1.2, true)
+}
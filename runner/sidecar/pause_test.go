@@ -0,0 +1,105 @@
+package sidecar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPausableSource struct{ paused bool }
+
+func (s *testPausableSource) Close() error { return nil }
+
+func (s *testPausableSource) Pause(context.Context) error {
+	s.paused = true
+	return nil
+}
+
+func (s *testPausableSource) Resume(context.Context) error {
+	s.paused = false
+	return nil
+}
+
+func Test_pausableSource(t *testing.T) {
+	ctx := context.Background()
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "paused"})
+	p := newPausableSource("my-source", gauge)
+	processed := make(chan string, 1)
+	process := p.process(func(ctx context.Context, msg []byte) error {
+		processed <- string(msg)
+		return nil
+	})
+	t.Run("PausedBeforeConnected", func(t *testing.T) {
+		assert.NoError(t, p.update(ctx, func() { p.bySuspend = true }))
+		s := &testPausableSource{}
+		assert.NoError(t, p.connected(ctx, s))
+		assert.True(t, s.paused)
+		assert.Equal(t, float64(1), testutil.ToFloat64(gauge))
+	})
+	t.Run("HeldWhilePaused", func(t *testing.T) {
+		go func() { _ = process(ctx, []byte("foo")) }()
+		select {
+		case <-processed:
+			t.Fatal("message processed while paused")
+		case <-time.After(20 * time.Millisecond):
+		}
+		assert.NoError(t, p.update(ctx, func() { p.bySuspend = false }))
+		assert.Equal(t, "foo", <-processed)
+		assert.False(t, p.source.(*testPausableSource).paused)
+		assert.Equal(t, float64(0), testutil.ToFloat64(gauge))
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		assert.NoError(t, p.update(ctx, func() { p.byEndpoint = true }))
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.Error(t, process(ctx, []byte("foo")))
+	})
+	t.Run("PausedForBothReasons", func(t *testing.T) {
+		assert.NoError(t, p.update(ctx, func() { p.bySuspend = true }))
+		assert.NoError(t, p.update(ctx, func() { p.byEndpoint = false }))
+		assert.True(t, p.source.(*testPausableSource).paused, "still suspended")
+		assert.NoError(t, p.update(ctx, func() { p.bySuspend = false }))
+		assert.False(t, p.source.(*testPausableSource).paused)
+	})
+}
+
+func Test_pausableSource_handler(t *testing.T) {
+	p := newPausableSource("my-source", prometheus.NewGauge(prometheus.GaugeOpts{Name: "paused"}))
+	s := &testPausableSource{}
+	assert.NoError(t, p.connected(context.Background(), s))
+	do := func(method, authorization string, pause bool) int {
+		r := httptest.NewRequest(method, "/sources/my-source/pause", nil)
+		r.Header.Set("Authorization", authorization)
+		w := httptest.NewRecorder()
+		p.handler("Bearer my-token", pause)(w, r)
+		return w.Code
+	}
+	assert.Equal(t, 403, do(http.MethodPost, "Bearer wrong", true))
+	assert.Equal(t, 405, do(http.MethodGet, "Bearer my-token", true))
+	assert.False(t, s.paused)
+	assert.Equal(t, 204, do(http.MethodPost, "Bearer my-token", true))
+	assert.True(t, s.paused)
+	assert.Equal(t, 204, do(http.MethodPost, "Bearer my-token", false))
+	assert.False(t, s.paused)
+}
+
+func Test_suspendedAnnotation(t *testing.T) {
+	suspended, err := suspendedAnnotation(strings.NewReader("dataflow.argoproj.io/replica=\"0\"\ndataflow.argoproj.io/suspend=\"true\"\n"))
+	assert.NoError(t, err)
+	assert.True(t, suspended)
+	suspended, err = suspendedAnnotation(strings.NewReader("dataflow.argoproj.io/suspend=\"false\"\n"))
+	assert.NoError(t, err)
+	assert.False(t, suspended)
+	suspended, err = suspendedAnnotation(strings.NewReader(""))
+	assert.NoError(t, err)
+	assert.False(t, suspended)
+	_, err = suspendedAnnotation(strings.NewReader("dataflow.argoproj.io/suspend=true\n"))
+	assert.Error(t, err)
+}
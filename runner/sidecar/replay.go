@@ -0,0 +1,58 @@
+package sidecar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/replay"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// newReplayProcess returns a function that replays the dead letter in each message using process, with its original
+// meta-data. Dead letters that are not matched are skipped, and invalid ones are logged and skipped, as retrying them
+// would never succeed.
+func newReplayProcess(s dfv1.Source, process func(context.Context, []byte) error, replayedCounter *prometheus.CounterVec) (func(context.Context, []byte) error, error) {
+	if s.Kafka == nil && s.JetStream == nil && s.S3 == nil && s.HTTP == nil {
+		return nil, fmt.Errorf("source %q cannot replay, only Kafka, NATS JetStream, S3 and HTTP sources can", s.Name)
+	}
+	r, err := replay.New(*s.Replay)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, msg []byte) error {
+		if s.S3 != nil {
+			// the message is the object's key and the path to its contents
+			x := struct {
+				Path string `json:"path"`
+			}{}
+			if err := json.Unmarshal(msg, &x); err != nil {
+				return fmt.Errorf("failed to unmarshal S3 message: %w", err)
+			}
+			if msg, err = ioutil.ReadFile(x.Path); err != nil {
+				return fmt.Errorf("failed to read %q: %w", x.Path, err)
+			}
+		}
+		d, err := replay.Decode(msg)
+		if err != nil {
+			logger.Error(err, "failed to replay message, skipping", "source", s.Name)
+			replayedCounter.WithLabelValues(s.Name, fmt.Sprint(replica), "invalid").Inc()
+			return nil
+		}
+		if !r.Match(d) {
+			replayedCounter.WithLabelValues(s.Name, fmt.Sprint(replica), "skipped").Inc()
+			return nil
+		}
+		if err := r.Wait(ctx); err != nil {
+			return err
+		}
+		if err := process(dfv1.ContextWithMeta(ctx, d.Meta), d.Data); err != nil {
+			replayedCounter.WithLabelValues(s.Name, fmt.Sprint(replica), "failed").Inc()
+			return err
+		}
+		replayedCounter.WithLabelValues(s.Name, fmt.Sprint(replica), "replayed").Inc()
+		return nil
+	}, nil
+}
@@ -0,0 +1,78 @@
+package sidecar
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_newReplayProcess(t *testing.T) {
+	msg, err := json.Marshal(dfv1.DeadLetter{
+		Error:           "timeout",
+		LastFailureTime: metav1.NewTime(time.Unix(1, 0)),
+		Meta:            dfv1.Meta{Source: "my-source", ID: "my-id", SourceName: "my-source-name"},
+		Data:            []byte("my-data"),
+	})
+	assert.NoError(t, err)
+	newCounter := func() *prometheus.CounterVec {
+		return prometheus.NewCounterVec(prometheus.CounterOpts{Name: "replayed"}, []string{"sourceName", "replica", "result"})
+	}
+	var got []string
+	process := func(ctx context.Context, data []byte) error {
+		m, err := dfv1.MetaFromContext(ctx)
+		assert.NoError(t, err)
+		got = append(got, m.ID+":"+string(data))
+		return nil
+	}
+	t.Run("NotReplayable", func(t *testing.T) {
+		_, err := newReplayProcess(dfv1.Source{Name: "cron", Cron: &dfv1.Cron{}, Replay: &dfv1.Replay{}}, process, newCounter())
+		assert.Error(t, err)
+	})
+	t.Run("Replayed", func(t *testing.T) {
+		got = nil
+		counter := newCounter()
+		p, err := newReplayProcess(dfv1.Source{Name: "dlq", Kafka: &dfv1.KafkaSource{}, Replay: &dfv1.Replay{Errors: "timeout"}}, process, counter)
+		assert.NoError(t, err)
+		assert.NoError(t, p(context.Background(), msg))
+		assert.Equal(t, []string{"my-id:my-data"}, got)
+		assert.Equal(t, float64(1), testutil.ToFloat64(counter.WithLabelValues("dlq", "0", "replayed")))
+	})
+	t.Run("Skipped", func(t *testing.T) {
+		got = nil
+		counter := newCounter()
+		p, err := newReplayProcess(dfv1.Source{Name: "dlq", Kafka: &dfv1.KafkaSource{}, Replay: &dfv1.Replay{Errors: "bad request"}}, process, counter)
+		assert.NoError(t, err)
+		assert.NoError(t, p(context.Background(), msg))
+		assert.Empty(t, got)
+		assert.Equal(t, float64(1), testutil.ToFloat64(counter.WithLabelValues("dlq", "0", "skipped")))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		got = nil
+		counter := newCounter()
+		p, err := newReplayProcess(dfv1.Source{Name: "dlq", Kafka: &dfv1.KafkaSource{}, Replay: &dfv1.Replay{}}, process, counter)
+		assert.NoError(t, err)
+		assert.NoError(t, p(context.Background(), []byte("my-data")))
+		assert.Empty(t, got)
+		assert.Equal(t, float64(1), testutil.ToFloat64(counter.WithLabelValues("dlq", "0", "invalid")))
+	})
+	t.Run("S3", func(t *testing.T) {
+		got = nil
+		path := filepath.Join(t.TempDir(), "my-key")
+		assert.NoError(t, os.WriteFile(path, msg, 0o600))
+		s3Msg, err := json.Marshal(map[string]string{"key": "my-key", "path": path})
+		assert.NoError(t, err)
+		p, err := newReplayProcess(dfv1.Source{Name: "dlq", S3: &dfv1.S3Source{}, Replay: &dfv1.Replay{}}, process, newCounter())
+		assert.NoError(t, err)
+		assert.NoError(t, p(context.Background(), s3Msg))
+		assert.Equal(t, []string{"my-id:my-data"}, got)
+	})
+}
@@ -0,0 +1,178 @@
+// Package cloudevents encodes and decodes messages as CloudEvents over HTTP, in binary or structured mode, see
+// https://github.com/cloudevents/spec/blob/v1.0.1/http-protocol-binding.md
+package cloudevents
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+)
+
+const (
+	specVersion = "1.0"
+	// ContentType is the content type of structured mode events
+	ContentType = "application/cloudevents+json"
+	// DefaultType is the type of events made from messages without a "type" header
+	DefaultType = "io.argoproj.dataflow.message"
+)
+
+// attribute names must be lower-case letters and digits, so headers with other names cannot be attributes
+var attributeName = regexp.MustCompile(`^[a-z0-9]{1,20}$`)
+
+// Decode returns the meta-data and data of an event, in either mode, detected by the content type. The `id`, `source`
+// and `time` attributes are the message's meta-data, and the other attributes (e.g. `type`, `subject`,
+// `datacontenttype` and extensions) are its headers.
+func Decode(h http.Header, body []byte) (dfv1.Meta, []byte, error) {
+	mediaType, _, _ := mime.ParseMediaType(h.Get("Content-Type"))
+	if mediaType == ContentType {
+		return decodeStructured(body)
+	}
+	return decodeBinary(h, body)
+}
+
+func decodeBinary(h http.Header, body []byte) (dfv1.Meta, []byte, error) {
+	attributes := map[string]string{}
+	for k := range h {
+		if key := strings.ToLower(k); strings.HasPrefix(key, "ce-") {
+			attributes[strings.TrimPrefix(key, "ce-")] = h.Get(k)
+		}
+	}
+	if v := h.Get("Content-Type"); v != "" {
+		attributes["datacontenttype"] = v
+	}
+	m, err := meta(attributes)
+	return m, body, err
+}
+
+func decodeStructured(body []byte) (dfv1.Meta, []byte, error) {
+	event := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &event); err != nil {
+		return dfv1.Meta{}, nil, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+	attributes := map[string]string{}
+	for k, v := range event {
+		if k == "data" || k == "data_base64" {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			attributes[k] = s
+		} else {
+			attributes[k] = string(v) // e.g. a number or boolean extension
+		}
+	}
+	m, err := meta(attributes)
+	if err != nil {
+		return dfv1.Meta{}, nil, err
+	}
+	if v, ok := event["data_base64"]; ok {
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			return dfv1.Meta{}, nil, fmt.Errorf("failed to unmarshal data_base64: %w", err)
+		}
+		data, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return dfv1.Meta{}, nil, fmt.Errorf("failed to decode data_base64: %w", err)
+		}
+		return m, data, nil
+	}
+	data, ok := event["data"]
+	if !ok {
+		return m, nil, nil
+	}
+	// JSON data is stored as JSON, other data is stored as a string
+	var s string
+	if !isJSON(attributes["datacontenttype"]) && json.Unmarshal(data, &s) == nil {
+		return m, []byte(s), nil
+	}
+	return m, data, nil
+}
+
+func meta(attributes map[string]string) (dfv1.Meta, error) {
+	if v := attributes["specversion"]; v != specVersion {
+		return dfv1.Meta{}, fmt.Errorf("unsupported specversion %q", v)
+	}
+	for _, k := range []string{"id", "source", "type"} {
+		if attributes[k] == "" {
+			return dfv1.Meta{}, fmt.Errorf("event must have %q", k)
+		}
+	}
+	m := dfv1.Meta{Source: attributes["source"], ID: attributes["id"], Time: time.Now().Unix(), Headers: map[string]string{}}
+	if v, ok := attributes["time"]; ok {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return dfv1.Meta{}, fmt.Errorf("failed to parse time %q: %w", v, err)
+		}
+		m.Time = t.Unix()
+	}
+	for k, v := range attributes {
+		switch k {
+		case "specversion", "id", "source", "time":
+		default:
+			m.Headers[k] = v
+		}
+	}
+	return m, nil
+}
+
+// attributes returns the event's attributes for the message's meta-data, headers that are not valid attribute names are
+// not included
+func attributes(m dfv1.Meta) map[string]string {
+	attributes := map[string]string{"type": DefaultType}
+	for k, v := range m.Headers {
+		if attributeName.MatchString(k) {
+			attributes[k] = v
+		}
+	}
+	attributes["specversion"] = specVersion
+	attributes["id"] = m.ID
+	attributes["source"] = m.Source
+	attributes["time"] = time.Unix(m.Time, 0).UTC().Format(time.RFC3339)
+	return attributes
+}
+
+// Encode sets the HTTP headers, and returns the body, to send the message as an event in the mode.
+func Encode(mode dfv1.CloudEventsMode, m dfv1.Meta, data []byte, h http.Header) ([]byte, error) {
+	attributes := attributes(m)
+	switch mode {
+	case dfv1.CloudEventsModeBinary:
+		for k, v := range attributes {
+			if k == "datacontenttype" {
+				h.Set("Content-Type", v)
+			} else {
+				h.Set("ce-"+k, v)
+			}
+		}
+		return data, nil
+	case dfv1.CloudEventsModeStructured:
+		event := map[string]interface{}{}
+		for k, v := range attributes {
+			event[k] = v
+		}
+		if isJSON(attributes["datacontenttype"]) && json.Valid(data) {
+			event["data"] = json.RawMessage(data)
+		} else {
+			event["data_base64"] = base64.StdEncoding.EncodeToString(data)
+		}
+		h.Set("Content-Type", ContentType)
+		return json.Marshal(event)
+	default:
+		return nil, fmt.Errorf("unknown CloudEvents mode %q", mode)
+	}
+}
+
+// isJSON returns true if the content type is JSON, an event without a content type is JSON
+func isJSON(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "application/json" || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,106 @@
+package cloudevents
+
+import (
+	"net/http"
+	"testing"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	t.Run("Binary", func(t *testing.T) {
+		h := http.Header{
+			"Ce-Specversion": {"1.0"},
+			"Ce-Id":          {"my-id"},
+			"Ce-Source":      {"my-source"},
+			"Ce-Type":        {"my-type"},
+			"Ce-Time":        {"1970-01-01T00:00:01Z"},
+			"Ce-Subject":     {"my-subject"},
+			"Ce-Tenantid":    {"my-tenant"},
+			"Content-Type":   {"text/plain"},
+		}
+		m, data, err := Decode(h, []byte("hello"))
+		assert.NoError(t, err)
+		assert.Equal(t, dfv1.Meta{
+			Source:  "my-source",
+			ID:      "my-id",
+			Time:    1,
+			Headers: map[string]string{"type": "my-type", "subject": "my-subject", "tenantid": "my-tenant", "datacontenttype": "text/plain"},
+		}, m)
+		assert.Equal(t, "hello", string(data))
+	})
+	t.Run("Structured", func(t *testing.T) {
+		h := http.Header{"Content-Type": {"application/cloudevents+json; charset=utf-8"}}
+		m, data, err := Decode(h, []byte(`{"specversion": "1.0", "id": "my-id", "source": "my-source", "type": "my-type", "time": "1970-01-01T00:00:01Z", "count": 1, "data": {"a": 1}}`))
+		assert.NoError(t, err)
+		assert.Equal(t, dfv1.Meta{Source: "my-source", ID: "my-id", Time: 1, Headers: map[string]string{"type": "my-type", "count": "1"}}, m)
+		assert.JSONEq(t, `{"a": 1}`, string(data))
+	})
+	t.Run("StructuredString", func(t *testing.T) {
+		h := http.Header{"Content-Type": {ContentType}}
+		_, data, err := Decode(h, []byte(`{"specversion": "1.0", "id": "my-id", "source": "my-source", "type": "my-type", "datacontenttype": "text/plain", "data": "hello"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+	t.Run("StructuredBase64", func(t *testing.T) {
+		h := http.Header{"Content-Type": {ContentType}}
+		_, data, err := Decode(h, []byte(`{"specversion": "1.0", "id": "my-id", "source": "my-source", "type": "my-type", "data_base64": "aGVsbG8="}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, _, err := Decode(http.Header{}, nil)
+		assert.EqualError(t, err, `unsupported specversion ""`)
+		_, _, err = Decode(http.Header{"Ce-Specversion": {"1.0"}, "Ce-Id": {"my-id"}, "Ce-Source": {"my-source"}}, nil)
+		assert.EqualError(t, err, `event must have "type"`)
+		_, _, err = Decode(http.Header{"Content-Type": {ContentType}}, []byte("{"))
+		assert.Error(t, err)
+	})
+}
+
+func TestEncode(t *testing.T) {
+	m := dfv1.Meta{
+		Source:  "my-source",
+		ID:      "my-id",
+		Time:    1,
+		Headers: map[string]string{"subject": "my-subject", "tenant-id": "my-tenant"},
+	}
+	t.Run("Binary", func(t *testing.T) {
+		h := http.Header{}
+		body, err := Encode(dfv1.CloudEventsModeBinary, m, []byte("hello"), h)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(body))
+		assert.Equal(t, http.Header{
+			"Ce-Specversion": {"1.0"},
+			"Ce-Id":          {"my-id"},
+			"Ce-Source":      {"my-source"},
+			"Ce-Type":        {DefaultType},
+			"Ce-Time":        {"1970-01-01T00:00:01Z"},
+			"Ce-Subject":     {"my-subject"},
+		}, h, "tenant-id is not a valid attribute name")
+		m2, data, err := Decode(h, body)
+		assert.NoError(t, err)
+		assert.Equal(t, m.ID, m2.ID)
+		assert.Equal(t, "hello", string(data))
+	})
+	t.Run("Structured", func(t *testing.T) {
+		h := http.Header{}
+		m := dfv1.Meta{Source: "my-source", ID: "my-id", Time: 1, Headers: map[string]string{"type": "my-type"}}
+		body, err := Encode(dfv1.CloudEventsModeStructured, m, []byte(`{"a":1}`), h)
+		assert.NoError(t, err)
+		assert.Equal(t, ContentType, h.Get("Content-Type"))
+		assert.JSONEq(t, `{"specversion": "1.0", "id": "my-id", "source": "my-source", "type": "my-type", "time": "1970-01-01T00:00:01Z", "data": {"a": 1}}`, string(body))
+		body, err = Encode(dfv1.CloudEventsModeStructured, m, []byte("hello"), h)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"specversion": "1.0", "id": "my-id", "source": "my-source", "type": "my-type", "time": "1970-01-01T00:00:01Z", "data_base64": "aGVsbG8="}`, string(body))
+		m2, data, err := Decode(h, body)
+		assert.NoError(t, err)
+		assert.Equal(t, m, m2)
+		assert.Equal(t, "hello", string(data))
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := Encode("foo", m, nil, http.Header{})
+		assert.EqualError(t, err, `unknown CloudEvents mode "foo"`)
+	})
+}
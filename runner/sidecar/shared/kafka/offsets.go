@@ -0,0 +1,57 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+type offsetsKey struct{}
+
+// Offsets are the offsets a Kafka source will commit once a message is processed. A transactional Kafka sink can commit
+// them in the same transaction as the messages it produces, so each message is processed exactly-once.
+type Offsets struct {
+	mu        sync.Mutex
+	consumer  *kafka.Consumer
+	offsets   []kafka.TopicPartition
+	committed bool
+}
+
+// NewOffsets returns the offsets to commit once the message has been processed, i.e. the next offset.
+func NewOffsets(consumer *kafka.Consumer, msg *kafka.Message) *Offsets {
+	tp := msg.TopicPartition
+	tp.Offset++
+	return &Offsets{consumer: consumer, offsets: []kafka.TopicPartition{tp}}
+}
+
+func ContextWithOffsets(ctx context.Context, o *Offsets) context.Context {
+	return context.WithValue(ctx, offsetsKey{}, o)
+}
+
+// OffsetsFromContext returns nil if the message did not come from a Kafka source.
+func OffsetsFromContext(ctx context.Context) *Offsets {
+	o, _ := ctx.Value(offsetsKey{}).(*Offsets)
+	return o
+}
+
+func (o *Offsets) Get() []kafka.TopicPartition {
+	return o.offsets
+}
+
+func (o *Offsets) GetConsumerGroupMetadata() (*kafka.ConsumerGroupMetadata, error) {
+	return o.consumer.GetConsumerGroupMetadata()
+}
+
+// MarkCommitted records that the offsets were committed by a transaction, so the source does not need to.
+func (o *Offsets) MarkCommitted() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.committed = true
+}
+
+func (o *Offsets) Committed() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.committed
+}
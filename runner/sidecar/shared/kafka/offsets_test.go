@@ -0,0 +1,23 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffsets(t *testing.T) {
+	assert.Nil(t, OffsetsFromContext(context.Background()))
+	topic := "my-topic"
+	o := NewOffsets(nil, &kafka.Message{TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 1, Offset: 10}})
+	ctx := ContextWithOffsets(context.Background(), o)
+	x := OffsetsFromContext(ctx)
+	if assert.NotNil(t, x) {
+		assert.Equal(t, []kafka.TopicPartition{{Topic: &topic, Partition: 1, Offset: 11}}, x.Get())
+		assert.False(t, x.Committed())
+		x.MarkCommitted()
+		assert.True(t, o.Committed())
+	}
+}
@@ -0,0 +1,180 @@
+package schemaregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// Client is a client for a Confluent compatible schema registry, see
+// https://docs.confluent.io/platform/current/schema-registry/develop/api.html
+type Client struct {
+	url      string
+	user     string
+	password string
+	client   *http.Client
+	mu       sync.Mutex
+	schemas  map[int32]*Schema // schemas never change, so we can cache them forever
+}
+
+func New(ctx context.Context, secretInterface corev1.SecretInterface, x dfv1.SchemaRegistry) (*Client, error) {
+	if x.URL == "" {
+		return nil, fmt.Errorf("schema registry URL is required")
+	}
+	var user, password string
+	if s := x.UserSecret; s != nil {
+		var err error
+		if user, err = getSecret(ctx, secretInterface, s.Name, s.Key); err != nil {
+			return nil, fmt.Errorf("failed to get user secret: %w", err)
+		}
+	}
+	if s := x.PasswordSecret; s != nil {
+		var err error
+		if password, err = getSecret(ctx, secretInterface, s.Name, s.Key); err != nil {
+			return nil, fmt.Errorf("failed to get password secret: %w", err)
+		}
+	}
+	return newClient(x.URL, user, password), nil
+}
+
+func newClient(url, user, password string) *Client {
+	return &Client{
+		url:      strings.TrimSuffix(url, "/"),
+		user:     user,
+		password: password,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		schemas:  map[int32]*Schema{},
+	}
+}
+
+func getSecret(ctx context.Context, secretInterface corev1.SecretInterface, name, key string) (string, error) {
+	secret, err := secretInterface.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	d, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in secret %q", key, name)
+	}
+	return string(d), nil
+}
+
+type schemaResponse struct {
+	ID         int32           `json:"id"`
+	Schema     string          `json:"schema"`
+	SchemaType dfv1.SchemaType `json:"schemaType"` // omitted for Avro
+	References []interface{}   `json:"references"`
+}
+
+// Decode decodes a message framed with a schema ID to JSON. Messages that are not framed are returned unchanged.
+func (c *Client) Decode(ctx context.Context, data []byte) ([]byte, error) {
+	if len(data) < headerLen || data[0] != magicByte {
+		return data, nil
+	}
+	s, err := c.GetSchema(ctx, int32(binary.BigEndian.Uint32(data[1:headerLen])))
+	if err != nil {
+		return nil, err
+	}
+	return s.decode(data[headerLen:])
+}
+
+// GetSchema returns the schema with the ID.
+func (c *Client) GetSchema(ctx context.Context, id int32) (*Schema, error) {
+	c.mu.Lock()
+	s, ok := c.schemas[id]
+	c.mu.Unlock()
+	if ok {
+		return s, nil
+	}
+	r := &schemaResponse{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/schemas/ids/%d", id), nil, r); err != nil {
+		return nil, err
+	}
+	if len(r.References) > 0 {
+		return nil, fmt.Errorf("schema %d has references, which are not supported", id)
+	}
+	if r.SchemaType == dfv1.SchemaTypeProtobuf {
+		// the registry compiles the schema to a file descriptor for us, so we do not need to parse .proto files
+		if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/schemas/ids/%d?format=serialized", id), nil, r); err != nil {
+			return nil, err
+		}
+	}
+	s, err := newSchema(id, r.SchemaType, r.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema %d: %w", id, err)
+	}
+	c.mu.Lock()
+	c.schemas[id] = s
+	c.mu.Unlock()
+	return s, nil
+}
+
+// Latest returns the latest version of the subject's schema.
+func (c *Client) Latest(ctx context.Context, subject string) (*Schema, error) {
+	r := &schemaResponse{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/subjects/%s/versions/latest", url.PathEscape(subject)), nil, r); err != nil {
+		return nil, err
+	}
+	return c.GetSchema(ctx, r.ID)
+}
+
+// Register registers the schema with the subject, if it is not already registered, and returns it.
+func (c *Client) Register(ctx context.Context, subject string, schemaType dfv1.SchemaType, schema string) (*Schema, error) {
+	req := &schemaResponse{Schema: schema}
+	if schemaType != dfv1.SchemaTypeAvro {
+		req.SchemaType = schemaType
+	}
+	r := &schemaResponse{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/subjects/%s/versions", url.PathEscape(subject)), req, r); err != nil {
+		return nil, err
+	}
+	return c.GetSchema(ctx, r.ID)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.schemaregistry.v1+json")
+	req.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	if c.user != "" || c.password != "" {
+		req.SetBasicAuth(c.user, c.password)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to %s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response to %s %s: %w", method, path, err)
+	}
+	if resp.StatusCode >= 300 {
+		e := &struct {
+			Message string `json:"message"`
+		}{}
+		_ = json.Unmarshal(data, e)
+		return fmt.Errorf("failed to %s %s: %q %s", method, path, resp.Status, e.Message)
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,221 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeRegistry is a stand-in for a schema registry, it compiles Protobuf schemas by looking them up in protos
+type fakeRegistry struct {
+	mu       sync.Mutex
+	schemas  []schemaResponse
+	subjects map[string][]int32
+	protos   map[string]string // schema -> serialized
+	requests int
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{subjects: map[string][]int32{}, protos: map[string]string{}}
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests++
+	if u, p, _ := r.BasicAuth(); u != "my-user" || p != "my-password" {
+		w.WriteHeader(401)
+		_, _ = w.Write([]byte(`{"error_code": 401, "message": "Unauthorized"}`))
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/schemas/ids/"):
+		var id int32
+		_, _ = fmt.Sscanf(r.URL.Path, "/schemas/ids/%d", &id)
+		if id < 1 || int(id) > len(f.schemas) {
+			w.WriteHeader(404)
+			_, _ = w.Write([]byte(`{"error_code": 40403, "message": "Schema not found"}`))
+			return
+		}
+		s := f.schemas[id-1]
+		if r.URL.Query().Get("format") == "serialized" {
+			s.Schema = f.protos[s.Schema]
+		}
+		_ = json.NewEncoder(w).Encode(s)
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/versions/latest"):
+		subject := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/subjects/"), "/versions/latest")
+		ids := f.subjects[subject]
+		if len(ids) == 0 {
+			w.WriteHeader(404)
+			_, _ = w.Write([]byte(`{"error_code": 40401, "message": "Subject not found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(f.schemas[ids[len(ids)-1]-1])
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/versions"):
+		subject := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/subjects/"), "/versions")
+		s := schemaResponse{}
+		_ = json.NewDecoder(r.Body).Decode(&s)
+		s.ID = f.register(subject, s.SchemaType, s.Schema)
+		_ = json.NewEncoder(w).Encode(map[string]int32{"id": s.ID})
+	default:
+		w.WriteHeader(404)
+	}
+}
+
+func (f *fakeRegistry) register(subject string, schemaType dfv1.SchemaType, schema string) int32 {
+	for i, s := range f.schemas {
+		if s.Schema == schema {
+			return int32(i + 1)
+		}
+	}
+	id := int32(len(f.schemas) + 1)
+	f.schemas = append(f.schemas, schemaResponse{ID: id, Schema: schema, SchemaType: schemaType})
+	f.subjects[subject] = append(f.subjects[subject], id)
+	return id
+}
+
+const avroSchema = `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "amount", "type": "long"}]}`
+
+const protoSchema = `syntax = "proto3"; message Order { string id = 1; int64 amount = 2; message Item { string sku = 1; } }`
+
+func serializedProto(t *testing.T) string {
+	str := func(s string) *string { return &s }
+	num := func(n int32) *int32 { return &n }
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	data, err := proto.Marshal(&descriptorpb.FileDescriptorProto{
+		Name:   str("order.proto"),
+		Syntax: str("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: str("Order"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: str("id"), JsonName: str("id"), Number: num(1), Label: optional, Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+				{Name: str("amount"), JsonName: str("amount"), Number: num(2), Label: optional, Type: descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()},
+			},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name: str("Item"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: str("sku"), JsonName: str("sku"), Number: num(1), Label: optional, Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+				},
+			}},
+		}},
+	})
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func newTestClient(t *testing.T) (*Client, *fakeRegistry) {
+	f := newFakeRegistry()
+	f.protos[protoSchema] = serializedProto(t)
+	s := httptest.NewServer(f)
+	t.Cleanup(s.Close)
+	return newClient(s.URL+"/", "my-user", "my-password"), f
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	secrets := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret"},
+		Data:       map[string][]byte{"user": []byte("my-user"), "password": []byte("my-password")},
+	}).CoreV1().Secrets("")
+	_, err := New(ctx, secrets, dfv1.SchemaRegistry{})
+	assert.EqualError(t, err, "schema registry URL is required")
+	c, err := New(ctx, secrets, dfv1.SchemaRegistry{
+		URL:            "http://schema-registry:8081",
+		UserSecret:     &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}, Key: "user"},
+		PasswordSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}, Key: "password"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "my-user", c.user)
+	assert.Equal(t, "my-password", c.password)
+	_, err = New(ctx, secrets, dfv1.SchemaRegistry{
+		URL:        "http://schema-registry:8081",
+		UserSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}, Key: "foo"},
+	})
+	assert.EqualError(t, err, `failed to get user secret: key "foo" not found in secret "my-secret"`)
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Unauthorized", func(t *testing.T) {
+		c, _ := newTestClient(t)
+		c.password = "foo"
+		_, err := c.GetSchema(ctx, 1)
+		assert.EqualError(t, err, `failed to GET /schemas/ids/1: "401 Unauthorized" Unauthorized`)
+	})
+	t.Run("NotFramed", func(t *testing.T) {
+		c, f := newTestClient(t)
+		data, err := c.Decode(ctx, []byte(`{"id": "1"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, `{"id": "1"}`, string(data))
+		assert.Zero(t, f.requests)
+	})
+	t.Run("Avro", func(t *testing.T) {
+		c, f := newTestClient(t)
+		s, err := c.Register(ctx, "orders-value", dfv1.SchemaTypeAvro, avroSchema)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), s.ID)
+		data, err := s.Encode([]byte(`{"id": "1", "amount": 10}`))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0, 0, 0, 0, 1, 2, '1', 20}, data)
+		data, err = c.Decode(ctx, data)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id": "1", "amount": 10}`, string(data))
+		_, err = s.Encode([]byte(`{"id": 1}`))
+		assert.Error(t, err)
+		requests := f.requests
+		_, err = c.GetSchema(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, requests, f.requests, "schemas are cached")
+	})
+	t.Run("Protobuf", func(t *testing.T) {
+		c, _ := newTestClient(t)
+		_, err := c.Register(ctx, "orders-value", dfv1.SchemaTypeProtobuf, protoSchema)
+		assert.NoError(t, err)
+		s, err := c.Latest(ctx, "orders-value")
+		assert.NoError(t, err)
+		data, err := s.Encode([]byte(`{"id": "1", "amount": "10"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0, 0, 0, 0, 1, 0, 10, 1, '1', 16, 10}, data)
+		data, err = c.Decode(ctx, data)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id": "1", "amount": "10"}`, string(data))
+		// Order.Item is message indexes [0, 0], i.e. a count of 2 followed by two zeros, zig-zag encoded
+		data, err = c.Decode(ctx, []byte{0, 0, 0, 0, 1, 4, 0, 0, 10, 1, 'a'})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"sku": "a"}`, string(data))
+		_, err = c.Decode(ctx, []byte{0, 0, 0, 0, 1, 2, 2})
+		assert.EqualError(t, err, "message index [1] not found in schema 1")
+	})
+	t.Run("JSON", func(t *testing.T) {
+		c, _ := newTestClient(t)
+		s, err := c.Register(ctx, "orders-value", dfv1.SchemaTypeJSON, `{"type": "object", "required": ["id"]}`)
+		assert.NoError(t, err)
+		data, err := s.Encode([]byte(`{"id": "1"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, append([]byte{0, 0, 0, 0, 1}, `{"id": "1"}`...), data)
+		data, err = c.Decode(ctx, data)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"id": "1"}`, string(data))
+		_, err = s.Encode([]byte(`{}`))
+		assert.Error(t, err)
+	})
+	t.Run("UnknownSchema", func(t *testing.T) {
+		c, _ := newTestClient(t)
+		_, err := c.Decode(ctx, []byte{0, 0, 0, 0, 9, 1})
+		assert.EqualError(t, err, `failed to GET /schemas/ids/9: "404 Not Found" Schema not found`)
+	})
+}
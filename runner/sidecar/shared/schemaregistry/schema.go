@@ -0,0 +1,169 @@
+package schemaregistry
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/linkedin/goavro/v2"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// messages are framed as {magicByte}{4 byte big-endian schema ID}{payload}, Protobuf payloads are prefixed with the
+// indexes of the message type within the file
+const (
+	magicByte = 0
+	headerLen = 5
+)
+
+type Schema struct {
+	ID       int32
+	Type     dfv1.SchemaType
+	avro     *goavro.Codec
+	protobuf protoreflect.FileDescriptor
+	json     *jsonschema.Schema
+}
+
+func newSchema(id int32, schemaType dfv1.SchemaType, schema string) (*Schema, error) {
+	s := &Schema{ID: id, Type: schemaType}
+	var err error
+	switch schemaType {
+	case "", dfv1.SchemaTypeAvro:
+		s.Type = dfv1.SchemaTypeAvro
+		s.avro, err = goavro.NewCodec(schema)
+	case dfv1.SchemaTypeProtobuf:
+		s.protobuf, err = newFileDescriptor(schema)
+	case dfv1.SchemaTypeJSON:
+		s.json, err = jsonschema.CompileString(fmt.Sprintf("%d.json", id), schema)
+	default:
+		err = fmt.Errorf("unknown schema type %q", schemaType)
+	}
+	return s, err
+}
+
+// newFileDescriptor creates a file descriptor from a base64 encoded FileDescriptorProto
+func newFileDescriptor(schema string) (protoreflect.FileDescriptor, error) {
+	data, err := base64.StdEncoding.DecodeString(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode serialized schema: %w", err)
+	}
+	fd := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(data, fd); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal file descriptor: %w", err)
+	}
+	return protodesc.NewFile(fd, protoregistry.GlobalFiles)
+}
+
+func (s *Schema) header() []byte {
+	h := make([]byte, headerLen)
+	h[0] = magicByte
+	binary.BigEndian.PutUint32(h[1:], uint32(s.ID))
+	return h
+}
+
+func (s *Schema) decode(payload []byte) ([]byte, error) {
+	switch s.Type {
+	case dfv1.SchemaTypeAvro:
+		native, _, err := s.avro.NativeFromBinary(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode Avro: %w", err)
+		}
+		return s.avro.TextualFromNative(nil, native)
+	case dfv1.SchemaTypeProtobuf:
+		indexes, payload, err := readMessageIndexes(payload)
+		if err != nil {
+			return nil, err
+		}
+		md, err := s.messageDescriptor(indexes)
+		if err != nil {
+			return nil, err
+		}
+		m := dynamicpb.NewMessage(md)
+		if err := proto.Unmarshal(payload, m); err != nil {
+			return nil, fmt.Errorf("failed to decode Protobuf: %w", err)
+		}
+		return protojson.Marshal(m)
+	default:
+		return payload, nil
+	}
+}
+
+// Encode encodes a JSON message, and frames it with the schema ID. Protobuf messages are encoded as the first message
+// type in the schema.
+func (s *Schema) Encode(msg []byte) ([]byte, error) {
+	switch s.Type {
+	case dfv1.SchemaTypeAvro:
+		native, _, err := s.avro.NativeFromTextual(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode Avro: %w", err)
+		}
+		return s.avro.BinaryFromNative(s.header(), native)
+	case dfv1.SchemaTypeProtobuf:
+		md, err := s.messageDescriptor([]int{0})
+		if err != nil {
+			return nil, err
+		}
+		m := dynamicpb.NewMessage(md)
+		if err := protojson.Unmarshal(msg, m); err != nil {
+			return nil, fmt.Errorf("failed to encode Protobuf: %w", err)
+		}
+		// a single zero is short-hand for the first message type, and deterministic marshalling encodes the same message to
+		// the same bytes
+		return proto.MarshalOptions{Deterministic: true}.MarshalAppend(append(s.header(), 0), m)
+	default:
+		var v interface{}
+		d := json.NewDecoder(bytes.NewReader(msg))
+		d.UseNumber()
+		if err := d.Decode(&v); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+		}
+		if err := s.json.Validate(v); err != nil {
+			return nil, err
+		}
+		return append(s.header(), msg...), nil
+	}
+}
+
+func (s *Schema) messageDescriptor(indexes []int) (protoreflect.MessageDescriptor, error) {
+	messages := s.protobuf.Messages()
+	var md protoreflect.MessageDescriptor
+	for _, i := range indexes {
+		if i < 0 || i >= messages.Len() {
+			return nil, fmt.Errorf("message index %v not found in schema %d", indexes, s.ID)
+		}
+		md = messages.Get(i)
+		messages = md.Messages()
+	}
+	return md, nil
+}
+
+func readMessageIndexes(payload []byte) ([]int, []byte, error) {
+	n, l := binary.Varint(payload)
+	if l <= 0 || n < 0 {
+		return nil, nil, fmt.Errorf("invalid message indexes")
+	}
+	payload = payload[l:]
+	if n == 0 {
+		return []int{0}, payload, nil
+	}
+	indexes := make([]int, n)
+	for i := range indexes {
+		v, l := binary.Varint(payload)
+		if l <= 0 {
+			return nil, nil, fmt.Errorf("invalid message indexes")
+		}
+		indexes[i] = int(v)
+		payload = payload[l:]
+	}
+	return indexes, payload, nil
+}
@@ -115,7 +115,7 @@ func Exec(ctx context.Context) error {
 	defer stop()
 	defer preStop("defer")
 
-	sink, dlq, err := connectSinks(ctx)
+	sink, dlq, breakers, err := connectSinks(ctx)
 	if err != nil {
 		return err
 	}
@@ -196,7 +196,7 @@ func Exec(ctx context.Context) error {
 		return err
 	}
 
-	if err := connectSources(ctx, process, dlq); err != nil {
+	if err := connectSources(ctx, process, dlq, breakers); err != nil {
 		return err
 	}
 
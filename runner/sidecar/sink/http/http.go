@@ -9,6 +9,7 @@ import (
 	"time"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/cloudevents"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink"
 	"github.com/opentracing/opentracing-go"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,10 +17,11 @@ import (
 )
 
 type httpSink struct {
-	sinkName string
-	header   http.Header
-	client   *http.Client
-	url      string
+	sinkName    string
+	header      http.Header
+	client      *http.Client
+	url         string
+	cloudEvents dfv1.CloudEventsMode
 }
 
 func New(ctx context.Context, sinkName string, secretInterface corev1.SecretInterface, x dfv1.HTTPSink) (sink.Interface, error) {
@@ -46,23 +48,34 @@ func New(ctx context.Context, sinkName string, secretInterface corev1.SecretInte
 		header,
 		&http.Client{Timeout: 10 * time.Second, Transport: t},
 		x.URL,
+		x.CloudEvents,
 	}, nil
 }
 
 func (h httpSink) Sink(ctx context.Context, msg []byte) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, fmt.Sprintf("http-sink-%s", h.sinkName))
 	defer span.Finish()
-	req, err := http.NewRequestWithContext(ctx, "POST", h.url, bytes.NewBuffer(msg))
-	if err != nil {
-		return fmt.Errorf("failed to create HTTP request: %w", err)
-	}
-	req.Header = h.header.Clone() // must clone to prevent concurrency issues
-	if err := opentracing.GlobalTracer().Inject(span.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(req.Header)); err != nil {
+	header := h.header.Clone() // must clone to prevent concurrency issues
+	if err := opentracing.GlobalTracer().Inject(span.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(header)); err != nil {
 		return fmt.Errorf("failed to inject tracing headers: %w", err)
 	}
-	if err := dfv1.MetaInject(ctx, req.Header); err != nil {
+	body := msg
+	if h.cloudEvents != "" {
+		m, err := dfv1.MetaFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if body, err = cloudevents.Encode(h.cloudEvents, m, msg, header); err != nil {
+			return fmt.Errorf("failed to encode CloudEvent: %w", err)
+		}
+	} else if err := dfv1.MetaInject(ctx, header); err != nil {
 		return err
 	}
+	req, err := http.NewRequestWithContext(ctx, "POST", h.url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	req.Header = header
 	if resp, err := h.client.Do(req); err != nil {
 		return fmt.Errorf("failed to send HTTP request: %w", err)
 	} else {
@@ -46,7 +46,12 @@ func (j jsSink) Sink(ctx context.Context, msg []byte) error {
 	if err != nil {
 		return err
 	}
-	if _, err := j.js.Publish(j.subject, msg, nats.MsgId(m.ID)); err != nil {
+	x := nats.NewMsg(j.subject)
+	x.Data = msg
+	for k, v := range m.Headers {
+		x.Header.Set(k, v)
+	}
+	if _, err := j.js.PublishMsg(x, nats.MsgId(m.ID)); err != nil {
 		return err
 	}
 	return nil
@@ -2,13 +2,21 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"os"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
 	sharedkafka "github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/kafka"
+	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/schemaregistry"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink"
+	"github.com/argoproj-labs/argo-dataflow/runner/util"
 	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
 	kafka "github.com/confluentinc/confluent-kafka-go/kafka"
 	"github.com/opentracing/opentracing-go"
@@ -21,15 +29,59 @@ import (
 var logger = sharedutil.NewLogger()
 
 type kafkaSink struct {
-	sinkName string
-	producer *kafka.Producer
-	topic    string
-	async    bool
-	inflight *semaphore.Weighted
+	sinkName  string
+	producer  *kafka.Producer
+	topic     string
+	async     bool
+	inflight  *semaphore.Weighted
+	key       *vm.Program
+	partition *vm.Program
+	headers   []header
+	// transactions are per-producer, so only one message can be written at a time
+	transactionMu *sync.Mutex
+	// schema is nil unless messages should be encoded
+	schema *schemaregistry.Schema
 }
 
-func New(ctx context.Context, sinkName string, secretInterface corev1.SecretInterface, x dfv1.KafkaSink, errorsCounter prometheus.Counter) (sink.Interface, error) {
+type header struct {
+	name string
+	prog *vm.Program
+}
+
+func New(ctx context.Context, sinkName, transactionalID string, secretInterface corev1.SecretInterface, x dfv1.KafkaSink, errorsCounter prometheus.Counter) (sink.Interface, error) {
 	logger := logger.WithValues("sink", sinkName)
+	if x.Topic == "" {
+		return nil, fmt.Errorf("Kafka sink %q must have a topic", sinkName)
+	}
+	key, err := compile(x.Key)
+	if err != nil {
+		return nil, err
+	}
+	partition, err := compile(x.Partition)
+	if err != nil {
+		return nil, err
+	}
+	headers, err := compileHeaders(x.Headers)
+	if err != nil {
+		return nil, err
+	}
+	var schema *schemaregistry.Schema
+	if r := x.SchemaRegistry; r != nil {
+		client, err := schemaregistry.New(ctx, secretInterface, r.SchemaRegistry)
+		if err != nil {
+			return nil, err
+		}
+		subject := r.GetSubject(x.Topic)
+		if r.Schema != "" {
+			schema, err = client.Register(ctx, subject, r.GetSchemaType(), r.Schema)
+		} else {
+			schema, err = client.Latest(ctx, subject)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get schema for subject %q: %w", subject, err)
+		}
+		logger.Info("encoding messages with schema", "subject", subject, "schemaID", schema.ID)
+	}
 	config, err := sharedkafka.GetConfig(ctx, secretInterface, x.KafkaConfig)
 	if err != nil {
 		return nil, err
@@ -45,6 +97,13 @@ func New(ctx context.Context, sinkName string, secretInterface corev1.SecretInte
 	config["compression.type"] = x.CompressionType
 	config["acks"] = x.GetAcks()
 	config["enable.idempotence"] = x.EnableIdempotence
+	if x.Transactional {
+		if x.Async {
+			return nil, fmt.Errorf("transactional Kafka sink %q cannot be async", sinkName)
+		}
+		config["transactional.id"] = transactionalID
+		config["enable.idempotence"] = true
+	}
 	if x.Async { // this is meant to be set by `enable.idempotence` automatically, but I'm not sure it is
 		config["retries"] = math.MaxInt32
 	}
@@ -63,6 +122,16 @@ func New(ctx context.Context, sinkName string, secretInterface corev1.SecretInte
 		}
 	}, 3*time.Second, 1.2, true)
 
+	var transactionMu *sync.Mutex
+	if x.Transactional {
+		logger.Info("initializing transactions", "transactionalID", transactionalID)
+		if err := producer.InitTransactions(ctx); err != nil {
+			producer.Close()
+			return nil, fmt.Errorf("failed to initialize transactions: %w", err)
+		}
+		transactionMu = &sync.Mutex{}
+	}
+
 	inflight := semaphore.NewWeighted(int64(x.GetMessageInflight()))
 
 	go wait.JitterUntilWithContext(ctx, func(context.Context) {
@@ -85,9 +154,44 @@ func New(ctx context.Context, sinkName string, secretInterface corev1.SecretInte
 		x.Topic,
 		x.Async,
 		inflight,
+		key,
+		partition,
+		headers,
+		transactionMu,
+		schema,
 	}, nil
 }
 
+// compile returns nil for an empty expression
+func compile(expression string) (*vm.Program, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	prog, err := expr.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile %q: %w", expression, err)
+	}
+	return prog, nil
+}
+
+// compileHeaders returns the headers sorted by name, so they are always written in the same order
+func compileHeaders(headers map[string]string) ([]header, error) {
+	var names []string
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var x []header
+	for _, name := range names {
+		prog, err := compile(headers[name])
+		if err != nil {
+			return nil, err
+		}
+		x = append(x, header{name, prog})
+	}
+	return x, nil
+}
+
 func (h *kafkaSink) Sink(ctx context.Context, msg []byte) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, fmt.Sprintf("kafka-sink-%s", h.sinkName))
 	defer span.Finish()
@@ -95,6 +199,13 @@ func (h *kafkaSink) Sink(ctx context.Context, msg []byte) error {
 	if err != nil {
 		return err
 	}
+	message, err := h.message(ctx, m, msg)
+	if err != nil {
+		return err
+	}
+	if h.transactionMu != nil {
+		return h.sinkTransaction(ctx, message)
+	}
 	var deliveryChan chan kafka.Event
 	if !h.async {
 		deliveryChan = make(chan kafka.Event)
@@ -103,14 +214,8 @@ func (h *kafkaSink) Sink(ctx context.Context, msg []byte) error {
 	if err := h.inflight.Acquire(ctx, 1); err != nil {
 		return err
 	}
-	if err := h.producer.Produce(&kafka.Message{
-		TopicPartition: kafka.TopicPartition{Topic: &h.topic, Partition: kafka.PartitionAny},
-		Headers: []kafka.Header{
-			{Key: "source", Value: []byte(m.Source)},
-			{Key: "id", Value: []byte(m.ID)},
-		},
-		Value: msg,
-	}, deliveryChan); err != nil {
+	if err := h.producer.Produce(message, deliveryChan); err != nil {
+		h.inflight.Release(1)
 		return err
 	}
 	if deliveryChan != nil {
@@ -130,6 +235,142 @@ func (h *kafkaSink) Sink(ctx context.Context, msg []byte) error {
 	return nil
 }
 
+// sinkTransaction writes the message in a transaction, together with the source's offsets if the message came from a
+// Kafka source, so the message is written once, and only if the offsets are committed
+func (h *kafkaSink) sinkTransaction(ctx context.Context, message *kafka.Message) error {
+	h.transactionMu.Lock()
+	defer h.transactionMu.Unlock()
+	if err := h.producer.BeginTransaction(); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// buffered, so the delivery report does not block while we commit
+	deliveryChan := make(chan kafka.Event, 1)
+	if err := h.producer.Produce(message, deliveryChan); err != nil {
+		return h.abortTransaction(ctx, fmt.Errorf("failed to produce message: %w", err))
+	}
+	offsets := sharedkafka.OffsetsFromContext(ctx)
+	if offsets != nil {
+		metadata, err := offsets.GetConsumerGroupMetadata()
+		if err != nil {
+			return h.abortTransaction(ctx, fmt.Errorf("failed to get consumer group metadata: %w", err))
+		}
+		if err := h.producer.SendOffsetsToTransaction(ctx, offsets.Get(), metadata); err != nil {
+			return h.abortTransaction(ctx, fmt.Errorf("failed to send offsets to transaction: %w", err))
+		}
+	}
+	// committing flushes the message, so there is no need to wait for the delivery report first
+	if err := h.producer.CommitTransaction(ctx); err != nil {
+		return h.abortTransaction(ctx, fmt.Errorf("failed to commit transaction: %w", err))
+	}
+	if offsets != nil {
+		offsets.MarkCommitted()
+	}
+	select {
+	case e := <-deliveryChan:
+		if ev, ok := e.(*kafka.Message); ok && ev.TopicPartition.Error != nil {
+			logger.Error(ev.TopicPartition.Error, "delivery failed, but transaction committed", "sinkName", h.sinkName)
+		}
+	default:
+	}
+	return nil
+}
+
+func (h *kafkaSink) abortTransaction(ctx context.Context, err error) error {
+	var kafkaErr kafka.Error
+	if errors.As(err, &kafkaErr) && kafkaErr.IsFatal() {
+		// the producer cannot be used again, e.g. because it has been fenced by a newer instance with the same ID, so
+		// exit, and the kubelet restarts the sidecar with a new producer; the source's offsets were not committed, so
+		// uncommitted messages are processed again
+		logger.Error(err, "fatal transaction error, exiting so the sidecar is restarted", "sinkName", h.sinkName)
+		os.Exit(1)
+	}
+	if abortErr := h.producer.AbortTransaction(ctx); abortErr != nil {
+		return fmt.Errorf("%v, and failed to abort transaction: %w", err, abortErr)
+	}
+	return err
+}
+
+// message creates the Kafka message, evaluating the key, partition and header expressions
+func (h *kafkaSink) message(ctx context.Context, m dfv1.Meta, msg []byte) (*kafka.Message, error) {
+	value := msg
+	if h.schema != nil {
+		var err error
+		if value, err = h.schema.Encode(msg); err != nil {
+			return nil, fmt.Errorf("failed to encode message: %w", err)
+		}
+	}
+	message := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &h.topic, Partition: kafka.PartitionAny},
+		Headers: []kafka.Header{
+			{Key: "source", Value: []byte(m.Source)},
+			{Key: "id", Value: []byte(m.ID)},
+		},
+		Value: value,
+	}
+	// the user's headers, sorted by key, so they are always written in the same order
+	var keys []string
+	for k := range m.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		message.Headers = append(message.Headers, kafka.Header{Key: k, Value: []byte(m.Headers[k])})
+	}
+	if h.key == nil && h.partition == nil && len(h.headers) == 0 {
+		return message, nil
+	}
+	env, err := util.ExprEnv(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expr env: %w", err)
+	}
+	if h.key != nil {
+		if message.Key, err = runBytes(h.key, env); err != nil {
+			return nil, fmt.Errorf("failed to evaluate key: %w", err)
+		}
+	}
+	if h.partition != nil {
+		res, err := expr.Run(h.partition, env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate partition: %w", err)
+		}
+		switch v := res.(type) {
+		case int:
+			message.TopicPartition.Partition = int32(v)
+		case int32:
+			message.TopicPartition.Partition = v
+		case int64:
+			message.TopicPartition.Partition = int32(v)
+		case float64:
+			message.TopicPartition.Partition = int32(v)
+		default:
+			return nil, fmt.Errorf("partition expression must return a number, got %T", res)
+		}
+	}
+	for _, x := range h.headers {
+		value, err := runBytes(x.prog, env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate header %q: %w", x.name, err)
+		}
+		message.Headers = append(message.Headers, kafka.Header{Key: x.name, Value: value})
+	}
+	return message, nil
+}
+
+func runBytes(prog *vm.Program, env map[string]interface{}) ([]byte, error) {
+	res, err := expr.Run(prog, env)
+	if err != nil {
+		return nil, err
+	}
+	switch v := res.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("expression must return a string or bytes, got %T", res)
+	}
+}
+
 func (h *kafkaSink) Close() error {
 	logger.Info("flushing producer")
 	unflushedMessages := h.producer.Flush(15 * 1000)
@@ -0,0 +1,89 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("NoTopic", func(t *testing.T) {
+		_, err := New(context.Background(), "my-sink", "my-id", nil, dfv1.KafkaSink{}, nil)
+		assert.EqualError(t, err, `Kafka sink "my-sink" must have a topic`)
+	})
+	t.Run("TransactionalAsync", func(t *testing.T) {
+		batchSize := resource.MustParse("100Ki")
+		acks := intstr.FromString("all")
+		_, err := New(context.Background(), "my-sink", "my-id", nil, dfv1.KafkaSink{
+			Kafka:          dfv1.Kafka{Topic: "my-topic"},
+			Async:          true,
+			Transactional:  true,
+			BatchSize:      &batchSize,
+			Acks:           &acks,
+			MessageTimeout: &metav1.Duration{},
+		}, nil)
+		assert.EqualError(t, err, `transactional Kafka sink "my-sink" cannot be async`)
+	})
+}
+
+func Test_kafkaSink_message(t *testing.T) {
+	m := dfv1.Meta{Source: "my-source", ID: "my-id"}
+	ctx := dfv1.ContextWithMeta(context.Background(), m)
+	msg := []byte(`{"userId": "my-user", "partition": 2, "tenant": "my-tenant"}`)
+	t.Run("Default", func(t *testing.T) {
+		h := &kafkaSink{topic: "my-topic"}
+		x, err := h.message(ctx, m, msg)
+		assert.NoError(t, err)
+		assert.Nil(t, x.Key)
+		assert.Equal(t, kafka.PartitionAny, x.TopicPartition.Partition)
+		assert.Equal(t, []kafka.Header{{Key: "source", Value: []byte("my-source")}, {Key: "id", Value: []byte("my-id")}}, x.Headers)
+		assert.Equal(t, msg, x.Value)
+	})
+	t.Run("UserHeaders", func(t *testing.T) {
+		m := dfv1.Meta{Source: "my-source", ID: "my-id", Headers: map[string]string{"tenant-id": "my-tenant", "correlation-id": "my-correlation-id"}}
+		h := &kafkaSink{topic: "my-topic"}
+		x, err := h.message(dfv1.ContextWithMeta(context.Background(), m), m, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, []kafka.Header{
+			{Key: "source", Value: []byte("my-source")},
+			{Key: "id", Value: []byte("my-id")},
+			{Key: "correlation-id", Value: []byte("my-correlation-id")},
+			{Key: "tenant-id", Value: []byte("my-tenant")},
+		}, x.Headers)
+	})
+	t.Run("Expressions", func(t *testing.T) {
+		key, err := compile(`object(msg).userId`)
+		assert.NoError(t, err)
+		partition, err := compile(`object(msg).partition`)
+		assert.NoError(t, err)
+		headers, err := compileHeaders(map[string]string{"tenant": `object(msg).tenant`, "bytes": `bytes("foo")`})
+		assert.NoError(t, err)
+		h := &kafkaSink{topic: "my-topic", key: key, partition: partition, headers: headers}
+		x, err := h.message(ctx, m, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("my-user"), x.Key)
+		assert.Equal(t, int32(2), x.TopicPartition.Partition)
+		assert.Equal(t, []kafka.Header{
+			{Key: "source", Value: []byte("my-source")},
+			{Key: "id", Value: []byte("my-id")},
+			{Key: "bytes", Value: []byte("foo")},
+			{Key: "tenant", Value: []byte("my-tenant")},
+		}, x.Headers)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		key, err := compile(`1`)
+		assert.NoError(t, err)
+		_, err = (&kafkaSink{key: key}).message(ctx, m, msg)
+		assert.EqualError(t, err, "failed to evaluate key: expression must return a string or bytes, got int")
+		partition, err := compile(`"1"`)
+		assert.NoError(t, err)
+		_, err = (&kafkaSink{partition: partition}).message(ctx, m, msg)
+		assert.EqualError(t, err, "partition expression must return a number, got string")
+	})
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
+	"sort"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink"
@@ -94,10 +96,33 @@ func (h s3Sink) Sink(ctx context.Context, msg []byte) error {
 		Bucket:  &h.bucket,
 		Key:     &message.Key,
 		Body:    f,
-		Tagging: pointer.StringPtr(fmt.Sprintf("%s=%s,%s=%s", dfv1.MetaSource, m.Source, dfv1.MetaID, m.ID)),
+		Tagging: pointer.StringPtr(tagging(m)),
 	}, s3.WithAPIOptions(
 		// https://aws.github.io/aws-sdk-go-v2/docs/sdk-utilities/s3/#unseekable-streaming-input
 		v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware,
 	))
 	return err
 }
+
+// S3 allows at most 10 tags on an object
+const maxTags = 10
+
+// tagging returns the object's tags, the message's source and ID, and the user's headers, as a URL query. Only the
+// first headers, by key, that fit within S3's limit are included, rather than failing to write the object.
+func tagging(m dfv1.Meta) string {
+	tags := url.Values{}
+	tags.Set(dfv1.MetaSource, m.Source)
+	tags.Set(dfv1.MetaID, m.ID)
+	keys := make([]string, 0, len(m.Headers))
+	for k := range m.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if len(tags) == maxTags {
+			break
+		}
+		tags.Set(k, m.Headers[k])
+	}
+	return tags.Encode()
+}
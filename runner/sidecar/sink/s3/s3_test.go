@@ -0,0 +1,19 @@
+package s3
+
+import (
+	"fmt"
+	"testing"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_tagging(t *testing.T) {
+	assert.Equal(t, "dataflow-id=my-id&dataflow-source=my-source", tagging(dfv1.Meta{Source: "my-source", ID: "my-id"}))
+	assert.Equal(t, "dataflow-id=my-id&dataflow-source=my-source&tenant+id=my%2Ftenant", tagging(dfv1.Meta{Source: "my-source", ID: "my-id", Headers: map[string]string{"tenant id": "my/tenant"}}))
+	headers := map[string]string{}
+	for i := 0; i < 12; i++ {
+		headers[fmt.Sprintf("h%02d", i)] = "v"
+	}
+	assert.Equal(t, "dataflow-id=my-id&dataflow-source=my-source&h00=v&h01=v&h02=v&h03=v&h04=v&h05=v&h06=v&h07=v", tagging(dfv1.Meta{Source: "my-source", ID: "my-id", Headers: headers}), "at most 10 tags")
+}
@@ -1,23 +1,285 @@
 package volume
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink"
+	"github.com/argoproj-labs/argo-dataflow/runner/util"
+	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
+	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+var logger = sharedutil.NewLogger()
+
+// files are written to a temporary directory, and then renamed into place, so readers never see partial files
+// like the Kubelet's atomic writer, the temporary directory starts with "..", so it is ignored by volume sources
+const tmpDirName = "..tmp"
+
+// rollingPrefix is the prefix of a temporary rolling file, the rest of the name is the escaped final name
+const rollingPrefix = "rolling."
+
 type volumeSink struct {
 	sinkName string
+	dir      string
+	tmpDir   string
+	fileName *vm.Program
+	rolling  *rollingFile
+}
+
+type rollingFile struct {
+	mu      sync.Mutex
+	maxSize int64
+	maxAge  time.Duration
+	file    *os.File
+	name    string
+	size    int64
+	opened  time.Time
+}
+
+func New(ctx context.Context, sinkName string, replica int, x dfv1.VolumeSink) (sink.Interface, error) {
+	return newVolumeSink(ctx, sinkName, filepath.Join(dfv1.PathVarRun, "sinks", sinkName), replica, x)
+}
+
+func newVolumeSink(ctx context.Context, sinkName, dir string, replica int, x dfv1.VolumeSink) (*volumeSink, error) {
+	logger := logger.WithValues("sink", sinkName)
+	prog, err := expr.Compile(x.GetFileName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile %q: %w", x.GetFileName(), err)
+	}
+	// each replica has its own temporary directory, as the volume maybe shared between replicas
+	tmpDir := filepath.Join(dir, tmpDirName, strconv.Itoa(replica))
+	if err := os.MkdirAll(tmpDir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create temporary dir %q: %w", tmpDir, err)
+	}
+	s := &volumeSink{sinkName: sinkName, dir: dir, tmpDir: tmpDir, fileName: prog}
+	if err := s.recover(); err != nil {
+		return nil, err
+	}
+	if r := x.Rolling; r != nil {
+		s.rolling = &rollingFile{maxSize: r.GetMaxSize(), maxAge: r.GetMaxAge()}
+		logger.Info("rolling file", "maxSize", s.rolling.maxSize, "maxAge", s.rolling.maxAge.String())
+		go wait.UntilWithContext(ctx, func(context.Context) {
+			if err := s.rollIfExpired(); err != nil {
+				logger.Error(err, "failed to roll expired file")
+			}
+		}, time.Second)
+	}
+	return s, nil
 }
 
-func New(sinkName string) (sink.Interface, error) {
-	return volumeSink{sinkName}, nil
+// recover removes any partially written files, and renames rolling files that were not rolled-over, e.g. due to a crash,
+// as messages appended to them have already been acknowledged.
+func (s *volumeSink) recover() error {
+	items, err := os.ReadDir(s.tmpDir)
+	if err != nil {
+		return fmt.Errorf("failed to read temporary dir %q: %w", s.tmpDir, err)
+	}
+	for _, item := range items {
+		tmp := filepath.Join(s.tmpDir, item.Name())
+		if name, err := url.PathUnescape(strings.TrimPrefix(item.Name(), rollingPrefix)); strings.HasPrefix(item.Name(), rollingPrefix) && err == nil {
+			logger.Info("recovering rolling file", "sink", s.sinkName, "name", name)
+			if err := s.rename(tmp, name); err != nil {
+				return err
+			}
+		} else {
+			logger.Info("removing partial file", "sink", s.sinkName, "path", tmp)
+			if err := os.Remove(tmp); err != nil {
+				return fmt.Errorf("failed to remove partial file %q: %w", tmp, err)
+			}
+		}
+	}
+	return nil
 }
 
-func (s volumeSink) Sink(ctx context.Context, msg []byte) error {
-	span, _ := opentracing.StartSpanFromContext(ctx, fmt.Sprintf("volume-sink-%s", s.sinkName))
+func (s *volumeSink) Sink(ctx context.Context, msg []byte) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, fmt.Sprintf("volume-sink-%s", s.sinkName))
 	defer span.Finish()
+	if s.rolling != nil {
+		return s.append(ctx, msg)
+	}
+	name, err := s.getFileName(ctx, msg)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(s.tmpDir, uuid.New().String())
+	if err := writeFile(tmp, msg); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return s.rename(tmp, name)
+}
+
+func (s *volumeSink) getFileName(ctx context.Context, msg []byte) (string, error) {
+	env, err := util.ExprEnv(ctx, msg)
+	if err != nil {
+		return "", fmt.Errorf("failed to create expr env: %w", err)
+	}
+	res, err := expr.Run(s.fileName, env)
+	if err != nil {
+		return "", fmt.Errorf("failed to run program: %w", err)
+	}
+	name, ok := res.(string)
+	if !ok {
+		return "", fmt.Errorf("file name expression must return a string")
+	}
+	return name, nil
+}
+
+// getPath returns the path of the named file, which must be within the sink's dir, and not within a dir starting
+// with "..", such as the temporary dir
+func (s *volumeSink) getPath(name string) (string, error) {
+	path := filepath.Join(s.dir, name) // Join cleans the path
+	rel, err := filepath.Rel(s.dir, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("file name %q is not valid", name)
+	}
+	return path, nil
+}
+
+func (s *volumeSink) rename(tmp, name string) error {
+	path, err := s.getPath(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create dir for %q: %w", path, err)
+	}
+	// unlike rename, link fails if the file exists, even if another replica creates it concurrently
+	err = os.Link(tmp, path)
+	if os.IsExist(err) {
+		same, cmpErr := sameContent(tmp, path)
+		if cmpErr != nil {
+			return cmpErr
+		}
+		if same {
+			// an earlier attempt at the same message wrote this file, so the write is already done
+			logger.Info("file exists with the same content", "sink", s.sinkName, "path", path)
+			err = nil
+		} else {
+			ext := filepath.Ext(path)
+			unique := strings.TrimSuffix(path, ext) + "-" + uuid.New().String() + ext
+			logger.Info("file exists, adding unique suffix", "sink", s.sinkName, "path", path, "unique", unique)
+			err = os.Link(tmp, unique)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to link %q to %q: %w", tmp, path, err)
+	}
+	if err := os.Remove(tmp); err != nil {
+		return fmt.Errorf("failed to remove %q: %w", tmp, err)
+	}
+	return nil
+}
+
+func sameContent(a, b string) (bool, error) {
+	x, err := os.ReadFile(a)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %q: %w", a, err)
+	}
+	y, err := os.ReadFile(b)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %q: %w", b, err)
+	}
+	return bytes.Equal(x, y), nil
+}
+
+func writeFile(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create %q: %w", path, err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write %q: %w", path, err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to sync %q: %w", path, err)
+	}
+	return f.Close()
+}
+
+func (s *volumeSink) append(ctx context.Context, msg []byte) error {
+	r := s.rolling
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		name, err := s.getFileName(ctx, msg)
+		if err != nil {
+			return err
+		}
+		if _, err := s.getPath(name); err != nil {
+			return err
+		}
+		path := filepath.Join(s.tmpDir, rollingPrefix+url.PathEscape(name))
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to open %q: %w", path, err)
+		}
+		r.file, r.name, r.size, r.opened = f, name, 0, time.Now()
+	}
+	// we must not modify msg, it maybe shared with other sinks
+	data := make([]byte, len(msg)+1)
+	copy(data, msg)
+	data[len(msg)] = '\n'
+	n, err := r.file.Write(data)
+	r.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to append to %q: %w", r.file.Name(), err)
+	}
+	if r.size >= r.maxSize {
+		return s.roll()
+	}
+	return nil
+}
+
+func (s *volumeSink) rollIfExpired() error {
+	r := s.rolling
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file != nil && time.Since(r.opened) >= r.maxAge {
+		return s.roll()
+	}
+	return nil
+}
+
+// roll must be called while holding the rolling file's lock
+func (s *volumeSink) roll() error {
+	r := s.rolling
+	f := r.file
+	r.file = nil
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to sync %q: %w", f.Name(), err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close %q: %w", f.Name(), err)
+	}
+	logger.Info("rolling file", "sink", s.sinkName, "name", r.name, "size", r.size)
+	return s.rename(f.Name(), r.name)
+}
+
+func (s *volumeSink) Close() error {
+	if r := s.rolling; r != nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.file != nil {
+			return s.roll()
+		}
+	}
 	return nil
 }
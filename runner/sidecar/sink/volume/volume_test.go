@@ -0,0 +1,122 @@
+package volume
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/antonmedv/expr"
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func Test_volumeSink(t *testing.T) {
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "my-id"})
+	t.Run("File", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := newVolumeSink(ctx, "my-sink", dir, 0, dfv1.VolumeSink{FileName: `"foo/" + ctx.id`})
+		assert.NoError(t, err)
+		assert.NoError(t, s.Sink(ctx, []byte("my-msg")))
+		data, err := os.ReadFile(filepath.Join(dir, "foo", "my-id"))
+		assert.NoError(t, err)
+		assert.Equal(t, "my-msg", string(data))
+		items, err := os.ReadDir(s.tmpDir)
+		assert.NoError(t, err)
+		assert.Empty(t, items)
+	})
+	t.Run("DefaultFileName", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := newVolumeSink(ctx, "my-sink", dir, 0, dfv1.VolumeSink{})
+		assert.NoError(t, err)
+		assert.NoError(t, s.Sink(ctx, []byte("my-msg")))
+		_, err = os.Stat(filepath.Join(dir, "my-id"))
+		assert.NoError(t, err)
+	})
+	t.Run("InvalidFileName", func(t *testing.T) {
+		s, err := newVolumeSink(ctx, "my-sink", t.TempDir(), 0, dfv1.VolumeSink{FileName: `"../foo"`})
+		assert.NoError(t, err)
+		assert.Error(t, s.Sink(ctx, []byte("my-msg")))
+		s.fileName, _ = expr.Compile(`"..tmp/foo"`)
+		assert.Error(t, s.Sink(ctx, []byte("my-msg")))
+		s.fileName, _ = expr.Compile(`"foo/../.."`)
+		assert.Error(t, s.Sink(ctx, []byte("my-msg")))
+	})
+	t.Run("DotsInFileName", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := newVolumeSink(ctx, "my-sink", dir, 0, dfv1.VolumeSink{FileName: `"a..b"`})
+		assert.NoError(t, err)
+		assert.NoError(t, s.Sink(ctx, []byte("my-msg")))
+		_, err = os.Stat(filepath.Join(dir, "a..b"))
+		assert.NoError(t, err)
+	})
+	t.Run("ExistingFile", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := newVolumeSink(ctx, "my-sink", dir, 0, dfv1.VolumeSink{FileName: `"foo.txt"`})
+		assert.NoError(t, err)
+		assert.NoError(t, s.Sink(ctx, []byte("foo")))
+		assert.NoError(t, s.Sink(ctx, []byte("bar")))
+		data, err := os.ReadFile(filepath.Join(dir, "foo.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, "foo", string(data), "not overwritten")
+		matches, err := filepath.Glob(filepath.Join(dir, "foo-*.txt"))
+		assert.NoError(t, err)
+		if assert.Len(t, matches, 1) {
+			data, err := os.ReadFile(matches[0])
+			assert.NoError(t, err)
+			assert.Equal(t, "bar", string(data))
+		}
+	})
+	t.Run("Retry", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := newVolumeSink(ctx, "my-sink", dir, 0, dfv1.VolumeSink{})
+		assert.NoError(t, err)
+		ctx := dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: "my-source", ID: "my-id", Time: 1})
+		assert.NoError(t, s.Sink(ctx, []byte("foo")))
+		assert.NoError(t, s.Sink(ctx, []byte("foo")))
+		items, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		var names []string
+		for _, item := range items {
+			names = append(names, item.Name())
+		}
+		assert.Equal(t, []string{"..tmp", "my-id"}, names, "the same message is only written once")
+		tmp, err := os.ReadDir(filepath.Join(dir, "..tmp", "0"))
+		assert.NoError(t, err)
+		assert.Empty(t, tmp)
+	})
+	t.Run("Rolling", func(t *testing.T) {
+		dir := t.TempDir()
+		maxSize := resource.MustParse("8")
+		s, err := newVolumeSink(ctx, "my-sink", dir, 0, dfv1.VolumeSink{FileName: `string(msg)`, Rolling: &dfv1.RollingFile{MaxSize: &maxSize}})
+		assert.NoError(t, err)
+		for _, msg := range []string{"foo", "bar", "baz"} {
+			assert.NoError(t, s.Sink(ctx, []byte(msg)))
+		}
+		data, err := os.ReadFile(filepath.Join(dir, "foo"))
+		assert.NoError(t, err)
+		assert.Equal(t, "foo\nbar\n", string(data))
+		_, err = os.Stat(filepath.Join(dir, "baz"))
+		assert.True(t, os.IsNotExist(err))
+		assert.NoError(t, s.Close())
+		data, err = os.ReadFile(filepath.Join(dir, "baz"))
+		assert.NoError(t, err)
+		assert.Equal(t, "baz\n", string(data))
+	})
+	t.Run("Recover", func(t *testing.T) {
+		dir := t.TempDir()
+		tmpDir := filepath.Join(dir, tmpDirName, "0")
+		assert.NoError(t, os.MkdirAll(tmpDir, 0o700))
+		assert.NoError(t, os.WriteFile(filepath.Join(tmpDir, "partial"), []byte("my-msg"), 0o600))
+		assert.NoError(t, os.WriteFile(filepath.Join(tmpDir, rollingPrefix+"foo%2Fbar"), []byte("my-msg\n"), 0o600))
+		_, err := newVolumeSink(ctx, "my-sink", dir, 0, dfv1.VolumeSink{FileName: `ctx.id`})
+		assert.NoError(t, err)
+		data, err := os.ReadFile(filepath.Join(dir, "foo", "bar"))
+		assert.NoError(t, err)
+		assert.Equal(t, "my-msg\n", string(data))
+		items, err := os.ReadDir(tmpDir)
+		assert.NoError(t, err)
+		assert.Empty(t, items)
+	})
+}
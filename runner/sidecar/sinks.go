@@ -2,9 +2,17 @@ package sidecar
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink"
 	dbsink "github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink/db"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink/http"
@@ -14,14 +22,21 @@ import (
 	s3sink "github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink/s3"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink/stan"
 	volumesink "github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink/volume"
+	"github.com/argoproj-labs/argo-dataflow/runner/tap"
+	"github.com/argoproj-labs/argo-dataflow/runner/util"
 	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
-func connectSinks(ctx context.Context) (func(context.Context, []byte) error, func(context.Context, []byte) error, error) {
+func connectSinks(ctx context.Context) (func(context.Context, []byte) error, func(context.Context, dfv1.DeadLetter) error, circuitBreakers, error) {
 	sinks := map[string]sink.Interface{}
 	dlqSlink := map[string]sink.Interface{}
+	dlqFormats := map[string]dfv1.DeadLetterQueueFormat{}
+	retries := map[string]dfv1.Backoff{}
+	breakers := circuitBreakers{}
+	whens := map[string]*vm.Program{}
+	defaultSinks := map[string]bool{}
 	totalCounter := promauto.NewCounterVec(prometheus.CounterOpts{
 		Subsystem: "sinks",
 		Name:      "total",
@@ -32,6 +47,21 @@ func connectSinks(ctx context.Context) (func(context.Context, []byte) error, fun
 		Name:      "errors",
 		Help:      "Total number of errors, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#sinks_errors",
 	}, []string{"sinkName", "replica", "dlq"})
+	unmatchedCounter := promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "sinks",
+		Name:      "unmatched",
+		Help:      "Total number of messages that did not match any sink, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#sinks_unmatched",
+	}, []string{"replica"})
+	retriesCounter := promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "sinks",
+		Name:      "retries",
+		Help:      "Number of retries, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#sinks_retries",
+	}, []string{"sinkName", "replica", "dlq"})
+	circuitBreakerStateGauge := promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "sinks",
+		Name:      "circuit_breaker_state",
+		Help:      "Circuit breaker state, 0 closed, 1 half-open, 2 open, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#sinks_circuit_breaker_state",
+	}, []string{"sinkName", "replica"})
 
 	for _, s := range step.Spec.Sinks {
 		logger.Info("connecting sink", "sink", sharedutil.MustJSON(s))
@@ -39,46 +69,77 @@ func connectSinks(ctx context.Context) (func(context.Context, []byte) error, fun
 		var err error
 		var sink sink.Interface
 		if _, exists := sinks[sinkName]; exists {
-			return nil, nil, fmt.Errorf("duplicate sink named %q", sinkName)
+			return nil, nil, nil, fmt.Errorf("duplicate sink named %q", sinkName)
+		}
+		if s.Default && (s.DeadLetterQueue || s.When != "") {
+			return nil, nil, nil, fmt.Errorf("default sink %q cannot be a dead-letter queue or have a when expression", sinkName)
+		}
+		if s.When != "" {
+			if s.DeadLetterQueue {
+				return nil, nil, nil, fmt.Errorf("dead-letter queue sink %q cannot have a when expression", sinkName)
+			}
+			prog, err := expr.Compile(s.When)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to compile %q: %w", s.When, err)
+			}
+			whens[sinkName] = prog
 		}
 		if x := s.STAN; x != nil {
 			if sink, err = stan.New(ctx, secretInterface, namespace, pipelineName, stepName, replica, sinkName, *x); err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 		} else if x := s.Kafka; x != nil {
-			if sink, err = kafka.New(ctx, sinkName, secretInterface, *x, errorsCounter.WithLabelValues(sinkName, fmt.Sprint(replica), fmt.Sprint(s.DeadLetterQueue))); err != nil {
-				return nil, nil, err
+			// the transactional ID must be the same after a restart, so that the previous producer's transactions are fenced
+			transactionalID := fmt.Sprintf("%s-%d", sharedutil.GetSinkUID(cluster, namespace, pipelineName, stepName, sinkName), replica)
+			if sink, err = kafka.New(ctx, sinkName, transactionalID, secretInterface, *x, errorsCounter.WithLabelValues(sinkName, fmt.Sprint(replica), fmt.Sprint(s.DeadLetterQueue))); err != nil {
+				return nil, nil, nil, err
 			}
 		} else if x := s.Log; x != nil {
 			sink = logsink.New(sinkName, *x)
 		} else if x := s.HTTP; x != nil {
 			if sink, err = http.New(ctx, sinkName, secretInterface, *x); err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 		} else if x := s.S3; x != nil {
 			if sink, err = s3sink.New(ctx, sinkName, secretInterface, *x); err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 		} else if x := s.DB; x != nil {
 			if sink, err = dbsink.New(ctx, sinkName, secretInterface, *x); err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 		} else if x := s.Volume; x != nil {
-			if sink, err = volumesink.New(sinkName); err != nil {
-				return nil, nil, err
+			if sink, err = volumesink.New(ctx, sinkName, replica, *x); err != nil {
+				return nil, nil, nil, err
 			}
 		} else if x := s.JetStream; x != nil {
 			if sink, err = jssink.New(ctx, secretInterface, namespace, pipelineName, stepName, replica, sinkName, *x); err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 		} else {
-			return nil, nil, fmt.Errorf("sink misconfigured")
+			return nil, nil, nil, fmt.Errorf("sink misconfigured")
 		}
 
+		if x := s.Retry; x != nil {
+			retries[sinkName] = *x
+		}
+		if x := s.CircuitBreaker; x != nil {
+			logger.Info("adding circuit breaker", "sink", sinkName)
+			circuitBreakerStateGauge.WithLabelValues(sinkName, fmt.Sprint(replica)).Set(float64(circuitClosed))
+			breakers[sinkName] = newCircuitBreaker(*x, func(state circuitState) {
+				logger.Info("circuit breaker state changed", "sink", sinkName, "state", state)
+				circuitBreakerStateGauge.WithLabelValues(sinkName, fmt.Sprint(replica)).Set(float64(state))
+			})
+		}
 		if s.DeadLetterQueue {
-			logger.Info("adding DLQ sink", "sink", sinkName)
+			logger.Info("adding DLQ sink", "sink", sinkName, "format", s.GetDeadLetterQueueFormat())
 			dlqSlink[sinkName] = sink
+			dlqFormats[sinkName] = s.GetDeadLetterQueueFormat()
 		} else {
+			if s.Default {
+				logger.Info("adding default sink", "sink", sinkName)
+				defaultSinks[sinkName] = true
+			}
 			sinks[sinkName] = sink
 		}
 		if closer, ok := sinks[sinkName].(io.Closer); ok {
@@ -90,23 +151,194 @@ func connectSinks(ctx context.Context) (func(context.Context, []byte) error, fun
 		}
 	}
 
+	// sinkOnce writes the message to the sink, unless its circuit breaker is open
+	sinkOnce := func(ctx context.Context, sinkName string, f sink.Interface, dlq bool, msg []byte) error {
+		b := breakers[sinkName]
+		if b != nil && !b.allow() {
+			return fmt.Errorf("failed to send message to sink %q: %w", sinkName, errCircuitOpen)
+		}
+		totalCounter.WithLabelValues(sinkName, fmt.Sprint(replica), fmt.Sprint(dlq)).Inc()
+		err := f.Sink(ctx, msg)
+		if b != nil {
+			b.done(err)
+		}
+		if err != nil {
+			errorsCounter.WithLabelValues(sinkName, fmt.Sprint(replica), fmt.Sprint(dlq)).Inc()
+		}
+		return err
+	}
+
+	// sinkWithRetry writes the message to the sink, retrying it with the sink's backoff, if it has one
+	sinkWithRetry := func(ctx context.Context, sinkName string, f sink.Interface, dlq bool, msg []byte) error {
+		x, ok := retries[sinkName]
+		if !ok {
+			return sinkOnce(ctx, sinkName, f, dlq, msg)
+		}
+		backoff := newBackoff(x)
+		for {
+			err := sinkOnce(ctx, sinkName, f, dlq, msg)
+			if err == nil || backoff.Steps <= 0 || errors.Is(err, errCircuitOpen) {
+				return err
+			}
+			logger.Info("failed to send message to sink, retrying", "sink", sinkName, "err", err.Error(), "backoffSteps", backoff.Steps)
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff.Step()):
+				retriesCounter.WithLabelValues(sinkName, fmt.Sprint(replica), fmt.Sprint(dlq)).Inc()
+			}
+		}
+	}
+
+	sinkTo := func(ctx context.Context, sinkName string, msg []byte) error {
+		return sinkWithRetry(ctx, sinkName, sinks[sinkName], false, msg)
+	}
+
+	policy := step.Spec.SinkFailurePolicy
+	logger.Info("sink failure policy", "policy", policy)
+
 	return func(ctx context.Context, msg []byte) error {
-			for sinkName, f := range sinks {
-				totalCounter.WithLabelValues(sinkName, fmt.Sprint(replica), "false").Inc()
-				if err := f.Sink(ctx, msg); err != nil {
-					errorsCounter.WithLabelValues(sinkName, fmt.Sprint(replica), "false").Inc()
-					return err
+			var sinkNames []string
+			for sinkName := range sinks {
+				if defaultSinks[sinkName] {
+					continue
 				}
+				if prog, ok := whens[sinkName]; ok {
+					if match, err := when(ctx, prog, msg); err != nil {
+						return fmt.Errorf("failed to evaluate when expression for sink %q: %w", sinkName, err)
+					} else if !match {
+						continue
+					}
+				}
+				sinkNames = append(sinkNames, sinkName)
 			}
-			return nil
-		}, func(ctx context.Context, msg []byte) error {
+			if len(sinkNames) == 0 && len(sinks) > 0 {
+				unmatchedCounter.WithLabelValues(fmt.Sprint(replica)).Inc()
+				for sinkName := range defaultSinks {
+					sinkNames = append(sinkNames, sinkName)
+				}
+			}
+			defaultTaps.publish(ctx, tap.Event{Type: tap.EventOutput, Sinks: sinkNames}, msg)
+			return sinkAll(ctx, policy, sinkNames, msg, sinkTo)
+		}, func(ctx context.Context, d dfv1.DeadLetter) error {
 			for sinkName, f := range dlqSlink {
-				totalCounter.WithLabelValues(sinkName, fmt.Sprint(replica), "true").Inc()
-				if err := f.Sink(ctx, msg); err != nil {
-					errorsCounter.WithLabelValues(sinkName, fmt.Sprint(replica), "true").Inc()
+				ctx, msg, err := deadLetterMessage(ctx, dlqFormats[sinkName], d)
+				if err != nil {
+					return err
+				}
+				if err := sinkWithRetry(ctx, sinkName, f, true, msg); err != nil {
 					return err
 				}
 			}
 			return nil
-		}, nil
+		}, breakers, nil
+}
+
+func when(ctx context.Context, prog *vm.Program, msg []byte) (bool, error) {
+	env, err := util.ExprEnv(ctx, msg)
+	if err != nil {
+		return false, fmt.Errorf("failed to create expr env: %w", err)
+	}
+	res, err := expr.Run(prog, env)
+	if err != nil {
+		return false, fmt.Errorf("failed to run program: %w", err)
+	}
+	accept, ok := res.(bool)
+	if !ok {
+		return false, fmt.Errorf("must return bool")
+	}
+	return accept, nil
+}
+
+// sinkAll writes the message to the named sinks concurrently, handling failures according to the policy
+func sinkAll(ctx context.Context, policy dfv1.SinkFailurePolicy, sinkNames []string, msg []byte, sinkTo func(ctx context.Context, sinkName string, msg []byte) error) error {
+	delivered := deliveredSinksFromContext(ctx)
+	m, _ := dfv1.MetaFromContext(ctx) // each output of a multipart message has its own ID
+	wg := sync.WaitGroup{}
+	mu := sync.Mutex{}
+	errs := map[string]error{}
+	for _, sinkName := range sinkNames {
+		if policy == dfv1.SinkFailurePolicyRetryFailedOnly && delivered.has(m.ID, sinkName) {
+			continue
+		}
+		wg.Add(1)
+		go func(sinkName string) {
+			defer wg.Done()
+			if err := sinkTo(ctx, sinkName, msg); err != nil {
+				mu.Lock()
+				errs[sinkName] = err
+				mu.Unlock()
+			} else {
+				delivered.add(m.ID, sinkName)
+			}
+		}(sinkName)
+	}
+	wg.Wait()
+	if len(errs) == 0 {
+		return nil
+	}
+	err := newSinksError(errs)
+	if policy == dfv1.SinkFailurePolicyBestEffort {
+		logger.Error(err, "failed to send message to some sinks, ignoring as sink failure policy is best effort")
+		return nil
+	}
+	return err
+}
+
+func newSinksError(errs map[string]error) error {
+	var sinkNames []string
+	for sinkName := range errs {
+		sinkNames = append(sinkNames, sinkName)
+	}
+	sort.Strings(sinkNames)
+	var msgs []string
+	for _, sinkName := range sinkNames {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", sinkName, errs[sinkName]))
+	}
+	return fmt.Errorf("failed to send message to %d sink(s): %s", len(errs), strings.Join(msgs, "; "))
+}
+
+type deliveredSinksKey struct{}
+
+// deliveredSinks records the sinks a message has been successfully written to, so that retries of the message can
+// skip them. They are recorded by ID, so each output of a multipart message is recorded separately.
+type deliveredSinks struct {
+	mu    sync.Mutex
+	sinks map[deliveredSink]bool
+}
+
+type deliveredSink struct {
+	id       string
+	sinkName string
+}
+
+func (d *deliveredSinks) add(id, sinkName string) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sinks[deliveredSink{id, sinkName}] = true
+}
+
+func (d *deliveredSinks) has(id, sinkName string) bool {
+	if d == nil {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sinks[deliveredSink{id, sinkName}]
+}
+
+func contextWithDeliveredSinks(ctx context.Context, d *deliveredSinks) context.Context {
+	return context.WithValue(ctx, deliveredSinksKey{}, d)
+}
+
+func deliveredSinksFromContext(ctx context.Context) *deliveredSinks {
+	d, _ := ctx.Value(deliveredSinksKey{}).(*deliveredSinks)
+	return d
+}
+
+func newDeliveredSinks() *deliveredSinks {
+	return &deliveredSinks{sinks: map[deliveredSink]bool{}}
 }
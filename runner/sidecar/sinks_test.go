@@ -0,0 +1,119 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/antonmedv/expr"
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_when(t *testing.T) {
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "my-id"})
+	prog, err := expr.Compile(`string(msg) == "foo"`)
+	assert.NoError(t, err)
+	t.Run("Match", func(t *testing.T) {
+		match, err := when(ctx, prog, []byte("foo"))
+		assert.NoError(t, err)
+		assert.True(t, match)
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		match, err := when(ctx, prog, []byte("bar"))
+		assert.NoError(t, err)
+		assert.False(t, match)
+	})
+	t.Run("NotBool", func(t *testing.T) {
+		prog, err := expr.Compile(`string(msg)`)
+		assert.NoError(t, err)
+		_, err = when(ctx, prog, []byte("foo"))
+		assert.Error(t, err)
+	})
+}
+
+func Test_deliveredSinks(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		d := deliveredSinksFromContext(context.Background())
+		d.add("my-id", "foo")
+		assert.False(t, d.has("my-id", "foo"))
+	})
+	t.Run("Context", func(t *testing.T) {
+		d := deliveredSinksFromContext(contextWithDeliveredSinks(context.Background(), newDeliveredSinks()))
+		assert.False(t, d.has("my-id", "foo"))
+		d.add("my-id", "foo")
+		assert.True(t, d.has("my-id", "foo"))
+		assert.False(t, d.has("my-id", "bar"))
+		assert.False(t, d.has("my-other-id", "foo"))
+	})
+}
+
+func Test_newSinksError(t *testing.T) {
+	err := newSinksError(map[string]error{"foo": fmt.Errorf("foo-error"), "bar": fmt.Errorf("bar-error")})
+	assert.EqualError(t, err, "failed to send message to 2 sink(s): bar: bar-error; foo: foo-error")
+}
+
+func Test_sinkAll(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		policy      dfv1.SinkFailurePolicy
+		failures    map[string][]int // the attempts, starting at 1, each sink fails on
+		wantErr     bool
+		wantAttempt int            // the attempt that succeeded, or the last attempt
+		wantCalls   map[string]int // the number of times each sink was written to
+	}{
+		{"AllOrNothing", dfv1.SinkFailurePolicyAllOrNothing, map[string][]int{"b": {1}}, false, 2, map[string]int{"a": 2, "b": 2}},
+		{"AllOrNothingAlwaysFails", dfv1.SinkFailurePolicyAllOrNothing, map[string][]int{"b": {1, 2, 3}}, true, 3, map[string]int{"a": 3, "b": 3}},
+		{"BestEffort", dfv1.SinkFailurePolicyBestEffort, map[string][]int{"b": {1}}, false, 1, map[string]int{"a": 1, "b": 1}},
+		{"RetryFailedOnly", dfv1.SinkFailurePolicyRetryFailedOnly, map[string][]int{"b": {1}}, false, 2, map[string]int{"a": 1, "b": 2}},
+		{"RetryFailedOnlyBothFail", dfv1.SinkFailurePolicyRetryFailedOnly, map[string][]int{"a": {1}, "b": {1, 2}}, false, 3, map[string]int{"a": 2, "b": 3}},
+		{"RetryFailedOnlyAlwaysFails", dfv1.SinkFailurePolicyRetryFailedOnly, map[string][]int{"b": {1, 2, 3}}, true, 3, map[string]int{"a": 1, "b": 3}},
+		{"NoFailures", dfv1.SinkFailurePolicyRetryFailedOnly, nil, false, 1, map[string]int{"a": 1, "b": 1}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mu := sync.Mutex{}
+			calls := map[string]int{}
+			attempt := 0
+			sinkTo := func(ctx context.Context, sinkName string, msg []byte) error {
+				assert.Equal(t, "my-msg", string(msg))
+				mu.Lock()
+				defer mu.Unlock()
+				calls[sinkName]++
+				for _, n := range tt.failures[sinkName] {
+					if n == attempt {
+						return fmt.Errorf("%s failed on attempt %d", sinkName, attempt)
+					}
+				}
+				return nil
+			}
+			// like processWithRetry, the delivered sinks are shared between attempts
+			ctx := contextWithDeliveredSinks(context.Background(), newDeliveredSinks())
+			var err error
+			for attempt = 1; attempt <= 3; attempt++ {
+				if err = sinkAll(ctx, tt.policy, []string{"a", "b"}, []byte("my-msg"), sinkTo); err == nil {
+					break
+				}
+			}
+			if tt.wantErr {
+				assert.Error(t, err)
+				attempt--
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantAttempt, attempt)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+	t.Run("RetryFailedOnlyWithoutDeliveredSinks", func(t *testing.T) {
+		calls := 0
+		sinkTo := func(context.Context, string, []byte) error {
+			calls++
+			return nil
+		}
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, sinkAll(context.Background(), dfv1.SinkFailurePolicyRetryFailedOnly, []string{"a"}, []byte("my-msg"), sinkTo))
+		}
+		assert.Equal(t, 2, calls, "nothing is recorded, so nothing is skipped")
+	})
+}
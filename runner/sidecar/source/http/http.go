@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/cloudevents"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/source"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
@@ -17,17 +19,18 @@ import (
 )
 
 type httpSource struct {
-	ready bool
+	ready  bool
+	paused int32 // 1 if paused, accessed atomically
 }
 
-func New(ctx context.Context, secretInterface corev1.SecretInterface, pipelineName, stepName, sourceURN, sourceName string, process source.Process) (string, source.Interface, error) {
+func New(ctx context.Context, secretInterface corev1.SecretInterface, pipelineName, stepName, sourceURN, sourceName string, x dfv1.HTTPSource, process source.Process) (string, source.Interface, error) {
 	// we don't want to share this secret
 	secret, err := secretInterface.Get(ctx, pipelineName+"-"+stepName, metav1.GetOptions{})
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to get secret %q: %w", stepName, err)
 	}
 	authorization := string(secret.Data[fmt.Sprintf("sources.%s.http.authorization", sourceName)])
-	h := &httpSource{true}
+	h := &httpSource{ready: true}
 	http.HandleFunc("/sources/"+sourceName, func(w http.ResponseWriter, r *http.Request) {
 		wireContext, err := opentracing.GlobalTracer().Extract(opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(r.Header))
 		operationName := fmt.Sprintf("http-source-%s", sourceName)
@@ -48,6 +51,11 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, pipelineNa
 			_, _ = w.Write([]byte("not ready"))
 			return
 		}
+		if atomic.LoadInt32(&h.paused) == 1 { // clients should retry later
+			w.WriteHeader(503)
+			_, _ = w.Write([]byte("paused"))
+			return
+		}
 		msg, err := ioutil.ReadAll(r.Body)
 		_ = r.Body.Close()
 		if err != nil {
@@ -56,22 +64,27 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, pipelineNa
 			return
 		}
 
-		id := r.Header.Get(dfv1.MetaID)
-		if id == "" {
-			id = uuid.New().String()
+		var m dfv1.Meta
+		if x.CloudEvents != "" {
+			if m, msg, err = cloudevents.Decode(r.Header, msg); err != nil {
+				w.WriteHeader(400)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+		} else {
+			id := r.Header.Get(dfv1.MetaID)
+			if id == "" {
+				id = uuid.New().String()
+			}
+			m = dfv1.Meta{
+				Source:  sourceURN,
+				ID:      id,
+				Time:    time.Now().Unix(),
+				Headers: dfv1.HeadersExtract(r.Header),
+			}
 		}
 
-		if err := process(
-			dfv1.ContextWithMeta(
-				ctx,
-				dfv1.Meta{
-					Source: sourceURN,
-					ID:     id,
-					Time:   time.Now().Unix(),
-				},
-			),
-			msg,
-		); err != nil {
+		if err := process(dfv1.ContextWithMeta(ctx, m), msg); err != nil {
 			w.WriteHeader(500)
 			_, _ = w.Write([]byte(err.Error()))
 		} else {
@@ -81,6 +94,16 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, pipelineNa
 	return authorization, h, nil
 }
 
+func (s *httpSource) Pause(context.Context) error {
+	atomic.StoreInt32(&s.paused, 1)
+	return nil
+}
+
+func (s *httpSource) Resume(context.Context) error {
+	atomic.StoreInt32(&s.paused, 0)
+	return nil
+}
+
 func (s *httpSource) Close() error {
 	s.ready = false
 	return nil
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
 	sharednats "github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/nats"
@@ -17,29 +19,23 @@ import (
 var logger = sharedutil.NewLogger()
 
 type jsSource struct {
-	conn *nats.Conn
-	sub  *nats.Subscription
+	mu      sync.Mutex
+	conn    *nats.Conn
+	sub     *nats.Subscription
+	connect func() (*nats.Conn, *nats.Subscription, error)
 }
 
 func New(ctx context.Context, secretInterface corev1.SecretInterface, cluster, namespace, pipelineName, stepName, sourceURN string, replica int, sourceName string, x dfv1.JetStreamSource, process source.Process) (source.Interface, error) {
-	conn, err := sharednats.ConnectNATS(ctx, secretInterface, x.NATSURL, x.Auth)
-	if err != nil {
-		return nil, err
-	}
-	js, err := conn.JetStream()
-	if err != nil {
-		return nil, err
-	}
 	queueName := sharedutil.GetSourceUID(cluster, namespace, pipelineName, stepName, sourceName)
 	durableName := fmt.Sprintf("%s-%s", queueName, sharedutil.MustHash(x.Subject))
-	sub, err := js.QueueSubscribe(x.Subject, queueName, func(msg *nats.Msg) {
+	handler := func(msg *nats.Msg) {
 		span, ctx := opentracing.StartSpanFromContext(ctx, fmt.Sprintf("jetstream-source-%s", sourceName))
 		defer span.Finish()
 		if metadata, err := msg.Metadata(); err != nil {
 			logger.Error(err, "failed to get message metadata")
 		} else {
 			if err := process(
-				dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: sourceURN, ID: fmt.Sprintf("%v-%v", metadata.Sequence.Consumer, metadata.Sequence.Stream), Time: metadata.Timestamp.Unix()}),
+				dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: sourceURN, ID: fmt.Sprintf("%v-%v", metadata.Sequence.Consumer, metadata.Sequence.Stream), Time: metadata.Timestamp.Unix(), Headers: headers(msg.Header)}),
 				msg.Data,
 			); err != nil {
 				logger.Error(err, "failed to process message")
@@ -51,18 +47,64 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, cluster, n
 				}
 			}
 		}
-	}, nats.ManualAck(), nats.Durable(durableName), nats.DeliverNew())
+	}
+	connect := func() (*nats.Conn, *nats.Subscription, error) {
+		conn, err := sharednats.ConnectNATS(ctx, secretInterface, x.NATSURL, x.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		js, err := conn.JetStream()
+		if err != nil {
+			conn.Close()
+			return nil, nil, err
+		}
+		sub, err := js.QueueSubscribe(x.Subject, queueName, handler, nats.ManualAck(), nats.Durable(durableName), nats.DeliverNew())
+		if err != nil {
+			conn.Close()
+			return nil, nil, err
+		}
+		return conn, sub, nil
+	}
+	conn, sub, err := connect()
 	if err != nil {
 		return nil, err
 	}
 
 	return &jsSource{
-		conn: conn,
-		sub:  sub,
+		conn:    conn,
+		sub:     sub,
+		connect: connect,
 	}, nil
 }
 
-func (j jsSource) Close() error {
+// Pause closes the connection, rather than unsubscribing, as unsubscribing may delete the durable consumer.
+func (j *jsSource) Pause(context.Context) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	logger.Info("closing jetstream source connection to pause")
+	if !j.conn.IsClosed() {
+		j.conn.Close()
+	}
+	return nil
+}
+
+func (j *jsSource) Resume(context.Context) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if !j.conn.IsClosed() {
+		return nil
+	}
+	conn, sub, err := j.connect()
+	if err != nil {
+		return err
+	}
+	j.conn, j.sub = conn, sub
+	return nil
+}
+
+func (j *jsSource) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
 	logger.Info("closing jetstream source connection")
 	if !j.conn.IsClosed() {
 		j.conn.Close()
@@ -70,10 +112,24 @@ func (j jsSource) Close() error {
 	return nil
 }
 
-func (j jsSource) GetPending(ctx context.Context) (uint64, error) {
-	if consumerInfo, err := j.sub.ConsumerInfo(); err != nil {
+func (j *jsSource) GetPending(ctx context.Context) (uint64, error) {
+	j.mu.Lock()
+	sub := j.sub
+	j.mu.Unlock()
+	if consumerInfo, err := sub.ConsumerInfo(); err != nil {
 		return 0, fmt.Errorf("failed to get consumer info: %w", err)
 	} else {
 		return consumerInfo.NumPending, nil
 	}
 }
+
+// headers returns the user's headers from the NATS headers, excluding NATS' own headers, e.g. "Nats-Msg-Id"
+func headers(h nats.Header) map[string]string {
+	headers := map[string]string{}
+	for k := range h {
+		if !strings.HasPrefix(k, "Nats-") {
+			headers[k] = h.Get(k)
+		}
+	}
+	return headers
+}
@@ -0,0 +1,16 @@
+package js
+
+import (
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_headers(t *testing.T) {
+	assert.Empty(t, headers(nil))
+	assert.Equal(t, map[string]string{"tenant-id": "my-tenant"}, headers(nats.Header{
+		"Nats-Msg-Id": {"my-id"},
+		"tenant-id":   {"my-tenant"},
+	}))
+}
@@ -0,0 +1,106 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+type pendingMessage struct {
+	msg  *kafka.Message
+	done bool
+}
+
+// concurrentPartition processes a partition's messages concurrently, and tracks the highest message that can be
+// committed, i.e. the highest message for which it, and all earlier messages, have been processed.
+type concurrentPartition struct {
+	keyOrdered  bool
+	process     func(context.Context, *kafka.Message)
+	sem         chan struct{}
+	wg          sync.WaitGroup
+	mu          sync.Mutex
+	pending     []*pendingMessage        // in offset order
+	committable *kafka.Message           // nil if there is nothing new to commit
+	lastByKey   map[string]chan struct{} // closed once the last message dispatched with the key is processed
+}
+
+func newConcurrentPartition(concurrency int, keyOrdered bool, process func(context.Context, *kafka.Message)) *concurrentPartition {
+	return &concurrentPartition{
+		keyOrdered: keyOrdered,
+		process:    process,
+		sem:        make(chan struct{}, concurrency),
+		lastByKey:  map[string]chan struct{}{},
+	}
+}
+
+// dispatch blocks until the message can be processed, and then processes it in the background. Messages must be
+// dispatched in offset order.
+func (p *concurrentPartition) dispatch(ctx context.Context, msg *kafka.Message) {
+	select {
+	case <-ctx.Done():
+		return
+	case p.sem <- struct{}{}:
+	}
+	m := &pendingMessage{msg: msg}
+	p.mu.Lock()
+	p.pending = append(p.pending, m)
+	var prev, done chan struct{}
+	key := string(msg.Key)
+	if p.keyOrdered {
+		prev = p.lastByKey[key]
+		done = make(chan struct{})
+		p.lastByKey[key] = done
+	}
+	p.mu.Unlock()
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer func() { <-p.sem }()
+		if done != nil {
+			defer p.keyDone(key, done)
+		}
+		if prev != nil {
+			select {
+			case <-ctx.Done():
+				return // not completed, so never committed
+			case <-prev:
+			}
+		}
+		p.process(ctx, msg)
+		p.complete(m)
+	}()
+}
+
+func (p *concurrentPartition) keyDone(key string, done chan struct{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.lastByKey[key] == done {
+		delete(p.lastByKey, key)
+	}
+	close(done)
+}
+
+func (p *concurrentPartition) complete(m *pendingMessage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	m.done = true
+	for len(p.pending) > 0 && p.pending[0].done {
+		p.committable = p.pending[0].msg
+		p.pending = p.pending[1:]
+	}
+}
+
+// takeCommittable returns the highest message that can be committed, or nil if there is nothing new to commit.
+func (p *concurrentPartition) takeCommittable() *kafka.Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	msg := p.committable
+	p.committable = nil
+	return msg
+}
+
+// wait blocks until all dispatched messages have been processed.
+func (p *concurrentPartition) wait() {
+	p.wg.Wait()
+}
@@ -0,0 +1,95 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMessage(offset int64, key string) *kafka.Message {
+	topic := "my-topic"
+	return &kafka.Message{TopicPartition: kafka.TopicPartition{Topic: &topic, Offset: kafka.Offset(offset)}, Key: []byte(key)}
+}
+
+func Test_concurrentPartition(t *testing.T) {
+	t.Run("Committable", func(t *testing.T) {
+		release := map[int64]chan struct{}{0: make(chan struct{}), 1: make(chan struct{}), 2: make(chan struct{})}
+		processed := make(chan int64, 3)
+		p := newConcurrentPartition(3, false, func(ctx context.Context, msg *kafka.Message) {
+			<-release[int64(msg.TopicPartition.Offset)]
+			processed <- int64(msg.TopicPartition.Offset)
+		})
+		for i := int64(0); i < 3; i++ {
+			p.dispatch(context.Background(), newMessage(i, ""))
+		}
+		assert.Nil(t, p.takeCommittable())
+		close(release[1])
+		assert.Equal(t, int64(1), <-processed)
+		assert.Nil(t, p.takeCommittable(), "offset 0 is not processed")
+		close(release[0])
+		assert.Equal(t, int64(0), <-processed)
+		assert.Eventually(t, func() bool {
+			msg := p.takeCommittable()
+			return msg != nil && msg.TopicPartition.Offset == 1
+		}, time.Second, 10*time.Millisecond)
+		close(release[2])
+		p.wait()
+		assert.Equal(t, kafka.Offset(2), p.takeCommittable().TopicPartition.Offset)
+		assert.Nil(t, p.takeCommittable())
+	})
+	t.Run("Concurrency", func(t *testing.T) {
+		mu := sync.Mutex{}
+		inflight, maxInflight := 0, 0
+		p := newConcurrentPartition(2, false, func(ctx context.Context, msg *kafka.Message) {
+			mu.Lock()
+			inflight++
+			if inflight > maxInflight {
+				maxInflight = inflight
+			}
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			inflight--
+			mu.Unlock()
+		})
+		for i := int64(0); i < 6; i++ {
+			p.dispatch(context.Background(), newMessage(i, ""))
+		}
+		p.wait()
+		assert.Equal(t, 2, maxInflight)
+		assert.Equal(t, kafka.Offset(5), p.takeCommittable().TopicPartition.Offset)
+	})
+	t.Run("KeyOrdered", func(t *testing.T) {
+		mu := sync.Mutex{}
+		var order []string
+		p := newConcurrentPartition(4, true, func(ctx context.Context, msg *kafka.Message) {
+			if msg.TopicPartition.Offset == 0 {
+				time.Sleep(20 * time.Millisecond) // would be overtaken, if not key ordered
+			}
+			mu.Lock()
+			order = append(order, string(msg.Key)+"-"+msg.TopicPartition.Offset.String())
+			mu.Unlock()
+		})
+		p.dispatch(context.Background(), newMessage(0, "a"))
+		p.dispatch(context.Background(), newMessage(1, "b"))
+		p.dispatch(context.Background(), newMessage(2, "a"))
+		p.wait()
+		assert.Equal(t, []string{"b-1", "a-0", "a-2"}, order)
+		assert.Empty(t, p.lastByKey)
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		release := make(chan struct{})
+		p := newConcurrentPartition(1, false, func(ctx context.Context, msg *kafka.Message) { <-release })
+		p.dispatch(ctx, newMessage(0, ""))
+		cancel()
+		p.dispatch(ctx, newMessage(1, "")) // does not block
+		close(release)
+		p.wait()
+		assert.Equal(t, kafka.Offset(0), p.takeCommittable().TopicPartition.Offset)
+	})
+}
@@ -21,15 +21,20 @@ import (
 )
 
 type kafkaSource struct {
-	logger     logr.Logger
-	sourceName string
-	sourceURN  string
-	consumer   *kafka.Consumer
-	topic      string
-	wg         *sync.WaitGroup
-	channels   *sync.Map // map[int32]chan *kafka.Message
-	process    source.Process
-	totalLag   int64
+	logger      logr.Logger
+	sourceName  string
+	sourceURN   string
+	consumer    *kafka.Consumer
+	brokers     []string
+	startOffset dfv1.KafkaOffset
+	wg          *sync.WaitGroup
+	channels    *sync.Map // map[topicPartition]chan *kafka.Message
+	process     source.Process
+	totalLag    int64
+	concurrency int
+	keyOrdered  bool
+	mu          sync.Mutex
+	paused      bool
 }
 
 const (
@@ -37,8 +42,24 @@ const (
 	pendingUnavailable = math.MinInt32
 )
 
+type topicPartition struct {
+	topic     string
+	partition int32
+}
+
+func newTopicPartition(x kafka.TopicPartition) topicPartition {
+	return topicPartition{topic: *x.Topic, partition: x.Partition}
+}
+
 func New(ctx context.Context, secretInterface corev1.SecretInterface, cluster, namespace, pipelineName, stepName, sourceName, sourceURN string, replica int, x dfv1.KafkaSource, process source.Process) (source.Interface, error) {
 	logger := sharedutil.NewLogger().WithValues("source", sourceName)
+	topics := x.GetTopics()
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("Kafka source %q must have at least one topic", sourceName)
+	}
+	if _, err := x.StartOffset.GetPartitionOffsets(); err != nil {
+		return nil, err
+	}
 	config, err := sharedkafka.GetConfig(ctx, secretInterface, x.KafkaConfig)
 	if err != nil {
 		return nil, err
@@ -73,18 +94,22 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, cluster, n
 	}, 3*time.Second, 1.2, true)
 
 	s := &kafkaSource{
-		logger:     logger,
-		sourceName: sourceName,
-		sourceURN:  sourceURN,
-		consumer:   consumer,
-		topic:      x.Topic,
-		channels:   new(sync.Map), // partition -> messages
-		wg:         &sync.WaitGroup{},
-		process:    process,
-		totalLag:   pendingUnavailable,
-	}
-
-	if err = consumer.Subscribe(x.Topic, func(consumer *kafka.Consumer, event kafka.Event) error {
+		logger:      logger,
+		sourceName:  sourceName,
+		sourceURN:   sourceURN,
+		consumer:    consumer,
+		brokers:     x.Brokers,
+		startOffset: x.StartOffset,
+		channels:    new(sync.Map), // topic-partition -> messages
+		wg:          &sync.WaitGroup{},
+		process:     process,
+		totalLag:    pendingUnavailable,
+		concurrency: x.GetConcurrency(),
+		keyOrdered:  x.KeyOrdered,
+	}
+
+	// topics starting with "^" are regular expressions, and librdkafka subscribes to all matching topics
+	if err = consumer.SubscribeTopics(topics, func(consumer *kafka.Consumer, event kafka.Event) error {
 		return s.rebalanced(ctx, event)
 	}); err != nil {
 		return nil, err
@@ -95,29 +120,44 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, cluster, n
 	return s, nil
 }
 
-func (s *kafkaSource) processMessage(ctx context.Context, msg *kafka.Message) error {
+// processMessage returns true if a transactional sink committed the message's offset
+func (s *kafkaSource) processMessage(ctx context.Context, msg *kafka.Message) (bool, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, fmt.Sprintf("kafka-source-%s", s.sourceName))
 	defer span.Finish()
-	return s.process(
+	offsets := sharedkafka.NewOffsets(s.consumer, msg)
+	if s.concurrency == 1 { // a transactional sink would commit offsets out of order, so the sidecar rejects that combination
+		ctx = sharedkafka.ContextWithOffsets(ctx, offsets)
+	}
+	topic := *msg.TopicPartition.Topic
+	err := s.process(
 		dfv1.ContextWithMeta(
 			ctx,
 			dfv1.Meta{
-				Source: s.sourceURN,
-				ID:     fmt.Sprintf("%d-%d", msg.TopicPartition.Partition, msg.TopicPartition.Offset),
-				Time:   msg.Timestamp.Unix(),
+				// the URN is per-topic, so that the ID is unique when we consume many topics
+				Source:    dfv1.Kafka{KafkaConfig: dfv1.KafkaConfig{Brokers: s.brokers}, Topic: topic}.GenURN("", ""),
+				ID:        fmt.Sprintf("%d-%d", msg.TopicPartition.Partition, msg.TopicPartition.Offset),
+				Time:      msg.Timestamp.Unix(),
+				Topic:     topic,
+				Partition: msg.TopicPartition.Partition,
+				Headers:   headers(msg.Headers),
 			},
 		),
 		msg.Value,
 	)
+	return offsets.Committed(), err
 }
 
-func (s *kafkaSource) assignedPartition(ctx context.Context, partition int32) {
-	logger := s.logger.WithValues("partition", partition)
-	if _, ok := s.channels.Load(partition); !ok {
+func (s *kafkaSource) assignedPartition(ctx context.Context, tp topicPartition) {
+	logger := s.logger.WithValues("topic", tp.topic, "partition", tp.partition)
+	if _, ok := s.channels.Load(tp); !ok {
 		logger.Info("assigned partition")
-		s.channels.Store(partition, make(chan *kafka.Message, 256))
+		s.channels.Store(tp, make(chan *kafka.Message, 256))
 		go wait.JitterUntilWithContext(ctx, func(ctx context.Context) {
-			s.consumePartition(ctx, partition)
+			if s.concurrency > 1 {
+				s.consumePartitionConcurrently(ctx, tp)
+			} else {
+				s.consumePartition(ctx, tp)
+			}
 		}, 3*time.Second, 1.2, true)
 	}
 }
@@ -142,7 +182,7 @@ func (s *kafkaSource) startPollLoop(ctx context.Context) {
 							s.logger.Info("recovered from panic while queuing message", "recover", fmt.Sprint(r))
 						}
 					}()
-					v, _ := s.channels.Load(e.TopicPartition.Partition)
+					v, _ := s.channels.Load(newTopicPartition(e.TopicPartition))
 					v.(chan *kafka.Message) <- e
 				}()
 			case *kafka.Stats:
@@ -152,7 +192,7 @@ func (s *kafkaSource) startPollLoop(ctx context.Context) {
 				if err := json.Unmarshal([]byte(e.String()), stats); err != nil {
 					s.logger.Error(err, "failed to unmarshall stats")
 				} else {
-					s.totalLag = stats.totalLag(s.topic)
+					s.totalLag = stats.totalLag()
 				}
 			case kafka.Error:
 				s.logger.Info("poll error", "error", fmt.Errorf("%v", e))
@@ -177,6 +217,35 @@ func (s *kafkaSource) Close() error {
 	return s.consumer.Close()
 }
 
+// Pause stops fetching messages, messages already fetched are still processed.
+func (s *kafkaSource) Pause(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	partitions, err := s.consumer.Assignment()
+	if err != nil {
+		return fmt.Errorf("failed to get assigned partitions: %w", err)
+	}
+	if err := s.consumer.Pause(partitions); err != nil {
+		return fmt.Errorf("failed to pause partitions: %w", err)
+	}
+	s.paused = true
+	return nil
+}
+
+func (s *kafkaSource) Resume(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	partitions, err := s.consumer.Assignment()
+	if err != nil {
+		return fmt.Errorf("failed to get assigned partitions: %w", err)
+	}
+	if err := s.consumer.Resume(partitions); err != nil {
+		return fmt.Errorf("failed to resume partitions: %w", err)
+	}
+	s.paused = false
+	return nil
+}
+
 func (s *kafkaSource) GetPending(context.Context) (uint64, error) {
 	if s.totalLag == pendingUnavailable {
 		return 0, source.ErrPendingUnavailable
@@ -191,15 +260,46 @@ func (s *kafkaSource) rebalanced(ctx context.Context, event kafka.Event) error {
 	s.logger.Info("re-balance", "event", event.String())
 	switch e := event.(type) {
 	case kafka.AssignedPartitions:
+		assigned := false
+		switch s.startOffset {
+		case "", "First", "Last": // auto.offset.reset is enough
+		default:
+			positions, err := startPositions(s.consumer, s.startOffset, e.Partitions)
+			if err != nil {
+				// the consumer will assign the partitions itself, starting from auto.offset.reset
+				s.logger.Error(err, "failed to resolve start offsets")
+				return err
+			}
+			s.logger.Info("assigning partitions from start offset", "startOffset", s.startOffset, "positions", fmt.Sprint(positions))
+			if err := s.consumer.Assign(positions); err != nil {
+				return fmt.Errorf("failed to assign partitions: %w", err)
+			}
+			assigned = true
+		}
+		s.mu.Lock()
+		paused := s.paused
+		s.mu.Unlock()
+		if paused {
+			// partitions can only be paused once assigned
+			if !assigned {
+				if err := s.consumer.Assign(e.Partitions); err != nil {
+					return fmt.Errorf("failed to assign partitions: %w", err)
+				}
+			}
+			s.logger.Info("pausing assigned partitions")
+			if err := s.consumer.Pause(e.Partitions); err != nil {
+				return fmt.Errorf("failed to pause partitions: %w", err)
+			}
+		}
 		for _, p := range e.Partitions {
-			s.assignedPartition(ctx, p.Partition)
+			s.assignedPartition(ctx, newTopicPartition(p))
 		}
 	}
 	return nil
 }
 
-func (s *kafkaSource) consumePartition(ctx context.Context, partition int32) {
-	logger := s.logger.WithValues("partition", partition)
+func (s *kafkaSource) consumePartition(ctx context.Context, tp topicPartition) {
+	logger := s.logger.WithValues("topic", tp.topic, "partition", tp.partition)
 	logger.Info("consuming partition")
 	s.wg.Add(1)
 	var lastUncommitted *kafka.Message
@@ -220,7 +320,7 @@ func (s *kafkaSource) consumePartition(ctx context.Context, partition int32) {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 	for {
-		v, _ := s.channels.Load(partition)
+		v, _ := s.channels.Load(tp)
 		select {
 		case <-ticker.C:
 			commitLastUncommitted()
@@ -230,15 +330,75 @@ func (s *kafkaSource) consumePartition(ctx context.Context, partition int32) {
 			}
 			offset := int64(msg.TopicPartition.Offset)
 			logger := logger.WithValues("offset", offset)
-			if err := s.processMessage(ctx, msg); err != nil {
+			if committed, err := s.processMessage(ctx, msg); err != nil {
 				if errors.Is(err, context.Canceled) {
 					logger.Info("failed to process message", "err", err.Error())
 				} else {
 					logger.Error(err, "failed to process message")
 				}
+			} else if committed {
+				// committing an earlier message now would move the offset backwards
+				lastUncommitted = nil
 			} else {
 				lastUncommitted = msg
 			}
 		}
 	}
 }
+
+// consumePartitionConcurrently is like consumePartition, but processes up to s.concurrency messages at once
+func (s *kafkaSource) consumePartitionConcurrently(ctx context.Context, tp topicPartition) {
+	logger := s.logger.WithValues("topic", tp.topic, "partition", tp.partition)
+	logger.Info("consuming partition concurrently", "concurrency", s.concurrency, "keyOrdered", s.keyOrdered)
+	s.wg.Add(1)
+	p := newConcurrentPartition(s.concurrency, s.keyOrdered, func(ctx context.Context, msg *kafka.Message) {
+		if _, err := s.processMessage(ctx, msg); err != nil {
+			logger := logger.WithValues("offset", int64(msg.TopicPartition.Offset))
+			if errors.Is(err, context.Canceled) {
+				logger.Info("failed to process message", "err", err.Error())
+			} else {
+				logger.Error(err, "failed to process message")
+			}
+		}
+	})
+	commitCommittable := func() {
+		if msg := p.takeCommittable(); msg != nil {
+			if _, err := s.consumer.CommitMessage(msg); err != nil {
+				logger.Info("failed to commit message", "offset", msg.TopicPartition.Offset, "error", err)
+			}
+		}
+	}
+	defer func() {
+		logger.Info("waiting for messages to be processed")
+		p.wait()
+		logger.Info("committing last committable message")
+		commitCommittable()
+		logger.Info("done consuming partition")
+		s.wg.Done()
+	}()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		v, _ := s.channels.Load(tp)
+		select {
+		case <-ticker.C:
+			commitCommittable()
+		case msg, ok := <-v.(chan *kafka.Message):
+			if !ok {
+				return
+			}
+			p.dispatch(ctx, msg)
+		}
+	}
+}
+
+// headers returns the user's headers from the Kafka headers, excluding the "source" and "id" headers the Kafka sink adds
+func headers(x []kafka.Header) map[string]string {
+	headers := map[string]string{}
+	for _, h := range x {
+		if h.Key != "source" && h.Key != "id" {
+			headers[h.Key] = string(h.Value)
+		}
+	}
+	return headers
+}
@@ -0,0 +1,17 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_headers(t *testing.T) {
+	assert.Empty(t, headers(nil))
+	assert.Equal(t, map[string]string{"tenant-id": "my-tenant"}, headers([]kafka.Header{
+		{Key: "source", Value: []byte("my-source")},
+		{Key: "id", Value: []byte("my-id")},
+		{Key: "tenant-id", Value: []byte("my-tenant")},
+	}))
+}
@@ -0,0 +1,63 @@
+package kafka
+
+import (
+	"fmt"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// offsetsLookup is the subset of *kafka.Consumer we need to resolve start offsets
+type offsetsLookup interface {
+	Committed(partitions []kafka.TopicPartition, timeoutMs int) ([]kafka.TopicPartition, error)
+	OffsetsForTimes(times []kafka.TopicPartition, timeoutMs int) ([]kafka.TopicPartition, error)
+}
+
+// startPositions returns the partitions to assign, with the offset set to the start offset for any partition that does
+// not have a committed offset, so that we do not replay messages when the consumer group already has a position
+func startPositions(c offsetsLookup, startOffset dfv1.KafkaOffset, partitions []kafka.TopicPartition) ([]kafka.TopicPartition, error) {
+	committed, err := c.Committed(partitions, 10*seconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get committed offsets: %w", err)
+	}
+	positions := make([]kafka.TopicPartition, len(partitions))
+	copy(positions, partitions)
+	var uncommitted []int
+	for i, p := range committed {
+		if p.Offset < 0 {
+			uncommitted = append(uncommitted, i)
+		}
+	}
+	if len(uncommitted) == 0 {
+		return positions, nil
+	}
+	if t, ok := startOffset.GetTime(); ok {
+		times := make([]kafka.TopicPartition, len(uncommitted))
+		for j, i := range uncommitted {
+			times[j] = partitions[i]
+			times[j].Offset = kafka.Offset(t.UnixNano() / 1e6)
+		}
+		offsets, err := c.OffsetsForTimes(times, 10*seconds)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get offsets for %v: %w", t, err)
+		}
+		for j, i := range uncommitted {
+			// if there are no messages after the time, this is kafka.OffsetEnd
+			positions[i].Offset = offsets[j].Offset
+		}
+		return positions, nil
+	}
+	offsets, err := startOffset.GetPartitionOffsets()
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range uncommitted {
+		p := partitions[i]
+		if v, ok := offsets[fmt.Sprintf("%s:%d", *p.Topic, p.Partition)]; ok {
+			positions[i].Offset = kafka.Offset(v)
+		} else if v, ok := offsets[fmt.Sprint(p.Partition)]; ok {
+			positions[i].Offset = kafka.Offset(v)
+		}
+	}
+	return positions, nil
+}
@@ -0,0 +1,79 @@
+package kafka
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeOffsetsLookup struct {
+	committed map[int32]kafka.Offset
+	times     []kafka.TopicPartition
+}
+
+func (f *fakeOffsetsLookup) Committed(partitions []kafka.TopicPartition, _ int) ([]kafka.TopicPartition, error) {
+	var out []kafka.TopicPartition
+	for _, p := range partitions {
+		p.Offset = kafka.OffsetInvalid
+		if v, ok := f.committed[p.Partition]; ok {
+			p.Offset = v
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func (f *fakeOffsetsLookup) OffsetsForTimes(times []kafka.TopicPartition, _ int) ([]kafka.TopicPartition, error) {
+	f.times = times
+	var out []kafka.TopicPartition
+	for _, p := range times {
+		p.Offset = kafka.Offset(p.Partition) * 10
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func Test_startPositions(t *testing.T) {
+	topic := "my-topic"
+	partitions := []kafka.TopicPartition{
+		{Topic: &topic, Partition: 0, Offset: kafka.OffsetInvalid},
+		{Topic: &topic, Partition: 1, Offset: kafka.OffsetInvalid},
+		{Topic: &topic, Partition: 2, Offset: kafka.OffsetInvalid},
+	}
+	offsets := func(partitions []kafka.TopicPartition) string {
+		var out []kafka.Offset
+		for _, p := range partitions {
+			out = append(out, p.Offset)
+		}
+		return fmt.Sprint(out)
+	}
+	t.Run("Time", func(t *testing.T) {
+		c := &fakeOffsetsLookup{committed: map[int32]kafka.Offset{1: 5}}
+		positions, err := startPositions(c, "2021-10-01T12:00:00Z", partitions)
+		assert.NoError(t, err)
+		assert.Equal(t, "[0 unset 20]", offsets(positions), "committed partitions are not changed")
+		if assert.Len(t, c.times, 2) {
+			assert.Equal(t, kafka.Offset(1633089600000), c.times[0].Offset)
+		}
+	})
+	t.Run("PartitionOffsets", func(t *testing.T) {
+		c := &fakeOffsetsLookup{committed: map[int32]kafka.Offset{2: 5}}
+		positions, err := startPositions(c, "0:100,my-topic:1:200,2:300", partitions)
+		assert.NoError(t, err)
+		assert.Equal(t, "[100 200 unset]", offsets(positions))
+	})
+	t.Run("Unlisted", func(t *testing.T) {
+		positions, err := startPositions(&fakeOffsetsLookup{}, "other-topic:0:100", partitions)
+		assert.NoError(t, err)
+		assert.Equal(t, "[unset unset unset]", offsets(positions))
+	})
+	t.Run("AllCommitted", func(t *testing.T) {
+		c := &fakeOffsetsLookup{committed: map[int32]kafka.Offset{0: 1, 1: 1, 2: 1}}
+		positions, err := startPositions(c, "2021-10-01T12:00:00Z", partitions)
+		assert.NoError(t, err)
+		assert.Equal(t, partitions, positions)
+		assert.Nil(t, c.times)
+	})
+}
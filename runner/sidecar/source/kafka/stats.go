@@ -8,10 +8,15 @@ type Stats struct {
 	} `json:"topics"`
 }
 
-func (s Stats) totalLag(topic string) int64 {
+// totalLag returns the lag across all the topics we are consuming, which maybe many if we subscribe to a pattern
+func (s Stats) totalLag() int64 {
 	var totalLag int64
-	for _, p := range s.Topics[topic].Partitions {
-		totalLag += p.ConsumerLag
+	for _, t := range s.Topics {
+		for _, p := range t.Partitions {
+			if p.ConsumerLag > 0 { // -1 means unknown, e.g. the partition is not assigned to us
+				totalLag += p.ConsumerLag
+			}
+		}
 	}
 	return totalLag
 }
@@ -0,0 +1,18 @@
+package kafka
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats_totalLag(t *testing.T) {
+	stats := &Stats{}
+	err := json.Unmarshal([]byte(`{"topics": {
+	"orders.us": {"partitions": {"0": {"consumer_lag": 2}, "1": {"consumer_lag": 3}, "-1": {"consumer_lag": -1}}},
+	"orders.eu": {"partitions": {"0": {"consumer_lag": 5}}}
+}}`), stats)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), stats.totalLag())
+}
@@ -14,6 +14,7 @@ import (
 	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/opentracing/opentracing-go"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -98,6 +99,14 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, pipelineNa
 				return fmt.Errorf("failed to get object %q %q: %w", x.Bucket, key, err)
 			}
 			defer output.Body.Close()
+			// the message is still processed without headers if the tags cannot be read, e.g. if the source is not allowed
+			// to s3:GetObjectTagging
+			var tags []types.Tag
+			if tagging, err := client.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{Bucket: &x.Bucket, Key: &key}); err != nil {
+				logger.Error(err, "failed to get object tagging, continuing without headers", "key", key)
+			} else {
+				tags = tagging.TagSet
+			}
 			if err := syscall.Mkfifo(path, 0o600); sharedutil.IgnoreExist(err) != nil {
 				return fmt.Errorf("failed to create fifo %q: %w", path, err)
 			}
@@ -118,9 +127,10 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, pipelineNa
 				dfv1.ContextWithMeta(
 					ctx,
 					dfv1.Meta{
-						Source: sourceURN,
-						ID:     key,
-						Time:   output.LastModified.Unix(),
+						Source:  sourceURN,
+						ID:      key,
+						Time:    output.LastModified.Unix(),
+						Headers: headers(tags),
 					},
 				),
 				[]byte(sharedutil.MustJSON(message{Key: key, Path: path})),
@@ -144,3 +154,14 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, pipelineNa
 		},
 	})
 }
+
+// headers returns the user's headers from the object's tags, excluding the tags the S3 sink adds
+func headers(tags []types.Tag) map[string]string {
+	headers := map[string]string{}
+	for _, t := range tags {
+		if k := aws.ToString(t.Key); k != dfv1.MetaSource && k != dfv1.MetaID {
+			headers[k] = aws.ToString(t.Value)
+		}
+	}
+	return headers
+}
@@ -0,0 +1,18 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_headers(t *testing.T) {
+	assert.Empty(t, headers(nil))
+	assert.Equal(t, map[string]string{"tenant-id": "my-tenant"}, headers([]types.Tag{
+		{Key: aws.String("dataflow-source"), Value: aws.String("my-source")},
+		{Key: aws.String("dataflow-id"), Value: aws.String("my-id")},
+		{Key: aws.String("tenant-id"), Value: aws.String("my-tenant")},
+	}))
+}
@@ -14,6 +14,13 @@ type Process func(ctx context.Context, msg []byte) error
 
 var ErrPendingUnavailable = errors.New("pending not available")
 
+// Pausable is implemented by sources that can stop, and later resume, receiving messages without losing them.
+type Pausable interface {
+	Interface
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+}
+
 type HasPending interface {
 	Interface
 	// GetPending returns the number of pending messages.
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"sync"
 	"time"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
@@ -24,8 +25,11 @@ import (
 var logger = sharedutil.NewLogger()
 
 type stanSource struct {
-	sub               stan.Subscription
+	mu                sync.Mutex
+	sub               stan.Subscription // nil while paused
 	conn              *sharedstan.Conn
+	paused            bool
+	subscribe         func(conn *sharedstan.Conn) (stan.Subscription, error)
 	subject           string
 	natsMonitoringURL string
 	queueName         string
@@ -41,18 +45,15 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, cluster, n
 		return fmt.Sprintf("%s-%s-%s-%d-source-%s-%v", namespace, pipelineName, stepName, replica, sourceName, r1.Intn(100))
 	}
 
-	var conn *sharedstan.Conn
-	var err error
 	clientID := genClientID()
-	conn, err = sharedstan.ConnectSTAN(ctx, secretInterface, x, clientID)
+	conn, err := sharedstan.ConnectSTAN(ctx, secretInterface, x, clientID)
 	if err != nil {
 		return nil, err
 	}
 
 	// https://docs.nats.io/developing-with-nats-streaming/queues
-	var sub stan.Subscription
 	queueName := sharedutil.GetSourceUID(cluster, namespace, pipelineName, stepName, sourceName)
-	subFunc := func() (stan.Subscription, error) {
+	subFunc := func(conn *sharedstan.Conn) (stan.Subscription, error) {
 		logger.Info("subscribing to STAN queue", "source", sourceName, "queueName", queueName)
 		sub, err := conn.QueueSubscribe(x.Subject, queueName, func(msg *stan.Msg) {
 			span, ctx := opentracing.StartSpanFromContext(ctx, fmt.Sprintf("stan-source-%s", sourceName))
@@ -80,10 +81,18 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, cluster, n
 		return sub, nil
 	}
 
-	sub, err = subFunc()
+	sub, err := subFunc(conn)
 	if err != nil {
 		return nil, err
 	}
+	s := &stanSource{
+		conn:              conn,
+		sub:               sub,
+		subscribe:         subFunc,
+		subject:           x.Subject,
+		natsMonitoringURL: x.NATSMonitoringURL,
+		queueName:         queueName,
+	}
 	go func() {
 		defer runtimeutil.HandleCrash()
 		logger.Info("starting stan auto reconnection daemon", "source", sourceName)
@@ -95,40 +104,87 @@ func New(ctx context.Context, secretInterface corev1.SecretInterface, cluster, n
 				logger.Info("exiting stan auto reconnection daemon", "source", sourceName)
 				return
 			case <-ticker.C:
-				if conn == nil || conn.IsClosed() {
-					_ = sub.Close()
+				s.reconnectIfClosed(func() (*sharedstan.Conn, error) {
 					logger.Info("stan connection lost, reconnecting...", "source", sourceName)
 					clientID := genClientID()
-					conn, err = sharedstan.ConnectSTAN(ctx, secretInterface, x, clientID)
+					conn, err := sharedstan.ConnectSTAN(ctx, secretInterface, x, clientID)
 					if err != nil {
 						logger.Info("failed to reconnect, will try again soon", "source", sourceName, "clientID", clientID, "error", err)
-						continue
+						return nil, err
 					}
 					logger.Info("reconnected to stan server.", "source", sourceName, "clientID", clientID)
-					if sub, err = subFunc(); err != nil {
-						logger.Error(err, "failed to subscribe after reconnection", "source", sourceName, "clientID", clientID)
-						// Close the connection to let it retry
-						_ = conn.Close()
-					}
-				}
+					return conn, nil
+				})
 			}
 		}
 	}()
 
-	return stanSource{
-		conn:              conn,
-		sub:               sub,
-		subject:           x.Subject,
-		natsMonitoringURL: x.NATSMonitoringURL,
-		queueName:         queueName,
-	}, nil
+	return s, nil
+}
+
+func (s *stanSource) reconnectIfClosed(connect func() (*sharedstan.Conn, error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != nil && !s.conn.IsClosed() {
+		return
+	}
+	if s.sub != nil {
+		_ = s.sub.Close()
+		s.sub = nil
+	}
+	conn, err := connect()
+	if err != nil {
+		return
+	}
+	s.conn = conn
+	if s.paused {
+		return
+	}
+	if s.sub, err = s.subscribe(conn); err != nil {
+		logger.Error(err, "failed to subscribe after reconnection")
+		// Close the connection to let it retry
+		_ = conn.Close()
+	}
 }
 
-func (s stanSource) Close() error {
-	logger.Info("closing stan subscription")
-	if err := s.sub.Close(); err != nil {
+// Pause closes the subscription, it is durable, so messages are not lost.
+func (s *stanSource) Pause(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paused = true
+	if s.sub == nil {
+		return nil
+	}
+	logger.Info("closing stan subscription to pause")
+	err := s.sub.Close()
+	s.sub = nil
+	return err
+}
+
+func (s *stanSource) Resume(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paused = false
+	if s.sub != nil || s.conn.IsClosed() { // the reconnection daemon will subscribe
+		return nil
+	}
+	sub, err := s.subscribe(s.conn)
+	if err != nil {
 		return err
 	}
+	s.sub = sub
+	return nil
+}
+
+func (s *stanSource) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sub != nil {
+		logger.Info("closing stan subscription")
+		if err := s.sub.Close(); err != nil {
+			return err
+		}
+	}
 	logger.Info("closing stan source connection")
 	return s.conn.Close()
 }
@@ -137,7 +193,7 @@ var httpClient = http.Client{
 	Timeout: time.Second * 3,
 }
 
-func (s stanSource) GetPending(ctx context.Context) (uint64, error) {
+func (s *stanSource) GetPending(ctx context.Context) (uint64, error) {
 	pendingMessages := func(ctx context.Context, channel, queueNameCombo string) (int64, error) {
 		monitoringEndpoint := fmt.Sprintf("%s/streaming/channelsz?channel=%s&subs=1", s.natsMonitoringURL, channel)
 		req, err := http.NewRequestWithContext(ctx, "GET", monitoringEndpoint, nil)
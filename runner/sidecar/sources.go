@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	sharedkafka "github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/kafka"
+	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/schemaregistry"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/source"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/source/cron"
 	dbsource "github.com/argoproj-labs/argo-dataflow/runner/sidecar/source/db"
@@ -16,6 +19,7 @@ import (
 	s3source "github.com/argoproj-labs/argo-dataflow/runner/sidecar/source/s3"
 	"github.com/argoproj-labs/argo-dataflow/runner/sidecar/source/stan"
 	volumeSource "github.com/argoproj-labs/argo-dataflow/runner/sidecar/source/volume"
+	"github.com/argoproj-labs/argo-dataflow/runner/tap"
 	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -25,7 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
-func connectSources(ctx context.Context, process func(context.Context, []byte) error, dlq func(context.Context, []byte) error) error {
+func connectSources(ctx context.Context, process func(context.Context, []byte) error, dlq func(context.Context, dfv1.DeadLetter) error, breakers circuitBreakers) error {
 	var pendingGauge *prometheus.GaugeVec
 	if leadReplica() {
 		pendingGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
@@ -65,11 +69,50 @@ func connectSources(ctx context.Context, process func(context.Context, []byte) e
 		Buckets:   []float64{0.0, 1.0, 3.0, 5.0, 10.0, 15.0, 30.0, 45.0, 60.0, 75.0, 90.0, 105.0, 120.0},
 	}, []string{"sourceName", "replica"})
 
+	replayedCounter := promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "sources",
+		Name:      "replayed",
+		Help:      "Number of dead letters replayed, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#sources_replayed",
+	}, []string{"sourceName", "replica", "result"})
+
+	inflightGauge := promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "sources",
+		Name:      "inflight",
+		Help:      "Number of messages being processed, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#sources_inflight",
+	}, []string{"sourceName", "replica"})
+
+	throttledCounter := promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "sources",
+		Name:      "throttled_seconds",
+		Help:      "Total seconds messages waited for a limiter, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#sources_throttled_seconds",
+	}, []string{"sourceName", "replica", "limiter"})
+
+	pausedGauge := promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "sources",
+		Name:      "paused",
+		Help:      "Whether the source is paused, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#sources_paused",
+	}, []string{"sourceName", "replica"})
+
 	if err := createSecret(ctx); err != nil {
 		return err
 	}
+	secret, err := secretInterface.Get(ctx, step.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get secret %q: %w", step.Name, err)
+	}
+	if authorization := string(secret.Data["tap.authorization"]); authorization != "" {
+		http.HandleFunc("/tap", defaultTaps.handler(authorization))
+	} else {
+		logger.Info("not enabling the tap endpoint, the step's secret was created by an earlier version", "secret", step.Name)
+	}
+	suspended, err := stepSuspended()
+	if err != nil {
+		return err
+	}
+	logger.Info("step suspended", "suspended", suspended)
 
 	sources := make(map[string]source.Interface)
+	var pausables []*pausableSource
 	for _, s := range step.Spec.Sources {
 		sourceName := s.Name
 		sourceURN := s.GenURN(cluster, namespace)
@@ -78,6 +121,16 @@ func connectSources(ctx context.Context, process func(context.Context, []byte) e
 			return fmt.Errorf("duplicate source named %q", sourceName)
 		}
 
+		// each attempt decodes the message, so a failure to get its schema is retried, and dead-lettered
+		processAttempt := process
+		if x := s.Kafka; x != nil && x.SchemaRegistry != nil {
+			client, err := schemaregistry.New(ctx, secretInterface, *x.SchemaRegistry)
+			if err != nil {
+				return err
+			}
+			processAttempt = newDecodingProcess(client, process)
+		}
+
 		processWithRetry := func(ctx context.Context, msg []byte) error {
 			span, ctx := opentracing.StartSpanFromContext(ctx, "processWithRetry")
 			defer span.Finish()
@@ -91,42 +144,66 @@ func connectSources(ctx context.Context, process func(context.Context, []byte) e
 
 			sourceMsgTime := time.Unix(meta.Time, 0).UTC()
 			processLatencyHistoGram.WithLabelValues(sourceName, fmt.Sprint(replica)).Observe(time.Now().UTC().Sub(sourceMsgTime).Seconds())
+			defaultTaps.publish(ctx, tap.Event{Type: tap.EventInput, Source: sourceName}, msg)
 			backoff := newBackoff(s.Retry)
+			// shared between retries, so that retries can skip sinks the message has already been written to
+			delivered := newDeliveredSinks()
+			var attempts uint64
+			var firstFailureTime time.Time
 			for {
 				select {
 				case <-ctx.Done():
 					// we don't report error here, this is normal cancellation
 					return fmt.Errorf("could not send message: %w", ctx.Err())
 				default:
+					// pause, rather than use up retries, while a sink's circuit breaker is open
+					if err := breakers.wait(ctx); err != nil {
+						return fmt.Errorf("could not send message: %w", err)
+					}
 					if uint64(backoff.Steps) < s.Retry.Steps { // this is a retry
 						logger.Info("retry", "source", sourceName, "backoff", backoff)
 						retriesCounter.WithLabelValues(sourceName, fmt.Sprint(replica)).Inc()
 					}
-					// we need to copy anything except the timeout from the parent context
 					m, err := dfv1.MetaFromContext(ctx)
 					if err != nil {
 						return err
 					}
-					newCtx, cancel := context.WithTimeout(
-						dfv1.ContextWithMeta(
-							opentracing.ContextWithSpan(context.Background(), span),
-							m,
-						),
-						15*time.Second,
-					)
-
-					err = process(newCtx, msg)
+					if m.SourceName == "" { // replayed messages keep their original source name
+						m.SourceName = sourceName
+					}
+					newCtx, cancel := context.WithTimeout(newAttemptContext(ctx, span, m, delivered), 15*time.Second)
+
+					err = processAttempt(newCtx, msg)
 					cancel()
 					if err == nil {
 						return nil
 					}
+					defaultTaps.publish(ctx, tap.Event{Type: tap.EventError, Source: sourceName, Error: err.Error()}, msg)
+					if breakers.anyOpen() {
+						logger.Info("failed to send process message, pausing as a sink's circuit breaker is open", "source", sourceName, "err", err.Error())
+						continue
+					}
+					attempts++
+					lastFailureTime := time.Now()
+					if firstFailureTime.IsZero() {
+						firstFailureTime = lastFailureTime
+					}
 					giveUp := backoff.Steps <= 0
 					logger := logger.WithValues("source", sourceName, "backoffSteps", backoff.Steps, "giveUp", giveUp)
 					if giveUp {
 						logger.Error(err, "failed to send process message")
 						errorsCounter.WithLabelValues(sourceName, fmt.Sprint(replica)).Inc()
-						if dlqErr := dlq(ctx, msg); dlqErr != nil {
-							logger.Error(err, "failed to send failed message to DLQ", "error", err)
+						if dlqErr := dlq(ctx, dfv1.DeadLetter{
+							Error:            err.Error(),
+							StepName:         stepName,
+							SourceName:       sourceName,
+							Attempts:         attempts,
+							FirstFailureTime: metav1.NewTime(firstFailureTime),
+							LastFailureTime:  metav1.NewTime(lastFailureTime),
+							Meta:             m,
+							Data:             msg,
+						}); dlqErr != nil {
+							logger.Error(dlqErr, "failed to send failed message to DLQ", "error", err)
 						}
 
 						return err
@@ -137,50 +214,71 @@ func connectSources(ctx context.Context, process func(context.Context, []byte) e
 				}
 			}
 		}
+		process := processWithRetry
+		if s.RateLimit != nil || s.MaxConcurrency > 0 {
+			logger.Info("throttling", "source", sourceName, "rateLimit", s.RateLimit, "maxConcurrency", s.MaxConcurrency)
+			process = newThrottledProcess(s, process, inflightGauge, throttledCounter)
+		}
+		if s.Replay != nil {
+			logger.Info("replaying dead letters", "source", sourceName)
+			var err error
+			if process, err = newReplayProcess(s, process, replayedCounter); err != nil {
+				return err
+			}
+		}
+		pausable := newPausableSource(sourceName, pausedGauge.WithLabelValues(sourceName, fmt.Sprint(replica)))
+		if err := pausable.update(ctx, func() { pausable.bySuspend = suspended }); err != nil {
+			return err
+		}
+		pausables = append(pausables, pausable)
+		process = pausable.process(process)
 		if x := s.Cron; x != nil {
-			if y, err := cron.New(ctx, sourceName, sourceURN, *x, processWithRetry); err != nil {
+			if y, err := cron.New(ctx, sourceName, sourceURN, *x, process); err != nil {
 				return err
 			} else {
 				sources[sourceName] = y
 			}
 		} else if x := s.STAN; x != nil {
-			if y, err := stan.New(ctx, secretInterface, cluster, namespace, pipelineName, stepName, sourceURN, replica, sourceName, *x, processWithRetry); err != nil {
+			if y, err := stan.New(ctx, secretInterface, cluster, namespace, pipelineName, stepName, sourceURN, replica, sourceName, *x, process); err != nil {
 				return err
 			} else {
 				sources[sourceName] = y
 			}
 		} else if x := s.Kafka; x != nil {
-			if y, err := kafkasource.New(ctx, secretInterface, cluster, namespace, pipelineName, stepName, sourceName, sourceURN, replica, *x, processWithRetry); err != nil {
+			if err := validateKafkaConcurrency(s, step.Spec.Sinks); err != nil {
+				return err
+			}
+			if y, err := kafkasource.New(ctx, secretInterface, cluster, namespace, pipelineName, stepName, sourceName, sourceURN, replica, *x, process); err != nil {
 				return err
 			} else {
 				sources[sourceName] = y
 			}
 		} else if x := s.HTTP; x != nil {
-			if _, y, err := httpsource.New(ctx, secretInterface, pipelineName, stepName, sourceURN, sourceName, processWithRetry); err != nil {
+			if _, y, err := httpsource.New(ctx, secretInterface, pipelineName, stepName, sourceURN, sourceName, *x, process); err != nil {
 				return err
 			} else {
 				sources[sourceName] = y
 			}
 		} else if x := s.S3; x != nil {
-			if y, err := s3source.New(ctx, secretInterface, pipelineName, stepName, sourceName, sourceURN, *x, processWithRetry, leadReplica()); err != nil {
+			if y, err := s3source.New(ctx, secretInterface, pipelineName, stepName, sourceName, sourceURN, *x, process, leadReplica()); err != nil {
 				return err
 			} else {
 				sources[sourceName] = y
 			}
 		} else if x := s.DB; x != nil {
-			if y, err := dbsource.New(ctx, secretInterface, cluster, namespace, pipelineName, stepName, sourceName, sourceURN, *x, processWithRetry); err != nil {
+			if y, err := dbsource.New(ctx, secretInterface, cluster, namespace, pipelineName, stepName, sourceName, sourceURN, *x, process); err != nil {
 				return err
 			} else {
 				sources[sourceName] = y
 			}
 		} else if x := s.Volume; x != nil {
-			if y, err := volumeSource.New(ctx, secretInterface, pipelineName, stepName, sourceName, sourceURN, *x, processWithRetry, leadReplica()); err != nil {
+			if y, err := volumeSource.New(ctx, secretInterface, pipelineName, stepName, sourceName, sourceURN, *x, process, leadReplica()); err != nil {
 				return err
 			} else {
 				sources[sourceName] = y
 			}
 		} else if x := s.JetStream; x != nil {
-			if y, err := jssource.New(ctx, secretInterface, cluster, namespace, pipelineName, stepName, sourceURN, replica, sourceName, *x, processWithRetry); err != nil {
+			if y, err := jssource.New(ctx, secretInterface, cluster, namespace, pipelineName, stepName, sourceURN, replica, sourceName, *x, process); err != nil {
 				return err
 			} else {
 				sources[sourceName] = y
@@ -188,6 +286,12 @@ func connectSources(ctx context.Context, process func(context.Context, []byte) e
 		} else {
 			return fmt.Errorf("source misconfigured")
 		}
+		if err := pausable.connected(ctx, sources[sourceName]); err != nil {
+			return fmt.Errorf("failed to pause source %q: %w", sourceName, err)
+		}
+		authorization := string(secret.Data[fmt.Sprintf("sources.%s.http.authorization", sourceName)])
+		http.HandleFunc("/sources/"+sourceName+"/pause", pausable.handler(authorization, true))
+		http.HandleFunc("/sources/"+sourceName+"/resume", pausable.handler(authorization, false))
 		addPreStopHook(func(ctx context.Context) error {
 			logger.Info("closing", "source", sourceName)
 			return sources[sourceName].Close()
@@ -208,6 +312,7 @@ func connectSources(ctx context.Context, process func(context.Context, []byte) e
 			}, updateInterval, 1.2, true)
 		}
 	}
+	go watchSuspend(ctx, pausables)
 	return nil
 }
 
@@ -216,6 +321,7 @@ func createSecret(ctx context.Context) error {
 	for _, s := range step.Spec.Sources {
 		data[fmt.Sprintf("sources.%s.http.authorization", s.Name)] = fmt.Sprintf("Bearer %s", sharedutil.RandString())
 	}
+	data["tap.authorization"] = fmt.Sprintf("Bearer %s", sharedutil.RandString())
 	_, err := secretInterface.Create(ctx, &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            step.Name,
@@ -228,3 +334,12 @@ func createSecret(ctx context.Context) error {
 	}
 	return nil
 }
+
+// newAttemptContext returns the context for one attempt at processing a message. We need to copy anything except the
+// timeout from the parent context, including the Kafka source's offsets, so a transactional Kafka sink can commit them.
+func newAttemptContext(ctx context.Context, span opentracing.Span, m dfv1.Meta, delivered *deliveredSinks) context.Context {
+	newCtx := opentracing.ContextWithSpan(context.Background(), span)
+	newCtx = dfv1.ContextWithMeta(newCtx, m)
+	newCtx = contextWithDeliveredSinks(newCtx, delivered)
+	return sharedkafka.ContextWithOffsets(newCtx, sharedkafka.OffsetsFromContext(ctx))
+}
@@ -0,0 +1,94 @@
+package sidecar
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	sharedkafka "github.com/argoproj-labs/argo-dataflow/runner/sidecar/shared/kafka"
+	kafkasink "github.com/argoproj-labs/argo-dataflow/runner/sidecar/sink/kafka"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func Test_newAttemptContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m := dfv1.Meta{Source: "my-source", ID: "my-id", Time: 1}
+	span := opentracing.StartSpan("my-span")
+	defer span.Finish()
+	t.Run("Meta", func(t *testing.T) {
+		delivered := newDeliveredSinks()
+		ctx, cancel := context.WithCancel(dfv1.ContextWithMeta(ctx, m))
+		newCtx := newAttemptContext(ctx, span, m, delivered)
+		cancel()
+		assert.NoError(t, newCtx.Err(), "the parent's cancellation is not copied")
+		got, err := dfv1.MetaFromContext(newCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, m, got)
+		assert.Equal(t, span, opentracing.SpanFromContext(newCtx))
+		assert.Equal(t, delivered, deliveredSinksFromContext(newCtx))
+		assert.Nil(t, sharedkafka.OffsetsFromContext(newCtx))
+	})
+	t.Run("TransactionalKafkaSink", func(t *testing.T) {
+		cluster, err := kafka.NewMockCluster(1)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer cluster.Close()
+		brokers := cluster.BootstrapServers()
+		producer, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": brokers})
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer producer.Close()
+		topic := "input-topic"
+		assert.NoError(t, producer.Produce(&kafka.Message{TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny}, Value: []byte("foo")}, nil))
+		producer.Flush(5 * 1000)
+		consumer, err := kafka.NewConsumer(&kafka.ConfigMap{"bootstrap.servers": brokers, "group.id": "my-group", "auto.offset.reset": "earliest", "enable.auto.commit": false})
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer func() { _ = consumer.Close() }()
+		assert.NoError(t, consumer.Subscribe(topic, nil))
+		msg, err := consumer.ReadMessage(10 * time.Second)
+		if !assert.NoError(t, err) {
+			return
+		}
+		// like the Kafka source
+		offsets := sharedkafka.NewOffsets(consumer, msg)
+		sourceCtx := sharedkafka.ContextWithOffsets(dfv1.ContextWithMeta(ctx, m), offsets)
+
+		batchSize := resource.MustParse("100Ki")
+		acks := intstr.FromString("all")
+		s, err := kafkasink.New(ctx, "my-sink", "my-transactional-id", nil, dfv1.KafkaSink{
+			Kafka:           dfv1.Kafka{Topic: "output-topic", KafkaConfig: dfv1.KafkaConfig{Brokers: []string{brokers}}},
+			Transactional:   true,
+			BatchSize:       &batchSize,
+			CompressionType: "none",
+			Acks:            &acks,
+			MessageTimeout:  &metav1.Duration{Duration: 5 * time.Second},
+		}, prometheus.NewCounter(prometheus.CounterOpts{Name: "my_counter"}))
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer func() { _ = s.(io.Closer).Close() }()
+
+		// like processWithRetry, and the main container returning the message
+		newCtx := newAttemptContext(sourceCtx, span, m, newDeliveredSinks())
+		err = sinkOutputs(newCtx, []output{{data: msg.Value}}, func(ctx context.Context, msg []byte) error {
+			return sinkAll(ctx, dfv1.SinkFailurePolicyAllOrNothing, []string{"my-sink"}, msg, func(ctx context.Context, _ string, msg []byte) error {
+				return s.Sink(ctx, msg)
+			})
+		})
+		assert.NoError(t, err)
+		assert.True(t, offsets.Committed(), "the offsets reach the sink, and are committed in its transaction")
+	})
+}
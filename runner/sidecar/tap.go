@@ -0,0 +1,176 @@
+package sidecar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/tap"
+)
+
+// tapSubscriber is a client of the tap endpoint.
+type tapSubscriber struct {
+	sample  float64
+	filter  *vm.Program // nil if all messages match
+	types   map[tap.EventType]bool
+	events  chan tap.Event
+	dropped uint64 // events dropped, because the client did not read them fast enough
+}
+
+func newTapSubscriber(query url.Values) (*tapSubscriber, error) {
+	s := &tapSubscriber{sample: 1, types: map[tap.EventType]bool{}, events: make(chan tap.Event, 64)}
+	if x := query.Get("sample"); x != "" {
+		v, err := strconv.ParseFloat(x, 64)
+		if err != nil || v < 0 || v > 1 {
+			return nil, fmt.Errorf("sample %q must be a number between 0 and 1", x)
+		}
+		s.sample = v
+	}
+	if x := query.Get("filter"); x != "" {
+		prog, err := expr.Compile(x)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile %q: %w", x, err)
+		}
+		s.filter = prog
+	}
+	for _, x := range strings.Split(query.Get("types"), ",") {
+		if x != "" {
+			s.types[tap.EventType(x)] = true
+		}
+	}
+	if len(s.types) == 0 {
+		for _, t := range tap.EventTypes {
+			s.types[t] = true
+		}
+	}
+	return s, nil
+}
+
+// taps streams a sample of the messages, that match each subscriber's filter, to the subscribers. Publishing never
+// blocks, events are dropped if a subscriber does not read them fast enough.
+type taps struct {
+	mu          sync.RWMutex
+	subscribers map[*tapSubscriber]bool
+}
+
+var defaultTaps = newTaps()
+
+func newTaps() *taps {
+	return &taps{subscribers: map[*tapSubscriber]bool{}}
+}
+
+func (t *taps) subscribe(s *tapSubscriber) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.subscribers[s] = true
+}
+
+func (t *taps) unsubscribe(s *tapSubscriber) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.subscribers, s)
+}
+
+// publish sends the event, with the message and the meta-data from the context, to each subscriber it is sampled for,
+// and matches the filter of
+func (t *taps) publish(ctx context.Context, e tap.Event, msg []byte) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if len(t.subscribers) == 0 {
+		return
+	}
+	var data []byte
+	for s := range t.subscribers {
+		if !s.types[e.Type] || rand.Float64() >= s.sample {
+			continue
+		}
+		if s.filter != nil {
+			if match, err := when(ctx, s.filter, msg); err != nil || !match {
+				continue
+			}
+		}
+		if data == nil { // the message may be re-used once processed, so we must copy it
+			data = append([]byte{}, msg...)
+			e.Meta, _ = dfv1.MetaFromContext(ctx)
+			e.Time = time.Now()
+			e.Data = data
+		}
+		select {
+		case s.events <- e:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	}
+}
+
+// handler returns the handler for the tap endpoint, which streams events to the client as server-sent events
+func (t *taps) handler(authorization string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if authorization == "" || r.Header.Get("Authorization") != authorization {
+			w.WriteHeader(403)
+			return
+		}
+		if r.Method != http.MethodGet {
+			w.WriteHeader(405)
+			return
+		}
+		s, err := newTapSubscriber(r.URL.Query())
+		if err != nil {
+			w.WriteHeader(400)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte("streaming not supported"))
+			return
+		}
+		logger.Info("tap started", "sample", s.sample, "types", s.types)
+		defer logger.Info("tap finished")
+		t.subscribe(s)
+		defer t.unsubscribe(s)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(200)
+		flusher.Flush()
+		keepAlive := time.NewTicker(15 * time.Second)
+		defer keepAlive.Stop()
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-keepAlive.C:
+				if _, err := w.Write([]byte(":\n\n")); err != nil {
+					return
+				}
+			case e := <-s.events:
+				if n := atomic.SwapUint64(&s.dropped, 0); n > 0 {
+					if _, err := fmt.Fprintf(w, "event: dropped\ndata: %d\n\n", n); err != nil {
+						return
+					}
+				}
+				data, err := json.Marshal(e)
+				if err != nil {
+					logger.Error(err, "failed to marshal tap event")
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+		}
+	}
+}
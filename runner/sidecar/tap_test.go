@@ -0,0 +1,114 @@
+package sidecar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/tap"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newTapSubscriber(t *testing.T) {
+	s, err := newTapSubscriber(url.Values{})
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), s.sample)
+	assert.Nil(t, s.filter)
+	assert.Len(t, s.types, 3)
+	s, err = newTapSubscriber(url.Values{"sample": {"0.5"}, "filter": {`string(msg) == "foo"`}, "types": {"input,error"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 0.5, s.sample)
+	assert.NotNil(t, s.filter)
+	assert.Equal(t, map[tap.EventType]bool{tap.EventInput: true, tap.EventError: true}, s.types)
+	_, err = newTapSubscriber(url.Values{"sample": {"2"}})
+	assert.Error(t, err)
+	_, err = newTapSubscriber(url.Values{"filter": {"("}})
+	assert.Error(t, err)
+}
+
+func Test_taps_publish(t *testing.T) {
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "my-id"})
+	taps := newTaps()
+	taps.publish(ctx, tap.Event{Type: tap.EventInput}, []byte("foo")) // no subscribers
+	all, err := newTapSubscriber(url.Values{})
+	assert.NoError(t, err)
+	none, err := newTapSubscriber(url.Values{"sample": {"0"}})
+	assert.NoError(t, err)
+	filtered, err := newTapSubscriber(url.Values{"filter": {`string(msg) == "bar"`}, "types": {"input"}})
+	assert.NoError(t, err)
+	for _, s := range []*tapSubscriber{all, none, filtered} {
+		taps.subscribe(s)
+	}
+	msg := []byte("foo")
+	taps.publish(ctx, tap.Event{Type: tap.EventInput, Source: "my-source"}, msg)
+	taps.publish(ctx, tap.Event{Type: tap.EventOutput, Sinks: []string{"my-sink"}}, []byte("bar"))
+	msg[0] = 'g' // the message is copied
+	if assert.Len(t, all.events, 2) {
+		e := <-all.events
+		assert.Equal(t, tap.EventInput, e.Type)
+		assert.Equal(t, "my-source", e.Source)
+		assert.Equal(t, "my-id", e.Meta.ID)
+		assert.Equal(t, "foo", string(e.Data))
+		assert.False(t, e.Time.IsZero())
+		e = <-all.events
+		assert.Equal(t, tap.EventOutput, e.Type)
+		assert.Equal(t, []string{"my-sink"}, e.Sinks)
+	}
+	assert.Len(t, none.events, 0)
+	assert.Len(t, filtered.events, 0, "not an input event")
+	t.Run("Dropped", func(t *testing.T) {
+		for i := 0; i < cap(all.events)+1; i++ {
+			taps.publish(ctx, tap.Event{Type: tap.EventError}, msg)
+		}
+		assert.Equal(t, uint64(1), all.dropped)
+	})
+	t.Run("Unsubscribed", func(t *testing.T) {
+		taps.unsubscribe(none)
+		assert.Len(t, taps.subscribers, 2)
+	})
+}
+
+func Test_taps_handler(t *testing.T) {
+	taps := newTaps()
+	server := httptest.NewServer(taps.handler("Bearer my-token"))
+	defer server.Close()
+	do := func(ctx context.Context, method, authorization string) *http.Response {
+		req, err := http.NewRequestWithContext(ctx, method, server.URL+"?types=error", nil)
+		assert.NoError(t, err)
+		req.Header.Set("Authorization", authorization)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		return resp
+	}
+	ctx := context.Background()
+	resp := do(ctx, http.MethodGet, "Bearer wrong")
+	_ = resp.Body.Close()
+	assert.Equal(t, 403, resp.StatusCode)
+	resp = do(ctx, http.MethodPost, "Bearer my-token")
+	_ = resp.Body.Close()
+	assert.Equal(t, 405, resp.StatusCode)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	resp = do(ctx, http.MethodGet, "Bearer my-token")
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	// the subscriber is added before the headers are written
+	taps.publish(dfv1.ContextWithMeta(ctx, dfv1.Meta{ID: "my-id"}), tap.Event{Type: tap.EventError, Source: "my-source", Error: "my-error"}, []byte("foo"))
+	var events []tap.Event
+	err := tap.Read(resp.Body, func(e tap.Event) error {
+		events = append(events, e)
+		cancel()
+		return nil
+	}, func(int) error { return nil })
+	assert.Error(t, err, "cancelled")
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "my-error", events[0].Error)
+		assert.Equal(t, "my-id", events[0].Meta.ID)
+		assert.Equal(t, "foo", string(events[0].Data))
+	}
+}
@@ -0,0 +1,47 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+// newThrottledProcess returns a function that limits the rate, and the number of messages processed at once, using
+// process. The time each message waits for each limiter is counted, so that saturation can be monitored.
+func newThrottledProcess(s dfv1.Source, process func(context.Context, []byte) error, inflightGauge *prometheus.GaugeVec, throttledCounter *prometheus.CounterVec) func(context.Context, []byte) error {
+	var limiter *rate.Limiter
+	if x := s.RateLimit; x != nil {
+		limiter = rate.NewLimiter(rate.Limit(x.MessagesPerSecond), x.GetBurst())
+	}
+	var sem chan struct{}
+	if s.MaxConcurrency > 0 {
+		sem = make(chan struct{}, s.MaxConcurrency)
+	}
+	inflight := inflightGauge.WithLabelValues(s.Name, fmt.Sprint(replica))
+	return func(ctx context.Context, msg []byte) error {
+		if sem != nil {
+			start := time.Now()
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("could not send message: %w", ctx.Err())
+			case sem <- struct{}{}:
+			}
+			defer func() { <-sem }()
+			throttledCounter.WithLabelValues(s.Name, fmt.Sprint(replica), "concurrency").Add(time.Since(start).Seconds())
+		}
+		if limiter != nil {
+			start := time.Now()
+			if err := limiter.Wait(ctx); err != nil {
+				return fmt.Errorf("could not send message: %w", err)
+			}
+			throttledCounter.WithLabelValues(s.Name, fmt.Sprint(replica), "rate").Add(time.Since(start).Seconds())
+		}
+		inflight.Inc()
+		defer inflight.Dec()
+		return process(ctx, msg)
+	}
+}
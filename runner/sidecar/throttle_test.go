@@ -0,0 +1,67 @@
+package sidecar
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newThrottledProcess(t *testing.T) {
+	newMetrics := func() (*prometheus.GaugeVec, *prometheus.CounterVec) {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "inflight"}, []string{"sourceName", "replica"}),
+			prometheus.NewCounterVec(prometheus.CounterOpts{Name: "throttled"}, []string{"sourceName", "replica", "limiter"})
+	}
+	t.Run("MaxConcurrency", func(t *testing.T) {
+		var inflight, maxInflight int32
+		inflightGauge, throttledCounter := newMetrics()
+		p := newThrottledProcess(dfv1.Source{Name: "my-source", MaxConcurrency: 2}, func(ctx context.Context, msg []byte) error {
+			n := atomic.AddInt32(&inflight, 1)
+			defer atomic.AddInt32(&inflight, -1)
+			for {
+				m := atomic.LoadInt32(&maxInflight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInflight, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		}, inflightGauge, throttledCounter)
+		wg := sync.WaitGroup{}
+		for i := 0; i < 6; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, p(context.Background(), nil))
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(2), maxInflight)
+	})
+	t.Run("RateLimit", func(t *testing.T) {
+		inflightGauge, throttledCounter := newMetrics()
+		p := newThrottledProcess(dfv1.Source{Name: "my-source", RateLimit: &dfv1.RateLimit{MessagesPerSecond: 20}}, func(ctx context.Context, msg []byte) error {
+			return nil
+		}, inflightGauge, throttledCounter)
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, p(context.Background(), nil))
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		inflightGauge, throttledCounter := newMetrics()
+		p := newThrottledProcess(dfv1.Source{Name: "my-source", RateLimit: &dfv1.RateLimit{MessagesPerSecond: 1}}, func(ctx context.Context, msg []byte) error {
+			return nil
+		}, inflightGauge, throttledCounter)
+		assert.NoError(t, p(context.Background(), nil))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.Error(t, p(ctx, nil))
+	})
+}
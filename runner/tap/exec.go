@@ -0,0 +1,150 @@
+package tap
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+var logger = sharedutil.NewLogger()
+
+// Exec is the "tap" command. It port-forwards to a step's pod, and prints the messages its sidecar's tap endpoint
+// streams.
+func Exec(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("tap", flag.ContinueOnError)
+	namespace := flags.String("namespace", "", "the pipeline's namespace, defaults to the current namespace")
+	pipelineName := flags.String("pipeline", "", "the pipeline's name")
+	stepName := flags.String("step", "", "the step's name")
+	replica := flags.Int("replica", 0, "the replica to tap")
+	sample := flags.Float64("sample", 1, "the fraction of messages to stream, between 0 and 1")
+	filter := flags.String("filter", "", "an expression, only messages it returns true for are streamed, e.g. string(msg) contains \"foo\"")
+	types := flags.String("types", "", "the event types to stream, comma separated, defaults to input,output,error")
+	asJSON := flags.Bool("json", false, "print each event as JSON, one per line")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *pipelineName == "" || *stepName == "" {
+		return fmt.Errorf("--pipeline and --step are required")
+	}
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{})
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+	if *namespace == "" {
+		if *namespace, _, err = clientConfig.Namespace(); err != nil {
+			return err
+		}
+	}
+	kubernetesInterface, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	secretName := *pipelineName + "-" + *stepName
+	secret, err := kubernetesInterface.CoreV1().Secrets(*namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get secret %q: %w", secretName, err)
+	}
+	authorization := string(secret.Data["tap.authorization"])
+	if authorization == "" {
+		return fmt.Errorf("secret %q does not have a tap authorization, it was created by an earlier version, delete it and restart the step to re-create it", secretName)
+	}
+
+	podName := fmt.Sprintf("%s-%d", secretName, *replica)
+	transport, upgrader, err := spdy.RoundTripperFor(restConfig)
+	if err != nil {
+		return err
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST",
+		kubernetesInterface.CoreV1().RESTClient().Post().Namespace(*namespace).Resource("pods").Name(podName).SubResource("portforward").URL())
+	stopChan, readyChan := make(chan struct{}), make(chan struct{})
+	defer close(stopChan)
+	forwarder, err := portforward.New(dialer, []string{"0:3570"}, stopChan, readyChan, ioutil.Discard, os.Stderr)
+	if err != nil {
+		return err
+	}
+	errs := make(chan error, 1)
+	go func() { errs <- forwarder.ForwardPorts() }()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-errs:
+		return fmt.Errorf("failed to port-forward to pod %q: %w", podName, err)
+	case <-readyChan:
+	}
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("sample", fmt.Sprint(*sample))
+	query.Set("filter", *filter)
+	query.Set("types", *types)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("https://localhost:%d/tap?%s", ports[0].Local, query.Encode()), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", authorization)
+	client := &http.Client{
+		// the sidecar's certificate is self-signed, and we connect to it via the port-forward
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}
+	logger.Info("tapping", "pod", podName, "sample", *sample, "filter", *filter, "types", *types)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != 200 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("failed to tap: %q %q", resp.Status, body)
+	}
+	print := func(e Event) error { return Print(os.Stdout, e) }
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		print = func(e Event) error { return encoder.Encode(e) }
+	}
+	return Read(resp.Body, print, func(n int) error {
+		logger.Info("the sidecar dropped events, because they were not read fast enough", "dropped", n)
+		return nil
+	})
+}
+
+// Print prints the event on one line, with its data as text if it is valid UTF-8, otherwise as base64.
+func Print(w io.Writer, e Event) error {
+	var parts []string
+	switch e.Type {
+	case EventOutput:
+		parts = append(parts, "sinks="+strings.Join(e.Sinks, ","))
+	case EventError:
+		parts = append(parts, "source="+e.Source, fmt.Sprintf("error=%q", e.Error))
+	default:
+		parts = append(parts, "source="+e.Source)
+	}
+	parts = append(parts, "id="+e.Meta.ID)
+	if utf8.Valid(e.Data) {
+		parts = append(parts, fmt.Sprintf("data=%q", e.Data))
+	} else {
+		parts = append(parts, "base64="+base64.StdEncoding.EncodeToString(e.Data))
+	}
+	_, err := fmt.Fprintf(w, "%s %-6s %s\n", e.Time.Format("15:04:05.000"), e.Type, strings.Join(parts, " "))
+	return err
+}
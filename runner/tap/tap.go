@@ -0,0 +1,86 @@
+package tap
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+)
+
+type EventType string
+
+const (
+	EventInput  EventType = "input"  // a message from a source
+	EventOutput EventType = "output" // a message to the sinks
+	EventError  EventType = "error"  // a message that failed to process
+)
+
+// EventTypes are all the event types.
+var EventTypes = []EventType{EventInput, EventOutput, EventError}
+
+// Event is a message, or a message that failed to process, seen by a step's sidecar. It is streamed by the sidecar's
+// tap endpoint as server-sent events.
+type Event struct {
+	Type EventType `json:"type"`
+	Time time.Time `json:"time"`
+	// Source is the source the message came from, for input and error events.
+	Source string `json:"source,omitempty"`
+	// Sinks are the sinks the message is sent to, for output events.
+	Sinks []string `json:"sinks,omitempty"`
+	// Error is the error from the attempt, for error events.
+	Error string    `json:"error,omitempty"`
+	Meta  dfv1.Meta `json:"meta"`
+	// Data is base64 encoded in JSON.
+	Data []byte `json:"data"`
+}
+
+// Read reads server-sent events, calling event for each event, and dropped when the sidecar dropped events because
+// they were not read fast enough.
+func Read(in io.Reader, event func(Event) error, dropped func(n int) error) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, 16*1024*1024) // messages may be large
+	var name, data string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "": // the end of the event
+			if data == "" {
+				continue
+			}
+			var err error
+			if name == "dropped" {
+				var n int
+				if n, err = parseDropped(data); err == nil {
+					err = dropped(n)
+				}
+			} else {
+				e := Event{}
+				if err = json.Unmarshal([]byte(data), &e); err == nil {
+					err = event(e)
+				}
+			}
+			if err != nil {
+				return err
+			}
+			name, data = "", ""
+		case strings.HasPrefix(line, ":"): // a comment, e.g. keep-alive
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data += strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		}
+	}
+	return scanner.Err()
+}
+
+func parseDropped(data string) (int, error) {
+	var n int
+	if _, err := fmt.Sscan(data, &n); err != nil {
+		return 0, fmt.Errorf("failed to parse dropped %q: %w", data, err)
+	}
+	return n, nil
+}
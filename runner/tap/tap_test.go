@@ -0,0 +1,71 @@
+package tap
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	in := strings.NewReader(`:
+
+event: input
+data: {"type":"input","source":"my-source","meta":{"id":"my-id"},"data":"Zm9v"}
+
+event: dropped
+data: 2
+
+event: output
+data: {"type":"output","sinks":["my-sink"],"meta":{},"data":"YmFy"}
+
+`)
+	var events []Event
+	dropped := 0
+	err := Read(in, func(e Event) error {
+		events = append(events, e)
+		return nil
+	}, func(n int) error {
+		dropped += n
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, dropped)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, EventInput, events[0].Type)
+		assert.Equal(t, "my-source", events[0].Source)
+		assert.Equal(t, "my-id", events[0].Meta.ID)
+		assert.Equal(t, "foo", string(events[0].Data))
+		assert.Equal(t, []string{"my-sink"}, events[1].Sinks)
+	}
+	t.Run("Error", func(t *testing.T) {
+		err := Read(strings.NewReader("data: {}\n\n"), func(Event) error { return fmt.Errorf("my-error") }, nil)
+		assert.EqualError(t, err, "my-error")
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		err := Read(strings.NewReader("data: {\n\n"), func(Event) error { return nil }, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestPrint(t *testing.T) {
+	at := time.Date(2021, 10, 1, 12, 34, 56, 0, time.UTC)
+	for _, tt := range []struct {
+		e    Event
+		want string
+	}{
+		{Event{Type: EventInput, Time: at, Source: "my-source", Meta: dfv1.Meta{ID: "my-id"}, Data: []byte("foo")}, `12:34:56.000 input  source=my-source id=my-id data="foo"`},
+		{Event{Type: EventOutput, Time: at, Sinks: []string{"a", "b"}, Data: []byte("foo")}, `12:34:56.000 output sinks=a,b id= data="foo"`},
+		{Event{Type: EventError, Time: at, Source: "my-source", Error: "my-error", Data: []byte{0xff}}, `12:34:56.000 error  source=my-source error="my-error" id= base64=/w==`},
+	} {
+		t.Run(string(tt.e.Type), func(t *testing.T) {
+			w := &bytes.Buffer{}
+			assert.NoError(t, Print(w, tt.e))
+			assert.Equal(t, tt.want+"\n", w.String())
+		})
+	}
+}
@@ -23,12 +23,20 @@ func ExprEnv(ctx context.Context, msg []byte) (map[string]interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	headers := m.Headers
+	if headers == nil {
+		headers = map[string]string{}
+	}
 	return map[string]interface{}{
 		// values
 		"ctx": map[string]interface{}{
-			"source": m.Source,
-			"id":     m.ID,
-			"time":   time.Unix(m.Time, 0).UTC().Format(time.RFC3339),
+			"source":     m.Source,
+			"id":         m.ID,
+			"time":       time.Unix(m.Time, 0).UTC().Format(time.RFC3339),
+			"sourceName": m.SourceName,
+			"topic":      m.Topic,
+			"partition":  int(m.Partition),
+			"headers":    headers,
 		},
 		"msg": msg,
 		// funcs
@@ -11,18 +11,26 @@ import (
 func Test_ExprEnv(t *testing.T) {
 	ctx := context.Background()
 	ctx = dfv1.ContextWithMeta(ctx, dfv1.Meta{
-		Source: "my-source",
-		ID:     "my-id",
-		Time:   1,
+		Source:     "my-source",
+		ID:         "my-id",
+		Time:       1,
+		SourceName: "my-source-name",
+		Topic:      "my-topic",
+		Partition:  2,
+		Headers:    map[string]string{"tenant-id": "my-tenant"},
 	})
 	env, err := ExprEnv(ctx, []byte{0})
 	assert.NoError(t, err)
 	assert.Len(t, env, 10)
 	c := env["ctx"].(map[string]interface{})
-	assert.Len(t, c, 3)
+	assert.Len(t, c, 7)
 	assert.Equal(t, c["source"], "my-source")
 	assert.Equal(t, c["id"], "my-id")
 	assert.Equal(t, c["time"], "1970-01-01T00:00:01Z")
+	assert.Equal(t, c["sourceName"], "my-source-name")
+	assert.Equal(t, c["topic"], "my-topic")
+	assert.Equal(t, c["partition"], 2)
+	assert.Equal(t, c["headers"], map[string]string{"tenant-id": "my-tenant"})
 }
 
 func Test__int(t *testing.T) {
@@ -1,4 +1,7 @@
 #!/bin/sh
 
 echo '// Code generated by gen.sh. DO NOT EDIT.' > meta.go
-sed 's/package v1alpha1/package golang/' < ../../api/v1alpha1/meta.go >> meta.go
\ No newline at end of file
+sed 's/package v1alpha1/package golang/' < ../../api/v1alpha1/meta.go >> meta.go
+
+echo '// Code generated by gen.sh. DO NOT EDIT.' > batch.go
+sed 's/package v1alpha1/package golang/' < ../../api/v1alpha1/batch.go >> batch.go
@@ -0,0 +1,119 @@
+package golang
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/argoproj-labs/argo-dataflow/sdks/golang/processor"
+	"google.golang.org/grpc"
+)
+
+// StartGRPC starts a gRPC server for the handler, use this when the step's `in` is `grpc`. The handler may return many
+// outputs for each message, or none. Messages are processed concurrently.
+func StartGRPC(handler func(ctx context.Context, msg []byte) ([][]byte, error)) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+	if err := StartGRPCWithContext(ctx, handler); err != nil {
+		panic(err)
+	}
+}
+
+func StartGRPCWithContext(ctx context.Context, handler func(ctx context.Context, msg []byte) ([][]byte, error)) error {
+	address := "/var/run/argo-dataflow/main.sock"
+	if err := os.Remove(address); !os.IsNotExist(err) && err != nil {
+		return err
+	}
+	listener, err := net.Listen("unix", address)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = listener.Close() }()
+	server := NewGRPCServer(handler)
+	go func() {
+		defer HandleCrash()
+		if err := server.Serve(listener); err != nil {
+			panic(err)
+		}
+	}()
+	log.Println("ready")
+	defer log.Println("done")
+	<-ctx.Done()
+	server.GracefulStop()
+	return nil
+}
+
+// NewGRPCServer returns a gRPC server for the handler, that has not been started.
+func NewGRPCServer(handler func(ctx context.Context, msg []byte) ([][]byte, error)) *grpc.Server {
+	server := grpc.NewServer()
+	processor.RegisterProcessorServer(server, &processorServer{handler: handler})
+	return server
+}
+
+type processorServer struct {
+	processor.UnimplementedProcessorServer
+	handler func(ctx context.Context, msg []byte) ([][]byte, error)
+}
+
+func (s *processorServer) Process(stream processor.Processor_ProcessServer) error {
+	mu := sync.Mutex{} // streams are not safe for concurrent sends
+	send := func(out *processor.Output) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err := stream.Send(out); err != nil {
+			log.Printf("failed to send output: %v\n", err)
+		}
+	}
+	wg := sync.WaitGroup{}
+	// we cannot send after we return
+	defer wg.Wait()
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m := in.GetMeta()
+			// so the handler can set headers
+			headers := map[string]string{}
+			for k, v := range m.GetHeaders() {
+				headers[k] = v
+			}
+			ctx := ContextWithMeta(stream.Context(), Meta{
+				Source:     m.GetSource(),
+				ID:         m.GetId(),
+				Time:       m.GetTime(),
+				SourceName: m.GetSourceName(),
+				Topic:      m.GetTopic(),
+				Partition:  m.GetPartition(),
+				Headers:    headers,
+			})
+			outputs, err := func() (outputs [][]byte, err error) {
+				defer func() {
+					if r := recover(); r != nil {
+						err = fmt.Errorf("recovered from crash: %v", r)
+					}
+				}()
+				return s.handler(ctx, in.Data)
+			}()
+			if err != nil {
+				send(&processor.Output{Seq: in.Seq, Error: err.Error(), Done: true})
+				return
+			}
+			for _, data := range outputs {
+				send(&processor.Output{Seq: in.Seq, Data: data, Headers: headers})
+			}
+			send(&processor.Output{Seq: in.Seq, Done: true})
+		}()
+	}
+}
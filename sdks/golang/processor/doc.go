@@ -0,0 +1,5 @@
+// Package processor is the gRPC interface between the sidecar and the main container.
+package processor
+
+//go:generate protoc -I . --go_out=. --go_opt=paths=source_relative processor.proto
+//go:generate protoc -I . --go-grpc_out=. --go-grpc_opt=paths=source_relative processor.proto
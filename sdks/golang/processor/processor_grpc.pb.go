@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: processor.proto
+
+package processor
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProcessorClient is the client API for Processor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProcessorClient interface {
+	// Process is a stream of messages from the sidecar. The sidecar sends messages without waiting for the outputs of
+	// previous ones, so the main container may process them concurrently. For each input, the main container sends zero
+	// or more outputs, and then an output with `done` (or `error`) set, which may be interleaved with the outputs for
+	// other inputs.
+	Process(ctx context.Context, opts ...grpc.CallOption) (Processor_ProcessClient, error)
+}
+
+type processorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProcessorClient(cc grpc.ClientConnInterface) ProcessorClient {
+	return &processorClient{cc}
+}
+
+func (c *processorClient) Process(ctx context.Context, opts ...grpc.CallOption) (Processor_ProcessClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Processor_ServiceDesc.Streams[0], "/dataflow.processor.Processor/Process", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &processorProcessClient{stream}
+	return x, nil
+}
+
+type Processor_ProcessClient interface {
+	Send(*Input) error
+	Recv() (*Output, error)
+	grpc.ClientStream
+}
+
+type processorProcessClient struct {
+	grpc.ClientStream
+}
+
+func (x *processorProcessClient) Send(m *Input) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *processorProcessClient) Recv() (*Output, error) {
+	m := new(Output)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ProcessorServer is the server API for Processor service.
+// All implementations must embed UnimplementedProcessorServer
+// for forward compatibility
+type ProcessorServer interface {
+	// Process is a stream of messages from the sidecar. The sidecar sends messages without waiting for the outputs of
+	// previous ones, so the main container may process them concurrently. For each input, the main container sends zero
+	// or more outputs, and then an output with `done` (or `error`) set, which may be interleaved with the outputs for
+	// other inputs.
+	Process(Processor_ProcessServer) error
+	mustEmbedUnimplementedProcessorServer()
+}
+
+// UnimplementedProcessorServer must be embedded to have forward compatible implementations.
+type UnimplementedProcessorServer struct {
+}
+
+func (UnimplementedProcessorServer) Process(Processor_ProcessServer) error {
+	return status.Errorf(codes.Unimplemented, "method Process not implemented")
+}
+func (UnimplementedProcessorServer) mustEmbedUnimplementedProcessorServer() {}
+
+// UnsafeProcessorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProcessorServer will
+// result in compilation errors.
+type UnsafeProcessorServer interface {
+	mustEmbedUnimplementedProcessorServer()
+}
+
+func RegisterProcessorServer(s grpc.ServiceRegistrar, srv ProcessorServer) {
+	s.RegisterService(&Processor_ServiceDesc, srv)
+}
+
+func _Processor_Process_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProcessorServer).Process(&processorProcessServer{stream})
+}
+
+type Processor_ProcessServer interface {
+	Send(*Output) error
+	Recv() (*Input, error)
+	grpc.ServerStream
+}
+
+type processorProcessServer struct {
+	grpc.ServerStream
+}
+
+func (x *processorProcessServer) Send(m *Output) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *processorProcessServer) Recv() (*Input, error) {
+	m := new(Input)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Processor_ServiceDesc is the grpc.ServiceDesc for Processor service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Processor_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dataflow.processor.Processor",
+	HandlerType: (*ProcessorServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Process",
+			Handler:       _Processor_Process_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "processor.proto",
+}
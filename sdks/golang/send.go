@@ -0,0 +1,40 @@
+package golang
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+var sendClient = &http.Client{Timeout: 10 * time.Second}
+
+// SendMessage sends a message to the sidecar, which writes it to the step's sinks.
+// Use this to send messages that are not a response to an incoming message, e.g. from a generator or background goroutine.
+// If the context contains meta-data, the message ID is sent too.
+func SendMessage(ctx context.Context, msg []byte) error {
+	authorization, err := ioutil.ReadFile("/var/run/argo-dataflow/authorization")
+	if err != nil {
+		return fmt.Errorf("failed to read authorization file: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", "http://localhost:3569/messages", bytes.NewBuffer(msg))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", string(authorization))
+	if m, err := MetaFromContext(ctx); err == nil {
+		req.Header.Set(MetaID, m.ID)
+	}
+	resp, err := sendClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to send message: %q %q", resp.Status, body)
+	}
+	return nil
+}
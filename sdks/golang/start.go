@@ -2,8 +2,11 @@ package golang
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"log"
+	"mime/multipart"
 	"net"
 	"net/http"
 	"os"
@@ -22,9 +25,6 @@ func Start(handler func(ctx context.Context, msg []byte) ([]byte, error)) {
 }
 
 func StartWithContext(ctx context.Context, handler func(ctx context.Context, msg []byte) ([]byte, error)) error {
-	http.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(204)
-	})
 	http.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
 		ctx := MetaExtract(r.Context(), r.Header)
 		out, err := func() ([]byte, error) {
@@ -40,12 +40,109 @@ func StartWithContext(ctx context.Context, handler func(ctx context.Context, msg
 			w.WriteHeader(500)
 			_, _ = w.Write([]byte(err.Error()))
 		} else if out != nil {
+			injectHeaders(ctx, w.Header())
 			w.WriteHeader(201)
 			_, _ = w.Write(out)
 		} else {
 			w.WriteHeader(204)
 		}
 	})
+	return serve(ctx)
+}
+
+// StartMulti starts a handler that may return many output messages for each message, or none. They are returned to
+// the sidecar as a "multipart/mixed" response, and each is sent to the sinks.
+func StartMulti(handler func(ctx context.Context, msg []byte) ([][]byte, error)) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+	if err := StartMultiWithContext(ctx, handler); err != nil {
+		panic(err)
+	}
+}
+
+func StartMultiWithContext(ctx context.Context, handler func(ctx context.Context, msg []byte) ([][]byte, error)) error {
+	http.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		ctx := MetaExtract(r.Context(), r.Header)
+		outs, err := func() ([][]byte, error) {
+			in, err := ioutil.ReadAll(r.Body)
+			_ = r.Body.Close()
+			if err != nil {
+				return nil, err
+			} else {
+				return handler(ctx, in)
+			}
+		}()
+		if err != nil {
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte(err.Error()))
+		} else if len(outs) > 0 {
+			mw := multipart.NewWriter(w)
+			w.Header().Set("Content-Type", "multipart/mixed; boundary="+mw.Boundary())
+			injectHeaders(ctx, w.Header())
+			w.WriteHeader(201)
+			for _, out := range outs {
+				pw, err := mw.CreatePart(nil)
+				if err != nil {
+					return
+				}
+				_, _ = pw.Write(out)
+			}
+			_ = mw.Close()
+		} else {
+			w.WriteHeader(204)
+		}
+	})
+	return serve(ctx)
+}
+
+// StartBatch starts a handler for batches of messages, use this when the step's `in.http.maxBatchSize` is greater than
+// one. The handler must return a result for each message, in the same order. Use ContextWithMeta(ctx, msg.Meta) to get
+// a message's context.
+func StartBatch(handler func(ctx context.Context, msgs []BatchMessage) []BatchResult) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+	if err := StartBatchWithContext(ctx, handler); err != nil {
+		panic(err)
+	}
+}
+
+func StartBatchWithContext(ctx context.Context, handler func(ctx context.Context, msgs []BatchMessage) []BatchResult) error {
+	http.HandleFunc("/messages/batch", func(w http.ResponseWriter, r *http.Request) {
+		out, err := func() ([]byte, error) {
+			var msgs []BatchMessage
+			err := json.NewDecoder(r.Body).Decode(&msgs)
+			_ = r.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			results := handler(r.Context(), msgs)
+			if len(results) != len(msgs) {
+				return nil, fmt.Errorf("handler returned %d results for %d messages", len(results), len(msgs))
+			}
+			return json.Marshal(results)
+		}()
+		if err != nil {
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte(err.Error()))
+		} else {
+			w.WriteHeader(200)
+			_, _ = w.Write(out)
+		}
+	})
+	return serve(ctx)
+}
+
+// injectHeaders adds the message's headers, including any set by the handler, to the response
+func injectHeaders(ctx context.Context, h http.Header) {
+	if m, err := MetaFromContext(ctx); err == nil {
+		HeadersInject(m.Headers, h)
+	}
+}
+
+func serve(ctx context.Context) error {
+	http.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(204)
+	})
 	// https://medium.com/honestbee-tw-engineer/gracefully-shutdown-in-go-http-server-5f5e6b83da5a
 	httpServer := &http.Server{Addr: ":8080"}
 	go func() {
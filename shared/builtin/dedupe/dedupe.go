@@ -3,7 +3,6 @@ package dedupe
 import (
 	"context"
 	"fmt"
-	"sync"
 	"time"
 
 	"github.com/antonmedv/expr"
@@ -16,13 +15,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
-var (
-	logger = sharedutil.NewLogger()
-	db     = &uniqItems{ids: map[string]*item{}}
-	mu     = sync.Mutex{}
-)
+var logger = sharedutil.NewLogger()
 
-func New(ctx context.Context, uid string, maxSize resource.Quantity) (builtin.Process, error) {
+func New(ctx context.Context, uid string, db Store) (builtin.Process, error) {
 	duplicates := promauto.NewCounter(prometheus.CounterOpts{
 		Name: "duplicate_messages",
 		Help: "Duplicates messages, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#duplicate_messages",
@@ -33,22 +28,20 @@ func New(ctx context.Context, uid string, maxSize resource.Quantity) (builtin.Pr
 		return nil, fmt.Errorf("failed to compile %q: %w", uid, err)
 	}
 
-	int64MaxSize, ok := maxSize.AsInt64()
-	if !ok {
-		return nil, fmt.Errorf("max size %v must be int64", maxSize)
-	}
-
 	go wait.JitterUntil(func() {
-		size := db.size()
-		logger.Info("garbage collection", "size", resource.NewQuantity(int64(size), resource.DecimalSI), "maxSize", maxSize, "duplicates", duplicates)
-		for db.size() > int(int64MaxSize) {
-			func() {
-				mu.Lock()
-				defer mu.Unlock()
-				db.shrink()
-			}()
+		size, err := db.GC(ctx)
+		if err != nil {
+			logger.Error(err, "failed to garbage collect")
+			return
 		}
+		logger.Info("garbage collection", "size", resource.NewQuantity(int64(size), resource.DecimalSI), "duplicates", duplicates)
 	}, 15*time.Second, 1.2, true, ctx.Done())
+	go func() {
+		<-ctx.Done()
+		if err := db.Close(); err != nil {
+			logger.Error(err, "failed to close store")
+		}
+	}()
 	return func(ctx context.Context, msg []byte) ([]byte, error) {
 		env, err := util.ExprEnv(ctx, msg)
 		if err != nil {
@@ -62,9 +55,10 @@ func New(ctx context.Context, uid string, maxSize resource.Quantity) (builtin.Pr
 		if !ok {
 			return nil, fmt.Errorf("expression did not evaluate to string")
 		}
-		mu.Lock()
-		defer mu.Unlock()
-		dupe := db.update(id)
+		dupe, err := db.Seen(ctx, id)
+		if err != nil {
+			return nil, err
+		}
 		if dupe {
 			duplicates.Inc()
 			return nil, nil
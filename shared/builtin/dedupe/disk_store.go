@@ -0,0 +1,92 @@
+package dedupe
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var uidsBucket = []byte("uids")
+
+// diskStore keeps UIDs in an embedded database file, so they survive restarts. Each UID expires after the TTL.
+type diskStore struct {
+	db  *bolt.DB
+	ttl time.Duration
+	now func() time.Time
+}
+
+func NewDiskStore(path string, ttl time.Duration) (Store, error) {
+	// the timeout prevents us waiting forever if another process (e.g. the previous container) has the file open
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 30 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", path, err)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(uidsBucket)
+		return err
+	}); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create bucket: %w", err)
+	}
+	return &diskStore{db: db, ttl: ttl, now: time.Now}, nil
+}
+
+func (s *diskStore) expired(v []byte, now time.Time) bool {
+	return !now.Before(time.Unix(0, int64(binary.BigEndian.Uint64(v))).Add(s.ttl))
+}
+
+func (s *diskStore) Seen(_ context.Context, uid string) (bool, error) {
+	seen := false
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(uidsBucket)
+		now := s.now()
+		if v := b.Get([]byte(uid)); v != nil && !s.expired(v, now) {
+			seen = true
+			return nil
+		}
+		v := make([]byte, 8)
+		binary.BigEndian.PutUint64(v, uint64(now.UnixNano()))
+		return b.Put([]byte(uid), v)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to record UID: %w", err)
+	}
+	return seen, nil
+}
+
+func (s *diskStore) GC(context.Context) (int, error) {
+	size := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(uidsBucket)
+		now := s.now()
+		// deleting while iterating with a cursor can skip items, so collect the expired keys first
+		var expired [][]byte
+		if err := b.ForEach(func(k, v []byte) error {
+			if s.expired(v, now) {
+				expired = append(expired, append([]byte(nil), k...))
+			} else {
+				size++
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range expired {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove expired UIDs: %w", err)
+	}
+	return size, nil
+}
+
+func (s *diskStore) Close() error {
+	return s.db.Close()
+}
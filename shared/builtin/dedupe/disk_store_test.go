@@ -0,0 +1,50 @@
+package dedupe
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_diskStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "dedupe.db")
+	now := time.Unix(1000, 0)
+	open := func() *diskStore {
+		s, err := NewDiskStore(path, time.Minute)
+		assert.NoError(t, err)
+		x := s.(*diskStore)
+		x.now = func() time.Time { return now }
+		return x
+	}
+	s := open()
+	seen, err := s.Seen(ctx, "foo")
+	assert.NoError(t, err)
+	assert.False(t, seen)
+	seen, err = s.Seen(ctx, "foo")
+	assert.NoError(t, err)
+	assert.True(t, seen)
+	assert.NoError(t, s.Close())
+	t.Run("SurvivesRestart", func(t *testing.T) {
+		s = open()
+		seen, err := s.Seen(ctx, "foo")
+		assert.NoError(t, err)
+		assert.True(t, seen)
+	})
+	t.Run("Expiry", func(t *testing.T) {
+		_, _ = s.Seen(ctx, "bar")
+		now = now.Add(30 * time.Second)
+		_, _ = s.Seen(ctx, "baz")
+		now = now.Add(30 * time.Second)
+		size, err := s.GC(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, size, "only baz remains")
+		seen, err := s.Seen(ctx, "foo")
+		assert.NoError(t, err)
+		assert.False(t, seen, "foo has expired")
+	})
+	assert.NoError(t, s.Close())
+}
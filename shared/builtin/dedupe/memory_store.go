@@ -0,0 +1,34 @@
+package dedupe
+
+import (
+	"context"
+	"sync"
+)
+
+// memoryStore keeps the most recent UIDs in-memory, so they are lost on restart.
+type memoryStore struct {
+	mu      sync.Mutex
+	db      *uniqItems
+	maxSize int
+}
+
+func NewMemoryStore(maxSize int) Store {
+	return &memoryStore{db: &uniqItems{ids: map[string]*item{}}, maxSize: maxSize}
+}
+
+func (s *memoryStore) Seen(_ context.Context, uid string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.db.update(uid), nil
+}
+
+func (s *memoryStore) GC(context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for s.db.size() > s.maxSize {
+		s.db.shrink()
+	}
+	return s.db.size(), nil
+}
+
+func (s *memoryStore) Close() error { return nil }
@@ -0,0 +1,24 @@
+package dedupe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_memoryStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore(1)
+	seen, err := s.Seen(ctx, "foo")
+	assert.NoError(t, err)
+	assert.False(t, seen)
+	seen, err = s.Seen(ctx, "foo")
+	assert.NoError(t, err)
+	assert.True(t, seen)
+	_, _ = s.Seen(ctx, "bar")
+	size, err := s.GC(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, size)
+	assert.NoError(t, s.Close())
+}
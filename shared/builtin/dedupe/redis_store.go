@@ -0,0 +1,47 @@
+package dedupe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// redisStore keeps UIDs in Redis, so they survive restarts and are shared between replicas. Each UID expires after the
+// TTL.
+type redisStore struct {
+	client    *redis.Client
+	keyPrefix string
+	ttl       time.Duration
+}
+
+func NewRedisStore(address, password string, db int, keyPrefix string, ttl time.Duration) Store {
+	// Redis expires keys with millisecond precision, and rejects a zero TTL
+	if ttl < time.Millisecond {
+		ttl = time.Millisecond
+	}
+	return &redisStore{
+		client:    redis.NewClient(&redis.Options{Addr: address, Password: password, DB: db}),
+		keyPrefix: keyPrefix,
+		ttl:       ttl,
+	}
+}
+
+func (s *redisStore) Seen(ctx context.Context, uid string) (bool, error) {
+	// SET only if the key does not exist, which is atomic, so concurrent replicas agree on which one saw the UID first
+	set, err := s.client.SetNX(ctx, s.keyPrefix+uid, "1", s.ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to record UID: %w", err)
+	}
+	return !set, nil
+}
+
+// GC is a no-op, as Redis expires the keys itself
+func (s *redisStore) GC(context.Context) (int, error) {
+	return -1, nil
+}
+
+func (s *redisStore) Close() error {
+	return s.client.Close()
+}
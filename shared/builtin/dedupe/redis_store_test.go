@@ -0,0 +1,199 @@
+package dedupe
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRedis is a stand-in for a Redis server, that only supports the commands the store uses
+type fakeRedis struct {
+	mu       sync.Mutex
+	password string
+	keys     map[string]time.Duration // key -> TTL
+	expiry   map[string]time.Time
+	setError string // if not empty, the error reply to SET
+	listener net.Listener
+}
+
+func newFakeRedis(t *testing.T, password string) *fakeRedis {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	x := &fakeRedis{password: password, keys: map[string]time.Duration{}, expiry: map[string]time.Time{}, listener: l}
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go x.serve(conn)
+		}
+	}()
+	return x
+}
+
+// readCommand reads a command, an array of bulk strings
+func readCommand(r *bufio.Reader) ([]string, error) {
+	readLine := func(prefix byte) (int, error) {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return 0, err
+		}
+		if len(line) < 3 || line[0] != prefix {
+			return 0, fmt.Errorf("invalid line %q", line)
+		}
+		return strconv.Atoi(strings.TrimSpace(line[1:]))
+	}
+	n, err := readLine('*')
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		size, err := readLine('$')
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+		args[i] = string(data[:size])
+	}
+	return args, nil
+}
+
+func (x *fakeRedis) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	r := bufio.NewReader(conn)
+	authed := x.password == ""
+	db := "0"
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		reply := func() string {
+			x.mu.Lock()
+			defer x.mu.Unlock()
+			switch cmd := strings.ToUpper(args[0]); {
+			case cmd == "AUTH":
+				if args[1] != x.password {
+					return "-WRONGPASS invalid password\r\n"
+				}
+				authed = true
+				return "+OK\r\n"
+			case !authed:
+				return "-NOAUTH Authentication required.\r\n"
+			case cmd == "SELECT":
+				db = args[1]
+				return "+OK\r\n"
+			case cmd == "SET" && x.setError != "":
+				return "-" + x.setError + "\r\n"
+			case cmd == "SET":
+				key := db + "/" + args[1]
+				var ttl time.Duration
+				nx := false
+				for i := 3; i < len(args); i++ {
+					switch strings.ToUpper(args[i]) {
+					case "NX":
+						nx = true
+					case "PX", "EX":
+						v, _ := strconv.Atoi(args[i+1])
+						if v <= 0 {
+							return "-ERR invalid expire time in 'set' command\r\n"
+						}
+						ttl = time.Duration(v) * time.Millisecond
+						if strings.ToUpper(args[i]) == "EX" {
+							ttl = time.Duration(v) * time.Second
+						}
+						i++
+					}
+				}
+				if expiry, ok := x.expiry[key]; nx && ok && time.Now().Before(expiry) {
+					return "$-1\r\n"
+				}
+				x.keys[key] = ttl
+				x.expiry[key] = time.Now().Add(ttl)
+				return "+OK\r\n"
+			default:
+				return "-ERR unknown command\r\n"
+			}
+		}()
+		if _, err := conn.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+func Test_redisStore(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeRedis(t, "my-password")
+	address := server.listener.Addr().String()
+	t.Run("SharedBetweenReplicas", func(t *testing.T) {
+		a := NewRedisStore(address, "my-password", 1, "my-prefix:", time.Minute)
+		b := NewRedisStore(address, "my-password", 1, "my-prefix:", time.Minute)
+		seen, err := a.Seen(ctx, "foo")
+		assert.NoError(t, err)
+		assert.False(t, seen)
+		seen, err = b.Seen(ctx, "foo")
+		assert.NoError(t, err)
+		assert.True(t, seen)
+		assert.NoError(t, a.Close())
+		assert.NoError(t, b.Close())
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		assert.Equal(t, time.Minute, server.keys["1/my-prefix:foo"])
+	})
+	t.Run("Expiry", func(t *testing.T) {
+		s := NewRedisStore(address, "my-password", 0, "", 10*time.Millisecond)
+		defer func() { _ = s.Close() }()
+		_, _ = s.Seen(ctx, "bar")
+		time.Sleep(20 * time.Millisecond)
+		seen, err := s.Seen(ctx, "bar")
+		assert.NoError(t, err)
+		assert.False(t, seen)
+		size, err := s.GC(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, -1, size)
+	})
+	t.Run("TTLUnderOneMillisecond", func(t *testing.T) {
+		s := NewRedisStore(address, "my-password", 0, "", time.Microsecond)
+		defer func() { _ = s.Close() }()
+		_, err := s.Seen(ctx, "baz")
+		assert.NoError(t, err)
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		assert.Equal(t, time.Millisecond, server.keys["0/baz"])
+	})
+	t.Run("WrongPassword", func(t *testing.T) {
+		s := NewRedisStore(address, "wrong", 0, "", time.Minute)
+		defer func() { _ = s.Close() }()
+		_, err := s.Seen(ctx, "foo")
+		assert.EqualError(t, err, "failed to record UID: WRONGPASS invalid password")
+	})
+	t.Run("ErrorReply", func(t *testing.T) {
+		server.mu.Lock()
+		server.setError = "OOM command not allowed when used memory > 'maxmemory'"
+		server.mu.Unlock()
+		defer func() {
+			server.mu.Lock()
+			server.setError = ""
+			server.mu.Unlock()
+		}()
+		s := NewRedisStore(address, "my-password", 0, "", time.Minute)
+		defer func() { _ = s.Close() }()
+		_, err := s.Seen(ctx, "foo")
+		assert.EqualError(t, err, "failed to record UID: OOM command not allowed when used memory > 'maxmemory'")
+	})
+}
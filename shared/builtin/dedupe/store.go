@@ -0,0 +1,12 @@
+package dedupe
+
+import "context"
+
+// Store records the UIDs of messages that have been seen.
+type Store interface {
+	// Seen records the UID, and returns true if it was already recorded.
+	Seen(ctx context.Context, uid string) (bool, error)
+	// GC removes UIDs that are no longer needed, and returns how many remain, or -1 if this is not known.
+	GC(ctx context.Context) (int, error)
+	Close() error
+}
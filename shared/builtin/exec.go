@@ -5,3 +5,6 @@ import (
 )
 
 type Process func(ctx context.Context, msg []byte) ([]byte, error)
+
+// Emit sends a message that is not a response to an incoming message onwards, typically to the sidecar
+type Emit func(ctx context.Context, msg []byte) error
@@ -3,11 +3,13 @@ package group
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
+	"time"
 
 	"github.com/antonmedv/expr"
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
@@ -16,8 +18,13 @@ import (
 	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
 	"github.com/google/uuid"
 	"github.com/juju/fslock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+var logger = sharedutil.NewLogger()
+
 func withLock(dir string, f func() ([]byte, error)) ([]byte, error) {
 	mu := fslock.New(fmt.Sprintf("%s.lock", dir))
 	if err := mu.Lock(); err != nil {
@@ -31,7 +38,7 @@ func withLock(dir string, f func() ([]byte, error)) ([]byte, error) {
 	return msgs, err
 }
 
-func New(pathGroups, key, endOfGroup string, groupFormat dfv1.GroupFormat) (builtin.Process, error) {
+func New(ctx context.Context, pathGroups, key, endOfGroup string, groupFormat dfv1.GroupFormat, maxSize int, maxAge, idleTimeout time.Duration, emit builtin.Emit) (builtin.Process, error) {
 	if err := os.Mkdir(pathGroups, 0o700); sharedutil.IgnoreExist(err) != nil {
 		return nil, fmt.Errorf("failed to create groups dir: %w", err)
 	}
@@ -43,6 +50,24 @@ func New(pathGroups, key, endOfGroup string, groupFormat dfv1.GroupFormat) (buil
 	if err != nil {
 		return nil, fmt.Errorf("failed to compile %q: %w", endOfGroup, err)
 	}
+	openGauge := promauto.NewGauge(prometheus.GaugeOpts{
+		Subsystem: "group",
+		Name:      "open",
+		Help:      "Number of open groups, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#group_open",
+	})
+	oldestAgeGauge := promauto.NewGauge(prometheus.GaugeOpts{
+		Subsystem: "group",
+		Name:      "oldest_age_seconds",
+		Help:      "Age of the oldest open group, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#group_oldest_age_seconds",
+	})
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		open, oldest, err := sweep(ctx, pathGroups, groupFormat, maxAge, idleTimeout, emit)
+		if err != nil {
+			logger.Error(err, "failed to sweep groups")
+		}
+		openGauge.Set(float64(open))
+		oldestAgeGauge.Set(oldest.Seconds())
+	}, time.Second)
 	return func(ctx context.Context, msg []byte) ([]byte, error) {
 		env, err := util.ExprEnv(ctx, msg)
 		if err != nil {
@@ -73,46 +98,122 @@ func New(pathGroups, key, endOfGroup string, groupFormat dfv1.GroupFormat) (buil
 			if !ok {
 				return nil, fmt.Errorf("end-of-group expression must return a bool")
 			}
-			if !end {
+			items, err := readGroup(dir)
+			if err != nil {
+				return nil, err
+			}
+			if !end && (maxSize <= 0 || len(items) < maxSize) {
 				return nil, nil
 			}
-			items, err := ioutil.ReadDir(dir)
+			data, err := format(dir, items, groupFormat)
 			if err != nil {
-				return nil, fmt.Errorf("failed to read dir: %w", err)
+				return nil, err
 			}
-			// return items is creating date order, this is only at accuracy of system clock
-			sort.Slice(items, func(i, j int) bool {
-				return items[i].ModTime().Before(items[j].ModTime())
-			})
-			msgs := make([][]byte, len(items))
-			for i, f := range items {
-				msg, err := ioutil.ReadFile(filepath.Join(pathGroups, group, f.Name()))
-				if err != nil {
-					return nil, fmt.Errorf("failed to read file %q: %w", f.Name(), err)
-				}
-				msgs[i] = msg
+			return data, os.RemoveAll(dir)
+		})
+	}, nil
+}
+
+// readGroup returns the message files in the group, in creation order
+func readGroup(dir string) ([]os.FileInfo, error) {
+	items, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dir: %w", err)
+	}
+	// return items is creating date order, this is only at accuracy of system clock
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].ModTime().Before(items[j].ModTime())
+	})
+	return items, nil
+}
+
+// format returns the messages in the group in the required format
+func format(dir string, items []os.FileInfo, groupFormat dfv1.GroupFormat) ([]byte, error) {
+	msgs := make([][]byte, len(items))
+	for i, f := range items {
+		msg, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %q: %w", f.Name(), err)
+		}
+		msgs[i] = msg
+	}
+	switch groupFormat {
+	case dfv1.GroupFormatUnknown:
+	// noop - this is same as default switch branch
+	case dfv1.GroupFormatJSONBytesArray:
+		data, err := json.Marshal(msgs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal messages: %w", err)
+		}
+		return data, nil
+	case dfv1.GroupFormatJSONStringArray:
+		stringMsgs := make([]string, len(items))
+		for i, bytes := range msgs {
+			stringMsgs[i] = string(bytes)
+		}
+		data, err := json.Marshal(stringMsgs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal messages: %w", err)
+		}
+		return data, nil
+	}
+	return nil, fmt.Errorf("unknown group format %q", groupFormat)
+}
+
+// sweep emits any groups that have reached their max age or idle timeout, and returns the number of open groups and
+// the age of the oldest one
+func sweep(ctx context.Context, pathGroups string, groupFormat dfv1.GroupFormat, maxAge, idleTimeout time.Duration, emit builtin.Emit) (int, time.Duration, error) {
+	groups, err := ioutil.ReadDir(pathGroups)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read groups dir: %w", err)
+	}
+	open := 0
+	var oldest time.Duration
+	for _, g := range groups {
+		if !g.IsDir() {
+			continue // e.g. lock files
+		}
+		group := g.Name()
+		dir := filepath.Join(pathGroups, group)
+		isOpen := false
+		var age time.Duration
+		_, err := withLock(dir, func() ([]byte, error) {
+			items, err := readGroup(dir)
+			if errors.Is(err, os.ErrNotExist) {
+				return nil, nil // the group has just been sent onwards
+			} else if err != nil {
+				return nil, err
+			} else if len(items) == 0 {
+				return nil, nil
 			}
-			switch groupFormat {
-			case dfv1.GroupFormatUnknown:
-			// noop - this is same as default switch branch
-			case dfv1.GroupFormatJSONBytesArray:
-				data, err := json.Marshal(msgs)
-				if err != nil {
-					return nil, fmt.Errorf("failed to marshal messages: %w", err)
-				}
-				return data, os.RemoveAll(dir)
-			case dfv1.GroupFormatJSONStringArray:
-				stringMsgs := make([]string, len(items))
-				for i, bytes := range msgs {
-					stringMsgs[i] = string(bytes)
-				}
-				data, err := json.Marshal(stringMsgs)
-				if err != nil {
-					return nil, fmt.Errorf("failed to marshal messages: %w", err)
-				}
-				return data, os.RemoveAll(dir)
+			first, last := items[0], items[len(items)-1]
+			age = time.Since(first.ModTime())
+			if (maxAge <= 0 || age < maxAge) && (idleTimeout <= 0 || time.Since(last.ModTime()) < idleTimeout) {
+				isOpen = true
+				return nil, nil
 			}
-			return nil, fmt.Errorf("unknown group format %q", groupFormat)
+			logger.Info("flushing group", "group", group, "age", age.String(), "size", len(items))
+			data, err := format(dir, items, groupFormat)
+			if err != nil {
+				return nil, err
+			}
+			// the ID is the first message's, so duplicates can be detected if we crash after emitting
+			id := fmt.Sprintf("%s/%s", group, first.Name())
+			if err := emit(dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: "group", ID: id, Time: time.Now().Unix()}), data); err != nil {
+				isOpen = true
+				return nil, err
+			}
+			return nil, os.RemoveAll(dir)
 		})
-	}, nil
+		if isOpen {
+			open++
+			if age > oldest {
+				oldest = age
+			}
+		}
+		if err != nil {
+			return open, oldest, fmt.Errorf("failed to sweep group %q: %w", group, err)
+		}
+	}
+	return open, oldest, nil
 }
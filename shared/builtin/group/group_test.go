@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,7 +17,7 @@ func TestNew(t *testing.T) {
 	tmp, err := os.MkdirTemp("/tmp", "test")
 	assert.NoError(t, err)
 	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "my-id"})
-	p, err := New(tmp, `"1"`, `string(msg) == "end"`, dfv1.GroupFormatJSONStringArray)
+	p, err := New(ctx, tmp, `"1"`, `string(msg) == "end"`, dfv1.GroupFormatJSONStringArray, 0, 0, 0, nil)
 	assert.NoError(t, err)
 	resp, err := p(ctx, []byte("1"))
 	assert.NoError(t, err)
@@ -25,4 +28,47 @@ func TestNew(t *testing.T) {
 	err = json.Unmarshal(resp, &items)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, []string{"1", "end"}, items)
+	t.Run("MaxSize", func(t *testing.T) {
+		// each call to New registers its metrics, so we need a new registry
+		defer func(r prometheus.Registerer) { prometheus.DefaultRegisterer = r }(prometheus.DefaultRegisterer)
+		prometheus.DefaultRegisterer = prometheus.NewRegistry()
+		p, err := New(ctx, t.TempDir(), `"1"`, `string(msg) == "end"`, dfv1.GroupFormatJSONStringArray, 2, 0, 0, nil)
+		assert.NoError(t, err)
+		resp, err := p(ctx, []byte("1"))
+		assert.NoError(t, err)
+		assert.Nil(t, resp)
+		resp, err = p(ctx, []byte("2"))
+		assert.NoError(t, err)
+		items := make([]string, 0)
+		err = json.Unmarshal(resp, &items)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"1", "2"}, items)
+	})
+}
+
+func Test_sweep(t *testing.T) {
+	tmp := t.TempDir()
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "my-id"})
+	var emitted []string
+	emit := func(ctx context.Context, msg []byte) error {
+		emitted = append(emitted, string(msg))
+		return nil
+	}
+	assert.NoError(t, os.Mkdir(filepath.Join(tmp, "1"), 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(tmp, "1", "my-msg"), []byte("1"), 0o600))
+	t.Run("Open", func(t *testing.T) {
+		open, oldest, err := sweep(ctx, tmp, dfv1.GroupFormatJSONStringArray, time.Hour, time.Hour, emit)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, open)
+		assert.Greater(t, oldest, time.Duration(0))
+		assert.Empty(t, emitted)
+	})
+	t.Run("IdleTimeout", func(t *testing.T) {
+		open, _, err := sweep(ctx, tmp, dfv1.GroupFormatJSONStringArray, 0, time.Nanosecond, emit)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, open)
+		assert.Equal(t, []string{`["1"]`}, emitted)
+		_, err = os.Stat(filepath.Join(tmp, "1"))
+		assert.True(t, os.IsNotExist(err))
+	})
 }
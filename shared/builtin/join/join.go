@@ -0,0 +1,313 @@
+package join
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/util"
+	"github.com/argoproj-labs/argo-dataflow/shared/builtin"
+	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var logger = sharedutil.NewLogger()
+
+// the buffered messages are stored in a directory:
+//
+//	{dir}/{side}/_{key}/{arrival}_{id}[.matched]  a message waiting for a match, ".matched" once it has had one
+//
+// files are named so they sort in arrival order, and so the ID of the message is known when it is joined
+const matchedSuffix = ".matched"
+
+type side struct {
+	name   string // "left" or "right"
+	source string
+	key    *vm.Program
+}
+
+type entry struct {
+	name    string
+	arrival time.Time
+	id      string
+	matched bool
+}
+
+func parseEntry(name string) (entry, error) {
+	parts := strings.SplitN(strings.TrimSuffix(name, matchedSuffix), "_", 2)
+	if len(parts) != 2 {
+		return entry{}, fmt.Errorf("invalid entry name %q", name)
+	}
+	nano, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return entry{}, fmt.Errorf("invalid entry name %q: %w", name, err)
+	}
+	id, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return entry{}, fmt.Errorf("invalid entry name %q: %w", name, err)
+	}
+	return entry{name: name, arrival: time.Unix(0, nano), id: id, matched: strings.HasSuffix(name, matchedSuffix)}, nil
+}
+
+type joiner struct {
+	mu          sync.Mutex
+	dir         string
+	left        side
+	right       side
+	window      time.Duration
+	joinType    dfv1.JoinType
+	template    *vm.Program
+	emit        builtin.Emit
+	now         func() time.Time
+	expirations *prometheus.CounterVec
+}
+
+func New(ctx context.Context, dir, leftSource, leftKey, rightSource, rightKey string, window time.Duration, joinType dfv1.JoinType, template string, emit builtin.Emit) (builtin.Process, error) {
+	expirations := promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "join",
+		Name:      "unmatched_expirations",
+		Help:      "Messages that left the join window without a match, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#join_unmatched_expirations",
+	}, []string{"side"})
+	j, err := newJoiner(dir, leftSource, leftKey, rightSource, rightKey, window, joinType, template, emit, expirations)
+	if err != nil {
+		return nil, err
+	}
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := j.sweep(ctx); err != nil {
+			logger.Error(err, "failed to expire join messages")
+		}
+	}, time.Second)
+	return j.process, nil
+}
+
+func newJoiner(dir, leftSource, leftKey, rightSource, rightKey string, window time.Duration, joinType dfv1.JoinType, template string, emit builtin.Emit, expirations *prometheus.CounterVec) (*joiner, error) {
+	if leftSource == rightSource {
+		return nil, fmt.Errorf("left and right sources must be different")
+	}
+	if window <= 0 {
+		return nil, fmt.Errorf("join window must be greater than zero")
+	}
+	switch joinType {
+	case "", dfv1.JoinTypeInner, dfv1.JoinTypeLeftOuter:
+	default:
+		return nil, fmt.Errorf("unknown join type %q", joinType)
+	}
+	j := &joiner{
+		dir:         dir,
+		left:        side{name: "left", source: leftSource},
+		right:       side{name: "right", source: rightSource},
+		window:      window,
+		joinType:    joinType,
+		emit:        emit,
+		now:         time.Now,
+		expirations: expirations,
+	}
+	var err error
+	if j.left.key, err = expr.Compile(leftKey); err != nil {
+		return nil, fmt.Errorf("failed to compile %q: %w", leftKey, err)
+	}
+	if j.right.key, err = expr.Compile(rightKey); err != nil {
+		return nil, fmt.Errorf("failed to compile %q: %w", rightKey, err)
+	}
+	if template != "" {
+		if j.template, err = expr.Compile(template); err != nil {
+			return nil, fmt.Errorf("failed to compile %q: %w", template, err)
+		}
+	}
+	for _, s := range []side{j.left, j.right} {
+		if err := os.MkdirAll(filepath.Join(dir, s.name), 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create join dir: %w", err)
+		}
+	}
+	return j, nil
+}
+
+func (j *joiner) process(ctx context.Context, msg []byte) ([]byte, error) {
+	m, err := dfv1.MetaFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	this, other := j.left, j.right
+	switch m.SourceName {
+	case j.left.source:
+	case j.right.source:
+		this, other = j.right, j.left
+	default:
+		return nil, fmt.Errorf("message from unknown source %q, expected %q or %q", m.SourceName, j.left.source, j.right.source)
+	}
+	env, err := util.ExprEnv(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expr env: %w", err)
+	}
+	res, err := expr.Run(this.key, env)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run program: %w", err)
+	}
+	key, ok := res.(string)
+	if !ok {
+		return nil, fmt.Errorf("key expression must return a string")
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := j.now()
+	otherDir := j.keyDir(other, key)
+	entries, err := j.entries(otherDir)
+	if err != nil {
+		return nil, err
+	}
+	matched := false
+	for _, e := range entries {
+		if j.expired(e, now) {
+			continue // waiting to be swept
+		}
+		data, err := ioutil.ReadFile(filepath.Join(otherDir, e.name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read message file %q: %w", e.name, err)
+		}
+		if this.name == "left" {
+			err = j.emitJoined(ctx, m.ID, msg, e.id, data)
+		} else {
+			err = j.emitJoined(ctx, e.id, data, m.ID, msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		matched = true
+		if !e.matched {
+			if err := os.Rename(filepath.Join(otherDir, e.name), filepath.Join(otherDir, e.name+matchedSuffix)); err != nil {
+				return nil, fmt.Errorf("failed to mark message file %q as matched: %w", e.name, err)
+			}
+		}
+	}
+	thisDir := j.keyDir(this, key)
+	if err := os.MkdirAll(thisDir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create key dir %q: %w", thisDir, err)
+	}
+	name := fmt.Sprintf("%020d_%s", now.UnixNano(), url.PathEscape(m.ID))
+	if matched {
+		name += matchedSuffix
+	}
+	if err := ioutil.WriteFile(filepath.Join(thisDir, name), msg, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to create message file %q: %w", name, err)
+	}
+	return nil, nil
+}
+
+func (j *joiner) keyDir(s side, key string) string {
+	return filepath.Join(j.dir, s.name, "_"+url.PathEscape(key))
+}
+
+// entries returns the messages buffered in the key dir, in arrival order
+func (j *joiner) entries(dir string) ([]entry, error) {
+	items, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read key dir %q: %w", dir, err)
+	}
+	entries := make([]entry, len(items))
+	for i, item := range items {
+		if entries[i], err = parseEntry(item.Name()); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+func (j *joiner) expired(e entry, now time.Time) bool {
+	return !now.Before(e.arrival.Add(j.window))
+}
+
+// emitJoined emits the joined message, right is nil if there was no match, the ID is deterministic, so duplicates can
+// be detected if we crash after emitting
+func (j *joiner) emitJoined(ctx context.Context, leftID string, left []byte, rightID string, right []byte) error {
+	id := fmt.Sprintf("%s/%s", leftID, rightID)
+	ctx = dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: "join", ID: id, Time: j.now().Unix()})
+	var data []byte
+	if j.template == nil {
+		out := map[string]interface{}{"left": string(left), "right": nil}
+		if right != nil {
+			out["right"] = string(right)
+		}
+		var err error
+		if data, err = json.Marshal(out); err != nil {
+			return fmt.Errorf("failed to marshal joined message: %w", err)
+		}
+	} else {
+		env, err := util.ExprEnv(ctx, left)
+		if err != nil {
+			return fmt.Errorf("failed to create expr env: %w", err)
+		}
+		env["left"] = left
+		env["right"] = right
+		res, err := expr.Run(j.template, env)
+		if err != nil {
+			return fmt.Errorf("failed to run template: %w", err)
+		}
+		var ok bool
+		if data, ok = res.([]byte); !ok {
+			return fmt.Errorf("template must return []byte")
+		}
+	}
+	if err := j.emit(ctx, data); err != nil {
+		return fmt.Errorf("failed to emit joined message %q: %w", id, err)
+	}
+	return nil
+}
+
+// sweep removes messages that have been buffered for longer than the window, emitting unmatched left messages for a
+// left-outer join
+func (j *joiner) sweep(ctx context.Context) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := j.now()
+	for _, s := range []side{j.left, j.right} {
+		keys, err := ioutil.ReadDir(filepath.Join(j.dir, s.name))
+		if err != nil {
+			return fmt.Errorf("failed to read join dir: %w", err)
+		}
+		for _, k := range keys {
+			dir := filepath.Join(j.dir, s.name, k.Name())
+			entries, err := j.entries(dir)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				if !j.expired(e, now) {
+					break // entries are in arrival order
+				}
+				if !e.matched {
+					if s.name == "left" && j.joinType == dfv1.JoinTypeLeftOuter {
+						data, err := ioutil.ReadFile(filepath.Join(dir, e.name))
+						if err != nil {
+							return fmt.Errorf("failed to read message file %q: %w", e.name, err)
+						}
+						if err := j.emitJoined(ctx, e.id, data, "", nil); err != nil {
+							return err
+						}
+					}
+					j.expirations.WithLabelValues(s.name).Inc()
+				}
+				if err := os.Remove(filepath.Join(dir, e.name)); err != nil {
+					return fmt.Errorf("failed to remove message file %q: %w", e.name, err)
+				}
+			}
+			// remove the key dir if it is now empty, ignoring the error if it is not
+			_ = os.Remove(dir)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package join
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type emitted struct {
+	id   string
+	data string
+}
+
+type testJoiner struct {
+	*joiner
+	now     time.Time
+	emitted []emitted
+}
+
+func newTestJoiner(t *testing.T, joinType dfv1.JoinType, template string) *testJoiner {
+	x := &testJoiner{now: time.Unix(1000, 0)}
+	emit := func(ctx context.Context, msg []byte) error {
+		m, err := dfv1.MetaFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		x.emitted = append(x.emitted, emitted{id: m.ID, data: string(msg)})
+		return nil
+	}
+	expirations := prometheus.NewCounterVec(prometheus.CounterOpts{}, []string{"side"})
+	j, err := newJoiner(t.TempDir(), "orders", `object(msg).id`, "payments", `object(msg).orderId`, 10*time.Second, joinType, template, emit, expirations)
+	assert.NoError(t, err)
+	j.now = func() time.Time { return x.now }
+	x.joiner = j
+	return x
+}
+
+func (x *testJoiner) send(t *testing.T, source, id, msg string) {
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: id, Time: 1000, SourceName: source})
+	resp, err := x.process(ctx, []byte(msg))
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+}
+
+func (x *testJoiner) sweep(t *testing.T) {
+	assert.NoError(t, x.joiner.sweep(context.Background()))
+}
+
+func TestNew(t *testing.T) {
+	// not t.TempDir(), as the background sweeper may read it after the test
+	tmp, err := os.MkdirTemp("/tmp", "test")
+	assert.NoError(t, err)
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "my-id", Time: 1000, SourceName: "orders"})
+	p, err := New(ctx, tmp, "orders", `"my-key"`, "payments", `"my-key"`, time.Minute, dfv1.JoinTypeInner, "", func(context.Context, []byte) error { return nil })
+	assert.NoError(t, err)
+	resp, err := p(ctx, []byte("foo"))
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_newJoiner(t *testing.T) {
+	_, err := newJoiner(t.TempDir(), "a", `""`, "a", `""`, time.Minute, "", "", nil, nil)
+	assert.EqualError(t, err, "left and right sources must be different")
+	_, err = newJoiner(t.TempDir(), "a", `""`, "b", `""`, time.Minute, "foo", "", nil, nil)
+	assert.EqualError(t, err, `unknown join type "foo"`)
+	_, err = newJoiner(t.TempDir(), "a", `""`, "b", `""`, 0, "", "", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestUnknownSource(t *testing.T) {
+	x := newTestJoiner(t, dfv1.JoinTypeInner, "")
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "1", SourceName: "foo"})
+	_, err := x.process(ctx, []byte(`{}`))
+	assert.EqualError(t, err, `message from unknown source "foo", expected "orders" or "payments"`)
+}
+
+func TestInner(t *testing.T) {
+	x := newTestJoiner(t, dfv1.JoinTypeInner, "")
+	x.send(t, "orders", "o1", `{"id": "1"}`)
+	x.send(t, "payments", "p1", `{"orderId": "2"}`)
+	assert.Empty(t, x.emitted)
+	x.send(t, "payments", "p2", `{"orderId": "1"}`)
+	x.send(t, "payments", "p3", `{"orderId": "1"}`)
+	if assert.Len(t, x.emitted, 2) {
+		assert.Equal(t, "o1/p2", x.emitted[0].id)
+		assert.JSONEq(t, `{"left": "{\"id\": \"1\"}", "right": "{\"orderId\": \"1\"}"}`, x.emitted[0].data)
+		assert.Equal(t, "o1/p3", x.emitted[1].id)
+	}
+	x.send(t, "orders", "o2", `{"id": "2"}`)
+	if assert.Len(t, x.emitted, 3) {
+		assert.Equal(t, "o2/p1", x.emitted[2].id)
+	}
+	x.now = x.now.Add(10 * time.Second)
+	x.sweep(t)
+	assert.Len(t, x.emitted, 3, "inner joins do not emit unmatched messages")
+	assert.Equal(t, float64(0), testutil.ToFloat64(x.expirations.WithLabelValues("left")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(x.expirations.WithLabelValues("right")))
+	x.send(t, "payments", "p4", `{"orderId": "1"}`)
+	assert.Len(t, x.emitted, 3, "the order has left the window")
+}
+
+func TestLeftOuter(t *testing.T) {
+	x := newTestJoiner(t, dfv1.JoinTypeLeftOuter, "")
+	x.send(t, "orders", "o1", `{"id": "1"}`)
+	x.send(t, "orders", "o2", `{"id": "2"}`)
+	x.send(t, "payments", "p1", `{"orderId": "1"}`)
+	x.send(t, "payments", "p2", `{"orderId": "3"}`)
+	assert.Len(t, x.emitted, 1)
+	x.now = x.now.Add(5 * time.Second)
+	x.sweep(t)
+	assert.Len(t, x.emitted, 1, "still within the window")
+	x.now = x.now.Add(5 * time.Second)
+	x.sweep(t)
+	if assert.Len(t, x.emitted, 2) {
+		assert.Equal(t, "o2/", x.emitted[1].id)
+		assert.JSONEq(t, `{"left": "{\"id\": \"2\"}", "right": null}`, x.emitted[1].data)
+	}
+	assert.Equal(t, float64(1), testutil.ToFloat64(x.expirations.WithLabelValues("left")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(x.expirations.WithLabelValues("right")))
+	x.sweep(t)
+	assert.Len(t, x.emitted, 2, "expired messages are removed")
+}
+
+func TestTemplate(t *testing.T) {
+	x := newTestJoiner(t, dfv1.JoinTypeInner, `bytes(object(left).id + ":" + object(right).amount)`)
+	x.send(t, "payments", "p1", `{"orderId": "1", "amount": "10"}`)
+	x.send(t, "orders", "o1", `{"id": "1"}`)
+	if assert.Len(t, x.emitted, 1) {
+		assert.Equal(t, "1:10", x.emitted[0].data)
+	}
+}
+
+func Test_parseEntry(t *testing.T) {
+	e, err := parseEntry("00000000001000000000_a%2Fb_c.matched")
+	assert.NoError(t, err)
+	assert.True(t, time.Unix(1, 0).Equal(e.arrival))
+	assert.Equal(t, "a/b_c", e.id)
+	assert.True(t, e.matched)
+	_, err = parseEntry("foo")
+	assert.Error(t, err)
+}
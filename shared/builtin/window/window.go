@@ -0,0 +1,425 @@
+package window
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/argoproj-labs/argo-dataflow/runner/util"
+	"github.com/argoproj-labs/argo-dataflow/shared/builtin"
+	sharedutil "github.com/argoproj-labs/argo-dataflow/shared/util"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var logger = sharedutil.NewLogger()
+
+// the state of the windows is stored in a directory:
+//
+//	{dir}/watermark                         the max event time seen
+//	{dir}/keys/_{key}/{start}-{end}/{file}  a message in an open window, files are named so they sort in event time order
+const (
+	watermarkFile = "watermark"
+	keysDir       = "keys"
+)
+
+type window struct {
+	key   string
+	start time.Time
+	end   time.Time
+}
+
+func (w window) path() string {
+	return filepath.Join(keysDir, "_"+url.PathEscape(w.key), fmt.Sprintf("%d-%d", w.start.UnixNano(), w.end.UnixNano()))
+}
+
+func (w window) overlaps(x window) bool {
+	return w.key == x.key && !w.start.After(x.end) && !x.start.After(w.end)
+}
+
+type output struct {
+	Key      string      `json:"key"`
+	Start    time.Time   `json:"start"`
+	End      time.Time   `json:"end"`
+	Messages interface{} `json:"messages"`
+}
+
+type windows struct {
+	mu              sync.Mutex
+	dir             string
+	kind            string
+	size            time.Duration // the gap for session windows
+	slide           time.Duration
+	allowedLateness time.Duration
+	format          dfv1.GroupFormat
+	emit            builtin.Emit
+	now             func() time.Time
+	open            map[string]window // keyed by path
+	maxEventTime    time.Time
+	advanced        time.Time // when max event time was last advanced
+	persisted       time.Time // the max event time last written to disk
+	watermark       time.Time
+	late            prometheus.Counter
+	openGauge       prometheus.Gauge
+}
+
+func New(ctx context.Context, dir, key, eventTime, kind string, size, slide, allowedLateness time.Duration, format dfv1.GroupFormat, emit builtin.Emit) (builtin.Process, error) {
+	late := promauto.NewCounter(prometheus.CounterOpts{
+		Subsystem: "window",
+		Name:      "late_messages",
+		Help:      "Messages too late to be added to any window, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#window_late_messages",
+	})
+	openGauge := promauto.NewGauge(prometheus.GaugeOpts{
+		Subsystem: "window",
+		Name:      "open",
+		Help:      "Number of open windows, see https://github.com/argoproj-labs/argo-dataflow/blob/main/docs/METRICS.md#window_open",
+	})
+	s, err := newWindows(dir, kind, size, slide, allowedLateness, format, emit, late, openGauge)
+	if err != nil {
+		return nil, err
+	}
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := s.sweep(ctx); err != nil {
+			logger.Error(err, "failed to emit windows")
+		}
+	}, time.Second)
+	return s.process(key, eventTime)
+}
+
+func newWindows(dir, kind string, size, slide, allowedLateness time.Duration, format dfv1.GroupFormat, emit builtin.Emit, late prometheus.Counter, openGauge prometheus.Gauge) (*windows, error) {
+	switch kind {
+	case "tumbling", "session":
+	case "sliding":
+		if slide <= 0 {
+			return nil, fmt.Errorf("sliding window slide must be greater than zero")
+		}
+	default:
+		return nil, fmt.Errorf("unknown window kind %q", kind)
+	}
+	if size <= 0 {
+		return nil, fmt.Errorf("window size must be greater than zero")
+	}
+	switch format {
+	case dfv1.GroupFormatUnknown, dfv1.GroupFormatJSONBytesArray, dfv1.GroupFormatJSONStringArray:
+	default:
+		return nil, fmt.Errorf("unknown window format %q", format)
+	}
+	s := &windows{
+		dir:             dir,
+		kind:            kind,
+		size:            size,
+		slide:           slide,
+		allowedLateness: allowedLateness,
+		format:          format,
+		emit:            emit,
+		now:             time.Now,
+		open:            map[string]window{},
+		late:            late,
+		openGauge:       openGauge,
+	}
+	if err := s.recover(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// recover reads the open windows and watermark from disk, so a restart does not lose them
+func (s *windows) recover() error {
+	if err := os.MkdirAll(filepath.Join(s.dir, keysDir), 0o700); err != nil {
+		return fmt.Errorf("failed to create windows dir: %w", err)
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(s.dir, watermarkFile)); err == nil {
+		t, err := time.Parse(time.RFC3339Nano, string(data))
+		if err != nil {
+			return fmt.Errorf("failed to parse watermark %q: %w", data, err)
+		}
+		s.maxEventTime, s.persisted, s.watermark = t, t, t
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read watermark: %w", err)
+	}
+	s.advanced = s.now()
+	keys, err := ioutil.ReadDir(filepath.Join(s.dir, keysDir))
+	if err != nil {
+		return fmt.Errorf("failed to read windows dir: %w", err)
+	}
+	for _, k := range keys {
+		key, err := url.PathUnescape(strings.TrimPrefix(k.Name(), "_"))
+		if err != nil {
+			return fmt.Errorf("failed to unescape key %q: %w", k.Name(), err)
+		}
+		items, err := ioutil.ReadDir(filepath.Join(s.dir, keysDir, k.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read key dir: %w", err)
+		}
+		for _, item := range items {
+			var start, end int64
+			if _, err := fmt.Sscanf(item.Name(), "%d-%d", &start, &end); err != nil {
+				return fmt.Errorf("failed to parse window %q: %w", item.Name(), err)
+			}
+			w := window{key: key, start: time.Unix(0, start), end: time.Unix(0, end)}
+			s.open[w.path()] = w
+		}
+	}
+	logger.Info("recovered windows", "open", len(s.open), "watermark", s.watermark)
+	s.openGauge.Set(float64(len(s.open)))
+	return nil
+}
+
+func (s *windows) process(key, eventTime string) (builtin.Process, error) {
+	keyProg, err := expr.Compile(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile %q: %w", key, err)
+	}
+	var eventTimeProg *vm.Program
+	if eventTime != "" {
+		if eventTimeProg, err = expr.Compile(eventTime); err != nil {
+			return nil, fmt.Errorf("failed to compile %q: %w", eventTime, err)
+		}
+	}
+	return func(ctx context.Context, msg []byte) ([]byte, error) {
+		env, err := util.ExprEnv(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create expr env: %w", err)
+		}
+		res, err := expr.Run(keyProg, env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run program: %w", err)
+		}
+		key, ok := res.(string)
+		if !ok {
+			return nil, fmt.Errorf("key expression must return a string")
+		}
+		t, err := getEventTime(ctx, eventTimeProg, env)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.add(key, t, msg)
+	}, nil
+}
+
+func getEventTime(ctx context.Context, prog *vm.Program, env map[string]interface{}) (time.Time, error) {
+	if prog == nil {
+		m, err := dfv1.MetaFromContext(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(m.Time, 0), nil
+	}
+	res, err := expr.Run(prog, env)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to run program: %w", err)
+	}
+	switch v := res.(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse event time: %w", err)
+		}
+		return t, nil
+	case int:
+		return time.Unix(int64(v), 0), nil
+	case int64:
+		return time.Unix(v, 0), nil
+	case float64:
+		return time.Unix(0, int64(v*float64(time.Second))), nil
+	case time.Time:
+		return v, nil
+	default:
+		return time.Time{}, fmt.Errorf("event time expression must return a string or number, got %T", res)
+	}
+}
+
+// updateWatermark must be called while holding the lock.
+// The watermark is the max event time seen, plus the time since it was last advanced, so that windows are closed even
+// if no more messages arrive. It never goes backwards.
+func (s *windows) updateWatermark() {
+	if t := s.maxEventTime.Add(s.now().Sub(s.advanced)); t.After(s.watermark) {
+		s.watermark = t
+	}
+}
+
+func (s *windows) expired(w window) bool {
+	return !s.watermark.Before(w.end.Add(s.allowedLateness))
+}
+
+func (s *windows) add(key string, t time.Time, msg []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t.After(s.maxEventTime) {
+		s.maxEventTime = t
+		s.advanced = s.now()
+	}
+	s.updateWatermark()
+	ws, err := s.assign(key, t)
+	if err != nil {
+		return err
+	}
+	added := false
+	for _, w := range ws {
+		if s.expired(w) {
+			continue
+		}
+		dir := filepath.Join(s.dir, w.path())
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return fmt.Errorf("failed to create window dir %q: %w", dir, err)
+		}
+		path := filepath.Join(dir, fmt.Sprintf("%020d-%s", t.UnixNano(), uuid.New().String()))
+		if err := ioutil.WriteFile(path, msg, 0o600); err != nil {
+			return fmt.Errorf("failed to create message file %q: %w", path, err)
+		}
+		s.open[w.path()] = w
+		added = true
+	}
+	if !added {
+		s.late.Inc()
+	}
+	s.openGauge.Set(float64(len(s.open)))
+	return nil
+}
+
+// assign returns the windows the message belongs to, it must be called while holding the lock
+func (s *windows) assign(key string, t time.Time) ([]window, error) {
+	switch s.kind {
+	case "tumbling":
+		start := t.Truncate(s.size)
+		return []window{{key: key, start: start, end: start.Add(s.size)}}, nil
+	case "sliding":
+		var ws []window
+		for start := t.Truncate(s.slide); start.Add(s.size).After(t); start = start.Add(-s.slide) {
+			ws = append(ws, window{key: key, start: start, end: start.Add(s.size)})
+		}
+		return ws, nil
+	default:
+		return s.mergeSession(window{key: key, start: t, end: t.Add(s.size)})
+	}
+}
+
+// mergeSession merges the new session window with any open session windows it overlaps, moving their messages into
+// the merged window
+func (s *windows) mergeSession(w window) ([]window, error) {
+	if s.expired(w) {
+		return []window{w}, nil
+	}
+	var merging []window
+	merged := w
+	for _, x := range s.open {
+		if x.overlaps(w) {
+			merging = append(merging, x)
+			if x.start.Before(merged.start) {
+				merged.start = x.start
+			}
+			if x.end.After(merged.end) {
+				merged.end = x.end
+			}
+		}
+	}
+	for _, x := range merging {
+		if x.path() == merged.path() {
+			continue
+		}
+		from := filepath.Join(s.dir, x.path())
+		to := filepath.Join(s.dir, merged.path())
+		if err := os.MkdirAll(to, 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create window dir %q: %w", to, err)
+		}
+		items, err := ioutil.ReadDir(from)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read window dir %q: %w", from, err)
+		}
+		for _, item := range items {
+			if err := os.Rename(filepath.Join(from, item.Name()), filepath.Join(to, item.Name())); err != nil {
+				return nil, fmt.Errorf("failed to move message file %q: %w", item.Name(), err)
+			}
+		}
+		if err := os.Remove(from); err != nil {
+			return nil, fmt.Errorf("failed to remove window dir %q: %w", from, err)
+		}
+		delete(s.open, x.path())
+	}
+	return []window{merged}, nil
+}
+
+// sweep emits the windows that the watermark has passed
+func (s *windows) sweep(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updateWatermark()
+	if !s.maxEventTime.Equal(s.persisted) {
+		if err := ioutil.WriteFile(filepath.Join(s.dir, watermarkFile), []byte(s.maxEventTime.Format(time.RFC3339Nano)), 0o600); err != nil {
+			return fmt.Errorf("failed to write watermark: %w", err)
+		}
+		s.persisted = s.maxEventTime
+	}
+	var expired []window
+	for _, w := range s.open {
+		if s.expired(w) {
+			expired = append(expired, w)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		if !expired[i].end.Equal(expired[j].end) {
+			return expired[i].end.Before(expired[j].end)
+		}
+		return expired[i].key < expired[j].key
+	})
+	defer func() { s.openGauge.Set(float64(len(s.open))) }()
+	for _, w := range expired {
+		if err := s.emitWindow(ctx, w); err != nil {
+			return err
+		}
+		delete(s.open, w.path())
+	}
+	return nil
+}
+
+func (s *windows) emitWindow(ctx context.Context, w window) error {
+	dir := filepath.Join(s.dir, w.path())
+	items, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read window dir %q: %w", dir, err)
+	}
+	msgs := make([][]byte, len(items))
+	for i, item := range items {
+		if msgs[i], err = ioutil.ReadFile(filepath.Join(dir, item.Name())); err != nil {
+			return fmt.Errorf("failed to read file %q: %w", item.Name(), err)
+		}
+	}
+	out := output{Key: w.key, Start: w.start.UTC(), End: w.end.UTC()}
+	if s.format == dfv1.GroupFormatJSONBytesArray {
+		out.Messages = msgs
+	} else {
+		stringMsgs := make([]string, len(msgs))
+		for i, msg := range msgs {
+			stringMsgs[i] = string(msg)
+		}
+		out.Messages = stringMsgs
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("failed to marshal window: %w", err)
+	}
+	// the ID is deterministic, so that if we crash after emitting, but before deleting, the duplicate can be detected
+	id := fmt.Sprintf("%s/%d-%d", w.key, w.start.Unix(), w.end.Unix())
+	if err := s.emit(dfv1.ContextWithMeta(ctx, dfv1.Meta{Source: "window", ID: id, Time: w.end.Unix()}), data); err != nil {
+		return fmt.Errorf("failed to emit window %q: %w", id, err)
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to remove window dir %q: %w", dir, err)
+	}
+	// remove the key dir if it is now empty, ignoring the error if it is not
+	_ = os.Remove(filepath.Dir(dir))
+	return nil
+}
@@ -0,0 +1,161 @@
+package window
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	dfv1 "github.com/argoproj-labs/argo-dataflow/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+type testWindows struct {
+	*windows
+	now     time.Time
+	emitted []output
+}
+
+func newTestWindows(t *testing.T, dir, kind string, size, slide, allowedLateness time.Duration) *testWindows {
+	x := &testWindows{now: time.Unix(1000, 0)}
+	emit := func(ctx context.Context, msg []byte) error {
+		o := output{}
+		if err := json.Unmarshal(msg, &o); err != nil {
+			return err
+		}
+		x.emitted = append(x.emitted, o)
+		return nil
+	}
+	s, err := newWindows(dir, kind, size, slide, allowedLateness, dfv1.GroupFormatJSONStringArray, emit, prometheus.NewCounter(prometheus.CounterOpts{}), prometheus.NewGauge(prometheus.GaugeOpts{}))
+	assert.NoError(t, err)
+	s.now = func() time.Time { return x.now }
+	x.windows = s
+	return x
+}
+
+func (x *testWindows) send(t *testing.T, key string, eventTime int64, msg string) {
+	assert.NoError(t, x.add(key, time.Unix(eventTime, 0), []byte(msg)))
+}
+
+func (x *testWindows) sweep(t *testing.T) {
+	assert.NoError(t, x.windows.sweep(context.Background()))
+}
+
+func TestNew(t *testing.T) {
+	// not t.TempDir(), as the background sweeper may write to it after the test
+	tmp, err := os.MkdirTemp("/tmp", "test")
+	assert.NoError(t, err)
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "my-id", Time: 1000})
+	p, err := New(ctx, tmp, `"my-key"`, "", "tumbling", time.Minute, time.Minute, 0, dfv1.GroupFormatJSONStringArray, func(context.Context, []byte) error { return nil })
+	assert.NoError(t, err)
+	resp, err := p(ctx, []byte("foo"))
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_newWindows(t *testing.T) {
+	_, err := newWindows(t.TempDir(), "foo", time.Minute, 0, 0, "", nil, nil, nil)
+	assert.EqualError(t, err, `unknown window kind "foo"`)
+	_, err = newWindows(t.TempDir(), "sliding", time.Minute, 0, 0, "", nil, nil, nil)
+	assert.Error(t, err)
+}
+
+func Test_getEventTime(t *testing.T) {
+	ctx := dfv1.ContextWithMeta(context.Background(), dfv1.Meta{Source: "my-source", ID: "my-id", Time: 1000})
+	p, err := newTestWindows(t, t.TempDir(), "tumbling", time.Minute, 0, 0).process(`"my-key"`, `object(msg).time`)
+	assert.NoError(t, err)
+	_, err = p(ctx, []byte(`{"time": "1970-01-01T00:16:40Z"}`))
+	assert.NoError(t, err)
+	_, err = p(ctx, []byte(`{"time": 1000}`))
+	assert.NoError(t, err)
+	_, err = p(ctx, []byte(`{"time": true}`))
+	assert.Error(t, err)
+}
+
+func TestTumbling(t *testing.T) {
+	x := newTestWindows(t, t.TempDir(), "tumbling", 10*time.Second, 0, 0)
+	x.send(t, "a", 1001, "1")
+	x.send(t, "b", 1002, "2")
+	x.send(t, "a", 1009, "3")
+	x.sweep(t)
+	assert.Empty(t, x.emitted)
+	x.send(t, "a", 1010, "4") // advances the watermark to the end of the first window
+	x.sweep(t)
+	if assert.Len(t, x.emitted, 2) {
+		assert.Equal(t, "a", x.emitted[0].Key)
+		assert.Equal(t, time.Unix(1000, 0).UTC(), x.emitted[0].Start)
+		assert.Equal(t, time.Unix(1010, 0).UTC(), x.emitted[0].End)
+		assert.Equal(t, []interface{}{"1", "3"}, x.emitted[0].Messages)
+		assert.Equal(t, "b", x.emitted[1].Key)
+	}
+	assert.Len(t, x.open, 1)
+}
+
+func TestSliding(t *testing.T) {
+	x := newTestWindows(t, t.TempDir(), "sliding", 10*time.Second, 5*time.Second, 0)
+	x.send(t, "a", 1007, "1")
+	assert.Len(t, x.open, 2)
+	x.send(t, "a", 1015, "2")
+	x.sweep(t)
+	if assert.Len(t, x.emitted, 2) {
+		assert.Equal(t, time.Unix(1000, 0).UTC(), x.emitted[0].Start)
+		assert.Equal(t, []interface{}{"1"}, x.emitted[0].Messages)
+		assert.Equal(t, time.Unix(1005, 0).UTC(), x.emitted[1].Start)
+		assert.Equal(t, []interface{}{"1"}, x.emitted[1].Messages)
+	}
+}
+
+func TestSession(t *testing.T) {
+	x := newTestWindows(t, t.TempDir(), "session", 10*time.Second, 0, 0)
+	x.send(t, "a", 1000, "1")
+	x.send(t, "a", 1030, "2")
+	assert.Len(t, x.open, 2, "the first session is not extended, as the watermark has passed it")
+	x.send(t, "a", 1015, "3") // late
+	x.send(t, "a", 1035, "4")
+	assert.Len(t, x.open, 2, "the second session is extended")
+	x.sweep(t)
+	if assert.Len(t, x.emitted, 1) {
+		assert.Equal(t, []interface{}{"1"}, x.emitted[0].Messages)
+	}
+	x.now = x.now.Add(15 * time.Second) // no more messages, the watermark advances with time
+	x.sweep(t)
+	if assert.Len(t, x.emitted, 2) {
+		assert.Equal(t, time.Unix(1030, 0).UTC(), x.emitted[1].Start)
+		assert.Equal(t, time.Unix(1045, 0).UTC(), x.emitted[1].End)
+		assert.Equal(t, []interface{}{"2", "4"}, x.emitted[1].Messages)
+	}
+	assert.Empty(t, x.open)
+}
+
+func TestAllowedLateness(t *testing.T) {
+	x := newTestWindows(t, t.TempDir(), "tumbling", 10*time.Second, 0, 5*time.Second)
+	x.send(t, "a", 1001, "1")
+	x.send(t, "a", 1012, "2")
+	x.send(t, "a", 1002, "3") // late, but allowed
+	x.send(t, "a", 1016, "4")
+	x.send(t, "a", 1003, "5") // too late
+	x.sweep(t)
+	if assert.Len(t, x.emitted, 1) {
+		assert.Equal(t, []interface{}{"1", "3"}, x.emitted[0].Messages)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	dir := t.TempDir()
+	x := newTestWindows(t, dir, "tumbling", 10*time.Second, 0, 0)
+	x.send(t, "a/b", 1001, "1")
+	x.send(t, "", 1005, "2")
+	x.sweep(t)
+	y := newTestWindows(t, dir, "tumbling", 10*time.Second, 0, 0)
+	assert.Len(t, y.open, 2)
+	assert.True(t, time.Unix(1005, 0).Equal(y.watermark))
+	y.send(t, "a/b", 1010, "3")
+	y.sweep(t)
+	if assert.Len(t, y.emitted, 2) {
+		assert.Equal(t, "", y.emitted[0].Key)
+		assert.Equal(t, "a/b", y.emitted[1].Key)
+		assert.Equal(t, []interface{}{"1"}, y.emitted[1].Messages)
+	}
+}
@@ -10,3 +10,8 @@ func GetSourceUID(cluster, namespace, pipelineName, stepName, sourceName string)
 	hash := MustHash(fmt.Sprintf("%s.%s.%s.%s.sources.%s", cluster, namespace, pipelineName, stepName, sourceName))
 	return fmt.Sprintf("dataflow-%s-%s-%s-%s-%s-%s", strings.ShortenString(cluster, 3), strings.ShortenString(namespace, 3), strings.ShortenString(pipelineName, 3), strings.ShortenString(stepName, 3), strings.ShortenString(sourceName, 3), hash)
 }
+
+func GetSinkUID(cluster, namespace, pipelineName, stepName, sinkName string) string {
+	hash := MustHash(fmt.Sprintf("%s.%s.%s.%s.sinks.%s", cluster, namespace, pipelineName, stepName, sinkName))
+	return fmt.Sprintf("dataflow-%s-%s-%s-%s-%s-%s", strings.ShortenString(cluster, 3), strings.ShortenString(namespace, 3), strings.ShortenString(pipelineName, 3), strings.ShortenString(stepName, 3), strings.ShortenString(sinkName, 3), hash)
+}
@@ -10,3 +10,8 @@ func TestGetSourceUID(t *testing.T) {
 	uniqueID := GetSourceUID("cluster", "default", "pipeline", "stepName", "source")
 	assert.Equal(t, "dataflow-clu-def-pip-ste-sou-7c07c91b03ebf978f5dda8b77130662e016493600b8ca4e6ffe12ec5183e3d25", uniqueID)
 }
+
+func TestGetSinkUID(t *testing.T) {
+	uniqueID := GetSinkUID("cluster", "default", "pipeline", "stepName", "sink")
+	assert.Equal(t, "dataflow-clu-def-pip-ste-sin-fd232c1ec03f819acde379609d5ca51cb85073ffe01a646e853e8e11991fffdf", uniqueID)
+}